@@ -101,8 +101,24 @@ type K8SResourceOverlayPatch_Type int32
 
 const (
 	K8SResourceOverlayPatch_unspecified K8SResourceOverlayPatch_Type = 0
-	K8SResourceOverlayPatch_replace     K8SResourceOverlayPatch_Type = 1
-	K8SResourceOverlayPatch_remove      K8SResourceOverlayPatch_Type = 2
+	// Replaces the value at the path, using the go-patch path syntax, e.g. /spec/template/spec/containers/name=discovery/image
+	K8SResourceOverlayPatch_replace K8SResourceOverlayPatch_Type = 1
+	// Removes the value at the path, using the go-patch path syntax.
+	K8SResourceOverlayPatch_remove K8SResourceOverlayPatch_Type = 2
+	// RFC 6902 add operation, using a JSON pointer path, e.g. /spec/template/spec/containers/- to append to an array.
+	K8SResourceOverlayPatch_add K8SResourceOverlayPatch_Type = 3
+	// RFC 6902 move operation from the from path to the path.
+	K8SResourceOverlayPatch_move K8SResourceOverlayPatch_Type = 4
+	// RFC 6902 copy operation from the from path to the path.
+	K8SResourceOverlayPatch_copy K8SResourceOverlayPatch_Type = 5
+	// RFC 6902 test operation, the patching fails if the value at the path is not equal to the value.
+	K8SResourceOverlayPatch_test K8SResourceOverlayPatch_Type = 6
+	// RFC 7386 JSON merge patch, the value is merged into the resource. The path is ignored.
+	K8SResourceOverlayPatch_merge K8SResourceOverlayPatch_Type = 7
+	// Kubernetes strategic merge patch, the value is merged into the resource using the patch strategies of
+	// the built-in Kubernetes types, e.g. containers are merged by their names. Resources of other types are
+	// patched with JSON merge patch. The path is ignored.
+	K8SResourceOverlayPatch_strategicMerge K8SResourceOverlayPatch_Type = 8
 )
 
 // Enum value maps for K8SResourceOverlayPatch_Type.
@@ -111,11 +127,23 @@ var (
 		0: "unspecified",
 		1: "replace",
 		2: "remove",
+		3: "add",
+		4: "move",
+		5: "copy",
+		6: "test",
+		7: "merge",
+		8: "strategicMerge",
 	}
 	K8SResourceOverlayPatch_Type_value = map[string]int32{
-		"unspecified": 0,
-		"replace":     1,
-		"remove":      2,
+		"unspecified":    0,
+		"replace":        1,
+		"remove":         2,
+		"add":            3,
+		"move":           4,
+		"copy":           5,
+		"test":           6,
+		"merge":          7,
+		"strategicMerge": 8,
 	}
 )
 
@@ -146,6 +174,62 @@ func (K8SResourceOverlayPatch_Type) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1alpha1_common_proto_rawDescGZIP(), []int{15, 0}
 }
 
+type DriftPolicy_Action int32
+
+const (
+	// Same as revert.
+	DriftPolicy_unspecified DriftPolicy_Action = 0
+	// Reverts the changes.
+	DriftPolicy_revert DriftPolicy_Action = 1
+	// Keeps the changes and only reports them.
+	DriftPolicy_report DriftPolicy_Action = 2
+	// Keeps the changes of the listed fields and reverts the rest.
+	DriftPolicy_ignoreFields DriftPolicy_Action = 3
+)
+
+// Enum value maps for DriftPolicy_Action.
+var (
+	DriftPolicy_Action_name = map[int32]string{
+		0: "unspecified",
+		1: "revert",
+		2: "report",
+		3: "ignoreFields",
+	}
+	DriftPolicy_Action_value = map[string]int32{
+		"unspecified":  0,
+		"revert":       1,
+		"report":       2,
+		"ignoreFields": 3,
+	}
+)
+
+func (x DriftPolicy_Action) Enum() *DriftPolicy_Action {
+	p := new(DriftPolicy_Action)
+	*p = x
+	return p
+}
+
+func (x DriftPolicy_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftPolicy_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1alpha1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (DriftPolicy_Action) Type() protoreflect.EnumType {
+	return &file_api_v1alpha1_common_proto_enumTypes[2]
+}
+
+func (x DriftPolicy_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftPolicy_Action.Descriptor instead.
+func (DriftPolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1alpha1_common_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // Generic k8s resource metadata
 type K8SObjectMeta struct {
 	state         protoimpl.MessageState
@@ -1946,14 +2030,20 @@ func (x *Replicas) GetTargetCPUUtilizationPercentage() *wrappers.Int32Value {
 	return nil
 }
 
+// K8sResourceOverlayPatch defines patches applied to the matching resources rendered by the operator.
 type K8SResourceOverlayPatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	GroupVersionKind *K8SResourceOverlayPatch_GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
-	ObjectKey        *NamespacedName                           `protobuf:"bytes,2,opt,name=objectKey,proto3" json:"objectKey,omitempty"`
-	Patches          []*K8SResourceOverlayPatch_Patch          `protobuf:"bytes,3,rep,name=patches,proto3" json:"patches,omitempty"`
+	// Name and namespace of the resources to patch. Empty fields match any value,
+	// the fields may contain shell file name patterns, e.g. "istiod-*".
+	ObjectKey *NamespacedName `protobuf:"bytes,2,opt,name=objectKey,proto3" json:"objectKey,omitempty"`
+	// Patches applied in order.
+	Patches []*K8SResourceOverlayPatch_Patch `protobuf:"bytes,3,rep,name=patches,proto3" json:"patches,omitempty"`
+	// Label selector of the resources to patch, e.g. "app=istiod,istio.io/rev in (cp-v117x)".
+	LabelSelector string `protobuf:"bytes,4,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 }
 
 func (x *K8SResourceOverlayPatch) Reset() {
@@ -2009,6 +2099,200 @@ func (x *K8SResourceOverlayPatch) GetPatches() []*K8SResourceOverlayPatch_Patch
 	return nil
 }
 
+func (x *K8SResourceOverlayPatch) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+// DriftPolicy determines how the changes made by others to the matching resources rendered by the operator are handled.
+type DriftPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// GroupVersionKind of the resources the policy applies to. Empty fields match any value,
+	// the fields may contain shell file name patterns, e.g. "*" or "Deploy*".
+	GroupVersionKind *K8SResourceOverlayPatch_GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
+	// Name and namespace of the resources the policy applies to. Empty fields match any value,
+	// the fields may contain shell file name patterns, e.g. "istiod-*".
+	ObjectKey *NamespacedName `protobuf:"bytes,2,opt,name=objectKey,proto3" json:"objectKey,omitempty"`
+	// Label selector of the resources the policy applies to, e.g. "app=istiod".
+	LabelSelector string `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// Action taken when a matching resource has been changed. The first matching policy applies, the changes are reverted if none does.
+	Action DriftPolicy_Action `protobuf:"varint,4,opt,name=action,proto3,enum=istio_operator.v2.api.v1alpha1.DriftPolicy_Action" json:"action,omitempty"`
+	// Dot separated paths of the fields whose changes are kept by the ignoreFields action, e.g. "spec.template.spec.containers[0].env".
+	// A path covers the fields below it as well.
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *DriftPolicy) Reset() {
+	*x = DriftPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_common_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriftPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftPolicy) ProtoMessage() {}
+
+func (x *DriftPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_common_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftPolicy.ProtoReflect.Descriptor instead.
+func (*DriftPolicy) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DriftPolicy) GetGroupVersionKind() *K8SResourceOverlayPatch_GroupVersionKind {
+	if x != nil {
+		return x.GroupVersionKind
+	}
+	return nil
+}
+
+func (x *DriftPolicy) GetObjectKey() *NamespacedName {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return nil
+}
+
+func (x *DriftPolicy) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *DriftPolicy) GetAction() DriftPolicy_Action {
+	if x != nil {
+		return x.Action
+	}
+	return DriftPolicy_unspecified
+}
+
+func (x *DriftPolicy) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ResourceDrift describes the changes made by others to a resource rendered by the operator.
+type ResourceDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace  string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Dot separated paths of the fields which differ from the last applied configuration.
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Field managers of the changed fields, based on the managed fields of the resource.
+	Managers []string `protobuf:"bytes,6,rep,name=managers,proto3" json:"managers,omitempty"`
+	// Action taken according to the drift policies.
+	Action DriftPolicy_Action `protobuf:"varint,7,opt,name=action,proto3,enum=istio_operator.v2.api.v1alpha1.DriftPolicy_Action" json:"action,omitempty"`
+}
+
+func (x *ResourceDrift) Reset() {
+	*x = ResourceDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_common_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDrift) ProtoMessage() {}
+
+func (x *ResourceDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_common_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDrift.ProtoReflect.Descriptor instead.
+func (*ResourceDrift) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResourceDrift) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ResourceDrift) GetManagers() []string {
+	if x != nil {
+		return x.Managers
+	}
+	return nil
+}
+
+func (x *ResourceDrift) GetAction() DriftPolicy_Action {
+	if x != nil {
+		return x.Action
+	}
+	return DriftPolicy_unspecified
+}
+
 // Quantity is a fixed-point representation of a number. It provides convenient marshaling/unmarshaling in JSON and YAML, in addition to String() and Int64() accessors.
 // +cue-gen-param:intorstring=true
 // +cue-gen-param:set=pattern:^(\\+|-)?(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\\+|-)?(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))))?$
@@ -2030,7 +2314,7 @@ type DeploymentStrategy_RollingUpdateDeployment struct {
 func (x *DeploymentStrategy_RollingUpdateDeployment) Reset() {
 	*x = DeploymentStrategy_RollingUpdateDeployment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_common_proto_msgTypes[21]
+		mi := &file_api_v1alpha1_common_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2043,7 +2327,7 @@ func (x *DeploymentStrategy_RollingUpdateDeployment) String() string {
 func (*DeploymentStrategy_RollingUpdateDeployment) ProtoMessage() {}
 
 func (x *DeploymentStrategy_RollingUpdateDeployment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_common_proto_msgTypes[21]
+	mi := &file_api_v1alpha1_common_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2073,6 +2357,8 @@ func (x *DeploymentStrategy_RollingUpdateDeployment) GetMaxSurge() *IntOrString
 	return nil
 }
 
+// GroupVersionKind of the resources to patch. Empty fields match any value,
+// the fields may contain shell file name patterns, e.g. "*" or "Deploy*".
 type K8SResourceOverlayPatch_GroupVersionKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2086,7 +2372,7 @@ type K8SResourceOverlayPatch_GroupVersionKind struct {
 func (x *K8SResourceOverlayPatch_GroupVersionKind) Reset() {
 	*x = K8SResourceOverlayPatch_GroupVersionKind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_common_proto_msgTypes[26]
+		mi := &file_api_v1alpha1_common_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2099,7 +2385,7 @@ func (x *K8SResourceOverlayPatch_GroupVersionKind) String() string {
 func (*K8SResourceOverlayPatch_GroupVersionKind) ProtoMessage() {}
 
 func (x *K8SResourceOverlayPatch_GroupVersionKind) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_common_proto_msgTypes[26]
+	mi := &file_api_v1alpha1_common_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2141,16 +2427,20 @@ type K8SResourceOverlayPatch_Patch struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path       string                       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Value      string                       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Value of the patch. It is always parsed as YAML for the merge and strategicMerge types.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Whether to parse the value as YAML, otherwise it is used as a string.
 	ParseValue bool                         `protobuf:"varint,3,opt,name=parseValue,proto3" json:"parseValue,omitempty"`
 	Type       K8SResourceOverlayPatch_Type `protobuf:"varint,4,opt,name=type,proto3,enum=istio_operator.v2.api.v1alpha1.K8SResourceOverlayPatch_Type" json:"type,omitempty"`
+	// Source path of the move and copy operations.
+	From string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
 }
 
 func (x *K8SResourceOverlayPatch_Patch) Reset() {
 	*x = K8SResourceOverlayPatch_Patch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_common_proto_msgTypes[27]
+		mi := &file_api_v1alpha1_common_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2163,7 +2453,7 @@ func (x *K8SResourceOverlayPatch_Patch) String() string {
 func (*K8SResourceOverlayPatch_Patch) ProtoMessage() {}
 
 func (x *K8SResourceOverlayPatch_Patch) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_common_proto_msgTypes[27]
+	mi := &file_api_v1alpha1_common_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,6 +2497,13 @@ func (x *K8SResourceOverlayPatch_Patch) GetType() K8SResourceOverlayPatch_Type {
 	return K8SResourceOverlayPatch_unspecified
 }
 
+func (x *K8SResourceOverlayPatch_Patch) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
 var File_api_v1alpha1_common_proto protoreflect.FileDescriptor
 
 var file_api_v1alpha1_common_proto_rawDesc = []byte{
@@ -2645,7 +2942,7 @@ var file_api_v1alpha1_common_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1e, 0x74, 0x61, 0x72, 0x67,
 	0x65, 0x74, 0x43, 0x50, 0x55, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xe6, 0x04, 0x0a, 0x17, 0x4b,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xe6, 0x05, 0x0a, 0x17, 0x4b,
 	0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
 	0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x74, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
@@ -2665,38 +2962,88 @@ var file_api_v1alpha1_common_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x50,
 	0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x1a, 0x56, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0xa3, 0x01, 0x0a, 0x05,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x50, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e,
+	0x68, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x56, 0x0a, 0x10, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x1a, 0xb7, 0x01, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x76, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x61, 0x64, 0x64, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x04,
+	0x12, 0x08, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x74, 0x65,
+	0x73, 0x74, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x10, 0x07, 0x12,
+	0x12, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x10, 0x08, 0x22, 0xa0, 0x03, 0x0a, 0x0b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x74, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e,
 	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
 	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b,
 	0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
-	0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x72, 0x65,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x10, 0x02, 0x22, 0x0a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
-	0x0d, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2a, 0x6f,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a,
-	0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x02,
-	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x10,
-	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x04,
-	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x10, 0x05, 0x42,
-	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
-	0x6e, 0x7a, 0x61, 0x69, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x4c, 0x0a, 0x09, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69,
+	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4a, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x43, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x75,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x10, 0x03, 0x22, 0xf5, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0a,
+	0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x49, 0x6e,
+	0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2a, 0x6f, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x10, 0x05, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x7a, 0x61, 0x69, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2711,121 +3058,128 @@ func file_api_v1alpha1_common_proto_rawDescGZIP() []byte {
 	return file_api_v1alpha1_common_proto_rawDescData
 }
 
-var file_api_v1alpha1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1alpha1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_api_v1alpha1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1alpha1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_api_v1alpha1_common_proto_goTypes = []interface{}{
 	(ConfigState)(0),                             // 0: istio_operator.v2.api.v1alpha1.ConfigState
 	(K8SResourceOverlayPatch_Type)(0),            // 1: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Type
-	(*K8SObjectMeta)(nil),                        // 2: istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	(*ContainerImageConfiguration)(nil),          // 3: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
-	(*BaseKubernetesContainerConfiguration)(nil), // 4: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
-	(*BaseKubernetesResourceConfig)(nil),         // 5: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	(*DeploymentStrategy)(nil),                   // 6: istio_operator.v2.api.v1alpha1.DeploymentStrategy
-	(*PodDisruptionBudget)(nil),                  // 7: istio_operator.v2.api.v1alpha1.PodDisruptionBudget
-	(*Probe)(nil),                                // 8: istio_operator.v2.api.v1alpha1.Probe
-	(*HTTPGetAction)(nil),                        // 9: istio_operator.v2.api.v1alpha1.HTTPGetAction
-	(*TCPSocketAction)(nil),                      // 10: istio_operator.v2.api.v1alpha1.TCPSocketAction
-	(*Service)(nil),                              // 11: istio_operator.v2.api.v1alpha1.Service
-	(*UnprotectedService)(nil),                   // 12: istio_operator.v2.api.v1alpha1.UnprotectedService
-	(*ServicePort)(nil),                          // 13: istio_operator.v2.api.v1alpha1.ServicePort
-	(*NamespacedName)(nil),                       // 14: istio_operator.v2.api.v1alpha1.NamespacedName
-	(*ResourceRequirements)(nil),                 // 15: istio_operator.v2.api.v1alpha1.ResourceRequirements
-	(*Replicas)(nil),                             // 16: istio_operator.v2.api.v1alpha1.Replicas
-	(*K8SResourceOverlayPatch)(nil),              // 17: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	(*Quantity)(nil),                             // 18: istio_operator.v2.api.v1alpha1.Quantity
-	(*IntOrString)(nil),                          // 19: istio_operator.v2.api.v1alpha1.IntOrString
-	nil,                                          // 20: istio_operator.v2.api.v1alpha1.K8sObjectMeta.LabelsEntry
-	nil,                                          // 21: istio_operator.v2.api.v1alpha1.K8sObjectMeta.AnnotationsEntry
-	nil,                                          // 22: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.NodeSelectorEntry
-	(*DeploymentStrategy_RollingUpdateDeployment)(nil), // 23: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment
-	nil, // 24: istio_operator.v2.api.v1alpha1.Service.SelectorEntry
-	nil, // 25: istio_operator.v2.api.v1alpha1.UnprotectedService.SelectorEntry
-	nil, // 26: istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry
-	nil, // 27: istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry
-	(*K8SResourceOverlayPatch_GroupVersionKind)(nil), // 28: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind
-	(*K8SResourceOverlayPatch_Patch)(nil),            // 29: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch
-	(*v1.LocalObjectReference)(nil),                  // 30: k8s.io.api.core.v1.LocalObjectReference
-	(*v1.EnvVar)(nil),                                // 31: k8s.io.api.core.v1.EnvVar
-	(*v1.SecurityContext)(nil),                       // 32: k8s.io.api.core.v1.SecurityContext
-	(*v1.VolumeMount)(nil),                           // 33: k8s.io.api.core.v1.VolumeMount
-	(*v1.Affinity)(nil),                              // 34: k8s.io.api.core.v1.Affinity
-	(*v1.Toleration)(nil),                            // 35: k8s.io.api.core.v1.Toleration
-	(*v1.Volume)(nil),                                // 36: k8s.io.api.core.v1.Volume
-	(*v1.PodSecurityContext)(nil),                    // 37: k8s.io.api.core.v1.PodSecurityContext
-	(*v1.TopologySpreadConstraint)(nil),              // 38: k8s.io.api.core.v1.TopologySpreadConstraint
-	(*v1.ExecAction)(nil),                            // 39: k8s.io.api.core.v1.ExecAction
-	(*v1.GRPCAction)(nil),                            // 40: k8s.io.api.core.v1.GRPCAction
-	(*v1.HTTPHeader)(nil),                            // 41: k8s.io.api.core.v1.HTTPHeader
-	(*wrappers.BoolValue)(nil),                       // 42: google.protobuf.BoolValue
-	(*v1.SessionAffinityConfig)(nil),                 // 43: k8s.io.api.core.v1.SessionAffinityConfig
-	(*wrappers.Int32Value)(nil),                      // 44: google.protobuf.Int32Value
+	(DriftPolicy_Action)(0),                      // 2: istio_operator.v2.api.v1alpha1.DriftPolicy.Action
+	(*K8SObjectMeta)(nil),                        // 3: istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	(*ContainerImageConfiguration)(nil),          // 4: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
+	(*BaseKubernetesContainerConfiguration)(nil), // 5: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
+	(*BaseKubernetesResourceConfig)(nil),         // 6: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	(*DeploymentStrategy)(nil),                   // 7: istio_operator.v2.api.v1alpha1.DeploymentStrategy
+	(*PodDisruptionBudget)(nil),                  // 8: istio_operator.v2.api.v1alpha1.PodDisruptionBudget
+	(*Probe)(nil),                                // 9: istio_operator.v2.api.v1alpha1.Probe
+	(*HTTPGetAction)(nil),                        // 10: istio_operator.v2.api.v1alpha1.HTTPGetAction
+	(*TCPSocketAction)(nil),                      // 11: istio_operator.v2.api.v1alpha1.TCPSocketAction
+	(*Service)(nil),                              // 12: istio_operator.v2.api.v1alpha1.Service
+	(*UnprotectedService)(nil),                   // 13: istio_operator.v2.api.v1alpha1.UnprotectedService
+	(*ServicePort)(nil),                          // 14: istio_operator.v2.api.v1alpha1.ServicePort
+	(*NamespacedName)(nil),                       // 15: istio_operator.v2.api.v1alpha1.NamespacedName
+	(*ResourceRequirements)(nil),                 // 16: istio_operator.v2.api.v1alpha1.ResourceRequirements
+	(*Replicas)(nil),                             // 17: istio_operator.v2.api.v1alpha1.Replicas
+	(*K8SResourceOverlayPatch)(nil),              // 18: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	(*DriftPolicy)(nil),                          // 19: istio_operator.v2.api.v1alpha1.DriftPolicy
+	(*ResourceDrift)(nil),                        // 20: istio_operator.v2.api.v1alpha1.ResourceDrift
+	(*Quantity)(nil),                             // 21: istio_operator.v2.api.v1alpha1.Quantity
+	(*IntOrString)(nil),                          // 22: istio_operator.v2.api.v1alpha1.IntOrString
+	nil,                                          // 23: istio_operator.v2.api.v1alpha1.K8sObjectMeta.LabelsEntry
+	nil,                                          // 24: istio_operator.v2.api.v1alpha1.K8sObjectMeta.AnnotationsEntry
+	nil,                                          // 25: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.NodeSelectorEntry
+	(*DeploymentStrategy_RollingUpdateDeployment)(nil), // 26: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment
+	nil, // 27: istio_operator.v2.api.v1alpha1.Service.SelectorEntry
+	nil, // 28: istio_operator.v2.api.v1alpha1.UnprotectedService.SelectorEntry
+	nil, // 29: istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry
+	nil, // 30: istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry
+	(*K8SResourceOverlayPatch_GroupVersionKind)(nil), // 31: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind
+	(*K8SResourceOverlayPatch_Patch)(nil),            // 32: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch
+	(*v1.LocalObjectReference)(nil),                  // 33: k8s.io.api.core.v1.LocalObjectReference
+	(*v1.EnvVar)(nil),                                // 34: k8s.io.api.core.v1.EnvVar
+	(*v1.SecurityContext)(nil),                       // 35: k8s.io.api.core.v1.SecurityContext
+	(*v1.VolumeMount)(nil),                           // 36: k8s.io.api.core.v1.VolumeMount
+	(*v1.Affinity)(nil),                              // 37: k8s.io.api.core.v1.Affinity
+	(*v1.Toleration)(nil),                            // 38: k8s.io.api.core.v1.Toleration
+	(*v1.Volume)(nil),                                // 39: k8s.io.api.core.v1.Volume
+	(*v1.PodSecurityContext)(nil),                    // 40: k8s.io.api.core.v1.PodSecurityContext
+	(*v1.TopologySpreadConstraint)(nil),              // 41: k8s.io.api.core.v1.TopologySpreadConstraint
+	(*v1.ExecAction)(nil),                            // 42: k8s.io.api.core.v1.ExecAction
+	(*v1.GRPCAction)(nil),                            // 43: k8s.io.api.core.v1.GRPCAction
+	(*v1.HTTPHeader)(nil),                            // 44: k8s.io.api.core.v1.HTTPHeader
+	(*wrappers.BoolValue)(nil),                       // 45: google.protobuf.BoolValue
+	(*v1.SessionAffinityConfig)(nil),                 // 46: k8s.io.api.core.v1.SessionAffinityConfig
+	(*wrappers.Int32Value)(nil),                      // 47: google.protobuf.Int32Value
 }
 var file_api_v1alpha1_common_proto_depIdxs = []int32{
-	20, // 0: istio_operator.v2.api.v1alpha1.K8sObjectMeta.labels:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta.LabelsEntry
-	21, // 1: istio_operator.v2.api.v1alpha1.K8sObjectMeta.annotations:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta.AnnotationsEntry
-	30, // 2: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration.imagePullSecrets:type_name -> k8s.io.api.core.v1.LocalObjectReference
-	31, // 3: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.env:type_name -> k8s.io.api.core.v1.EnvVar
-	15, // 4: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
-	32, // 5: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.securityContext:type_name -> k8s.io.api.core.v1.SecurityContext
-	33, // 6: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.volumeMounts:type_name -> k8s.io.api.core.v1.VolumeMount
-	2,  // 7: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	31, // 8: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.env:type_name -> k8s.io.api.core.v1.EnvVar
-	15, // 9: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
-	22, // 10: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.nodeSelector:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.NodeSelectorEntry
-	34, // 11: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.affinity:type_name -> k8s.io.api.core.v1.Affinity
-	32, // 12: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.securityContext:type_name -> k8s.io.api.core.v1.SecurityContext
-	30, // 13: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.imagePullSecrets:type_name -> k8s.io.api.core.v1.LocalObjectReference
-	35, // 14: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.tolerations:type_name -> k8s.io.api.core.v1.Toleration
-	36, // 15: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.volumes:type_name -> k8s.io.api.core.v1.Volume
-	33, // 16: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.volumeMounts:type_name -> k8s.io.api.core.v1.VolumeMount
-	16, // 17: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.replicas:type_name -> istio_operator.v2.api.v1alpha1.Replicas
-	2,  // 18: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podMetadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	7,  // 19: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podDisruptionBudget:type_name -> istio_operator.v2.api.v1alpha1.PodDisruptionBudget
-	6,  // 20: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.deploymentStrategy:type_name -> istio_operator.v2.api.v1alpha1.DeploymentStrategy
-	37, // 21: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podSecurityContext:type_name -> k8s.io.api.core.v1.PodSecurityContext
-	8,  // 22: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.livenessProbe:type_name -> istio_operator.v2.api.v1alpha1.Probe
-	8,  // 23: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.readinessProbe:type_name -> istio_operator.v2.api.v1alpha1.Probe
-	38, // 24: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.topologySpreadConstraints:type_name -> k8s.io.api.core.v1.TopologySpreadConstraint
-	23, // 25: istio_operator.v2.api.v1alpha1.DeploymentStrategy.rollingUpdate:type_name -> istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment
-	19, // 26: istio_operator.v2.api.v1alpha1.PodDisruptionBudget.minAvailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	19, // 27: istio_operator.v2.api.v1alpha1.PodDisruptionBudget.maxUnavailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	39, // 28: istio_operator.v2.api.v1alpha1.Probe.exec:type_name -> k8s.io.api.core.v1.ExecAction
-	9,  // 29: istio_operator.v2.api.v1alpha1.Probe.httpGet:type_name -> istio_operator.v2.api.v1alpha1.HTTPGetAction
-	10, // 30: istio_operator.v2.api.v1alpha1.Probe.tcpSocket:type_name -> istio_operator.v2.api.v1alpha1.TCPSocketAction
-	40, // 31: istio_operator.v2.api.v1alpha1.Probe.grpc:type_name -> k8s.io.api.core.v1.GRPCAction
-	19, // 32: istio_operator.v2.api.v1alpha1.HTTPGetAction.port:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	41, // 33: istio_operator.v2.api.v1alpha1.HTTPGetAction.httpHeaders:type_name -> k8s.io.api.core.v1.HTTPHeader
-	19, // 34: istio_operator.v2.api.v1alpha1.TCPSocketAction.port:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	2,  // 35: istio_operator.v2.api.v1alpha1.Service.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	13, // 36: istio_operator.v2.api.v1alpha1.Service.ports:type_name -> istio_operator.v2.api.v1alpha1.ServicePort
-	24, // 37: istio_operator.v2.api.v1alpha1.Service.selector:type_name -> istio_operator.v2.api.v1alpha1.Service.SelectorEntry
-	42, // 38: istio_operator.v2.api.v1alpha1.Service.publishNotReadyAddresses:type_name -> google.protobuf.BoolValue
-	43, // 39: istio_operator.v2.api.v1alpha1.Service.sessionAffinityConfig:type_name -> k8s.io.api.core.v1.SessionAffinityConfig
-	2,  // 40: istio_operator.v2.api.v1alpha1.UnprotectedService.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	13, // 41: istio_operator.v2.api.v1alpha1.UnprotectedService.ports:type_name -> istio_operator.v2.api.v1alpha1.ServicePort
-	25, // 42: istio_operator.v2.api.v1alpha1.UnprotectedService.selector:type_name -> istio_operator.v2.api.v1alpha1.UnprotectedService.SelectorEntry
-	42, // 43: istio_operator.v2.api.v1alpha1.UnprotectedService.publishNotReadyAddresses:type_name -> google.protobuf.BoolValue
-	43, // 44: istio_operator.v2.api.v1alpha1.UnprotectedService.sessionAffinityConfig:type_name -> k8s.io.api.core.v1.SessionAffinityConfig
-	19, // 45: istio_operator.v2.api.v1alpha1.ServicePort.targetPort:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	26, // 46: istio_operator.v2.api.v1alpha1.ResourceRequirements.limits:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry
-	27, // 47: istio_operator.v2.api.v1alpha1.ResourceRequirements.requests:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry
-	44, // 48: istio_operator.v2.api.v1alpha1.Replicas.count:type_name -> google.protobuf.Int32Value
-	44, // 49: istio_operator.v2.api.v1alpha1.Replicas.min:type_name -> google.protobuf.Int32Value
-	44, // 50: istio_operator.v2.api.v1alpha1.Replicas.max:type_name -> google.protobuf.Int32Value
-	44, // 51: istio_operator.v2.api.v1alpha1.Replicas.targetCPUUtilizationPercentage:type_name -> google.protobuf.Int32Value
-	28, // 52: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.groupVersionKind:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind
-	14, // 53: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.objectKey:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
-	29, // 54: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.patches:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch
-	19, // 55: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment.maxUnavailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	19, // 56: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment.maxSurge:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
-	18, // 57: istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry.value:type_name -> istio_operator.v2.api.v1alpha1.Quantity
-	18, // 58: istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry.value:type_name -> istio_operator.v2.api.v1alpha1.Quantity
-	1,  // 59: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch.type:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Type
-	60, // [60:60] is the sub-list for method output_type
-	60, // [60:60] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
+	23, // 0: istio_operator.v2.api.v1alpha1.K8sObjectMeta.labels:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta.LabelsEntry
+	24, // 1: istio_operator.v2.api.v1alpha1.K8sObjectMeta.annotations:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta.AnnotationsEntry
+	33, // 2: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration.imagePullSecrets:type_name -> k8s.io.api.core.v1.LocalObjectReference
+	34, // 3: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.env:type_name -> k8s.io.api.core.v1.EnvVar
+	16, // 4: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
+	35, // 5: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.securityContext:type_name -> k8s.io.api.core.v1.SecurityContext
+	36, // 6: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration.volumeMounts:type_name -> k8s.io.api.core.v1.VolumeMount
+	3,  // 7: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	34, // 8: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.env:type_name -> k8s.io.api.core.v1.EnvVar
+	16, // 9: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
+	25, // 10: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.nodeSelector:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.NodeSelectorEntry
+	37, // 11: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.affinity:type_name -> k8s.io.api.core.v1.Affinity
+	35, // 12: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.securityContext:type_name -> k8s.io.api.core.v1.SecurityContext
+	33, // 13: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.imagePullSecrets:type_name -> k8s.io.api.core.v1.LocalObjectReference
+	38, // 14: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.tolerations:type_name -> k8s.io.api.core.v1.Toleration
+	39, // 15: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.volumes:type_name -> k8s.io.api.core.v1.Volume
+	36, // 16: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.volumeMounts:type_name -> k8s.io.api.core.v1.VolumeMount
+	17, // 17: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.replicas:type_name -> istio_operator.v2.api.v1alpha1.Replicas
+	3,  // 18: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podMetadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	8,  // 19: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podDisruptionBudget:type_name -> istio_operator.v2.api.v1alpha1.PodDisruptionBudget
+	7,  // 20: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.deploymentStrategy:type_name -> istio_operator.v2.api.v1alpha1.DeploymentStrategy
+	40, // 21: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.podSecurityContext:type_name -> k8s.io.api.core.v1.PodSecurityContext
+	9,  // 22: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.livenessProbe:type_name -> istio_operator.v2.api.v1alpha1.Probe
+	9,  // 23: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.readinessProbe:type_name -> istio_operator.v2.api.v1alpha1.Probe
+	41, // 24: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig.topologySpreadConstraints:type_name -> k8s.io.api.core.v1.TopologySpreadConstraint
+	26, // 25: istio_operator.v2.api.v1alpha1.DeploymentStrategy.rollingUpdate:type_name -> istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment
+	22, // 26: istio_operator.v2.api.v1alpha1.PodDisruptionBudget.minAvailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	22, // 27: istio_operator.v2.api.v1alpha1.PodDisruptionBudget.maxUnavailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	42, // 28: istio_operator.v2.api.v1alpha1.Probe.exec:type_name -> k8s.io.api.core.v1.ExecAction
+	10, // 29: istio_operator.v2.api.v1alpha1.Probe.httpGet:type_name -> istio_operator.v2.api.v1alpha1.HTTPGetAction
+	11, // 30: istio_operator.v2.api.v1alpha1.Probe.tcpSocket:type_name -> istio_operator.v2.api.v1alpha1.TCPSocketAction
+	43, // 31: istio_operator.v2.api.v1alpha1.Probe.grpc:type_name -> k8s.io.api.core.v1.GRPCAction
+	22, // 32: istio_operator.v2.api.v1alpha1.HTTPGetAction.port:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	44, // 33: istio_operator.v2.api.v1alpha1.HTTPGetAction.httpHeaders:type_name -> k8s.io.api.core.v1.HTTPHeader
+	22, // 34: istio_operator.v2.api.v1alpha1.TCPSocketAction.port:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	3,  // 35: istio_operator.v2.api.v1alpha1.Service.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	14, // 36: istio_operator.v2.api.v1alpha1.Service.ports:type_name -> istio_operator.v2.api.v1alpha1.ServicePort
+	27, // 37: istio_operator.v2.api.v1alpha1.Service.selector:type_name -> istio_operator.v2.api.v1alpha1.Service.SelectorEntry
+	45, // 38: istio_operator.v2.api.v1alpha1.Service.publishNotReadyAddresses:type_name -> google.protobuf.BoolValue
+	46, // 39: istio_operator.v2.api.v1alpha1.Service.sessionAffinityConfig:type_name -> k8s.io.api.core.v1.SessionAffinityConfig
+	3,  // 40: istio_operator.v2.api.v1alpha1.UnprotectedService.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	14, // 41: istio_operator.v2.api.v1alpha1.UnprotectedService.ports:type_name -> istio_operator.v2.api.v1alpha1.ServicePort
+	28, // 42: istio_operator.v2.api.v1alpha1.UnprotectedService.selector:type_name -> istio_operator.v2.api.v1alpha1.UnprotectedService.SelectorEntry
+	45, // 43: istio_operator.v2.api.v1alpha1.UnprotectedService.publishNotReadyAddresses:type_name -> google.protobuf.BoolValue
+	46, // 44: istio_operator.v2.api.v1alpha1.UnprotectedService.sessionAffinityConfig:type_name -> k8s.io.api.core.v1.SessionAffinityConfig
+	22, // 45: istio_operator.v2.api.v1alpha1.ServicePort.targetPort:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	29, // 46: istio_operator.v2.api.v1alpha1.ResourceRequirements.limits:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry
+	30, // 47: istio_operator.v2.api.v1alpha1.ResourceRequirements.requests:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry
+	47, // 48: istio_operator.v2.api.v1alpha1.Replicas.count:type_name -> google.protobuf.Int32Value
+	47, // 49: istio_operator.v2.api.v1alpha1.Replicas.min:type_name -> google.protobuf.Int32Value
+	47, // 50: istio_operator.v2.api.v1alpha1.Replicas.max:type_name -> google.protobuf.Int32Value
+	47, // 51: istio_operator.v2.api.v1alpha1.Replicas.targetCPUUtilizationPercentage:type_name -> google.protobuf.Int32Value
+	31, // 52: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.groupVersionKind:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind
+	15, // 53: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.objectKey:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
+	32, // 54: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.patches:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch
+	31, // 55: istio_operator.v2.api.v1alpha1.DriftPolicy.groupVersionKind:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind
+	15, // 56: istio_operator.v2.api.v1alpha1.DriftPolicy.objectKey:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
+	2,  // 57: istio_operator.v2.api.v1alpha1.DriftPolicy.action:type_name -> istio_operator.v2.api.v1alpha1.DriftPolicy.Action
+	2,  // 58: istio_operator.v2.api.v1alpha1.ResourceDrift.action:type_name -> istio_operator.v2.api.v1alpha1.DriftPolicy.Action
+	22, // 59: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment.maxUnavailable:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	22, // 60: istio_operator.v2.api.v1alpha1.DeploymentStrategy.RollingUpdateDeployment.maxSurge:type_name -> istio_operator.v2.api.v1alpha1.IntOrString
+	21, // 61: istio_operator.v2.api.v1alpha1.ResourceRequirements.LimitsEntry.value:type_name -> istio_operator.v2.api.v1alpha1.Quantity
+	21, // 62: istio_operator.v2.api.v1alpha1.ResourceRequirements.RequestsEntry.value:type_name -> istio_operator.v2.api.v1alpha1.Quantity
+	1,  // 63: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch.type:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Type
+	64, // [64:64] is the sub-list for method output_type
+	64, // [64:64] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_api_v1alpha1_common_proto_init() }
@@ -3026,7 +3380,31 @@ func file_api_v1alpha1_common_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_common_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_common_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriftPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_common_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_common_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeploymentStrategy_RollingUpdateDeployment); i {
 			case 0:
 				return &v.state
@@ -3038,7 +3416,7 @@ func file_api_v1alpha1_common_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_common_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_common_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SResourceOverlayPatch_GroupVersionKind); i {
 			case 0:
 				return &v.state
@@ -3050,7 +3428,7 @@ func file_api_v1alpha1_common_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_common_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_common_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SResourceOverlayPatch_Patch); i {
 			case 0:
 				return &v.state
@@ -3074,8 +3452,8 @@ func file_api_v1alpha1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1alpha1_common_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   28,
+			NumEnums:      3,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -404,6 +404,48 @@ func (in *K8SResourceOverlayPatch_Patch) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using DriftPolicy within kubernetes types, where deepcopy-gen is used.
+func (in *DriftPolicy) DeepCopyInto(out *DriftPolicy) {
+	p := proto.Clone(in).(*DriftPolicy)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftPolicy. Required by controller-gen.
+func (in *DriftPolicy) DeepCopy() *DriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DriftPolicy. Required by controller-gen.
+func (in *DriftPolicy) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ResourceDrift within kubernetes types, where deepcopy-gen is used.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	p := proto.Clone(in).(*ResourceDrift)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift. Required by controller-gen.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift. Required by controller-gen.
+func (in *ResourceDrift) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Quantity within kubernetes types, where deepcopy-gen is used.
 func (in *Quantity) DeepCopyInto(out *Quantity) {
 	p := proto.Clone(in).(*Quantity)
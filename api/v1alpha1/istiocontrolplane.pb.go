@@ -25,12 +25,14 @@
 package v1alpha1
 
 import (
+	duration "github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	v1alpha1 "istio.io/api/mesh/v1alpha1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	reflect "reflect"
 	sync "sync"
 )
@@ -253,6 +255,174 @@ func (JWTPolicyType) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{3}
 }
 
+type AdoptionConfiguration_Mode int32
+
+const (
+	// Same as report.
+	AdoptionConfiguration_unspecified AdoptionConfiguration_Mode = 0
+	// Detects the existing resources and reports how they differ from the rendered ones without changing anything.
+	// The components of the control plane are not reconciled in this mode.
+	AdoptionConfiguration_report AdoptionConfiguration_Mode = 1
+	// Adds the owner references and labels of the control plane to the matching existing resources,
+	// then reconciles the components, updating the adopted resources in place.
+	AdoptionConfiguration_adopt AdoptionConfiguration_Mode = 2
+)
+
+// Enum value maps for AdoptionConfiguration_Mode.
+var (
+	AdoptionConfiguration_Mode_name = map[int32]string{
+		0: "unspecified",
+		1: "report",
+		2: "adopt",
+	}
+	AdoptionConfiguration_Mode_value = map[string]int32{
+		"unspecified": 0,
+		"report":      1,
+		"adopt":       2,
+	}
+)
+
+func (x AdoptionConfiguration_Mode) Enum() *AdoptionConfiguration_Mode {
+	p := new(AdoptionConfiguration_Mode)
+	*p = x
+	return p
+}
+
+func (x AdoptionConfiguration_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdoptionConfiguration_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1alpha1_istiocontrolplane_proto_enumTypes[4].Descriptor()
+}
+
+func (AdoptionConfiguration_Mode) Type() protoreflect.EnumType {
+	return &file_api_v1alpha1_istiocontrolplane_proto_enumTypes[4]
+}
+
+func (x AdoptionConfiguration_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdoptionConfiguration_Mode.Descriptor instead.
+func (AdoptionConfiguration_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type HealthGatesStatus_State int32
+
+const (
+	HealthGatesStatus_unspecified HealthGatesStatus_State = 0
+	// The gates have not passed yet and the timeout is not reached.
+	HealthGatesStatus_checking HealthGatesStatus_State = 1
+	// Every gate passed, the spec is known-good.
+	HealthGatesStatus_passed HealthGatesStatus_State = 2
+	// The gates did not pass within the timeout.
+	HealthGatesStatus_failed HealthGatesStatus_State = 3
+	// The gates did not pass within the timeout and the spec was reverted to a known-good one.
+	HealthGatesStatus_reverted HealthGatesStatus_State = 4
+)
+
+// Enum value maps for HealthGatesStatus_State.
+var (
+	HealthGatesStatus_State_name = map[int32]string{
+		0: "unspecified",
+		1: "checking",
+		2: "passed",
+		3: "failed",
+		4: "reverted",
+	}
+	HealthGatesStatus_State_value = map[string]int32{
+		"unspecified": 0,
+		"checking":    1,
+		"passed":      2,
+		"failed":      3,
+		"reverted":    4,
+	}
+)
+
+func (x HealthGatesStatus_State) Enum() *HealthGatesStatus_State {
+	p := new(HealthGatesStatus_State)
+	*p = x
+	return p
+}
+
+func (x HealthGatesStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthGatesStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1alpha1_istiocontrolplane_proto_enumTypes[5].Descriptor()
+}
+
+func (HealthGatesStatus_State) Type() protoreflect.EnumType {
+	return &file_api_v1alpha1_istiocontrolplane_proto_enumTypes[5]
+}
+
+func (x HealthGatesStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthGatesStatus_State.Descriptor instead.
+func (HealthGatesStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type AdoptedResource_State int32
+
+const (
+	AdoptedResource_unspecified AdoptedResource_State = 0
+	// The resource matches a rendered resource and will be adopted in adopt mode.
+	AdoptedResource_pending AdoptedResource_State = 1
+	// The resource was adopted by the control plane.
+	AdoptedResource_adopted AdoptedResource_State = 2
+	// The resource is not rendered by the control plane and is left unchanged.
+	AdoptedResource_unmatched AdoptedResource_State = 3
+)
+
+// Enum value maps for AdoptedResource_State.
+var (
+	AdoptedResource_State_name = map[int32]string{
+		0: "unspecified",
+		1: "pending",
+		2: "adopted",
+		3: "unmatched",
+	}
+	AdoptedResource_State_value = map[string]int32{
+		"unspecified": 0,
+		"pending":     1,
+		"adopted":     2,
+		"unmatched":   3,
+	}
+)
+
+func (x AdoptedResource_State) Enum() *AdoptedResource_State {
+	p := new(AdoptedResource_State)
+	*p = x
+	return p
+}
+
+func (x AdoptedResource_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdoptedResource_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1alpha1_istiocontrolplane_proto_enumTypes[6].Descriptor()
+}
+
+func (AdoptedResource_State) Type() protoreflect.EnumType {
+	return &file_api_v1alpha1_istiocontrolplane_proto_enumTypes[6]
+}
+
+func (x AdoptedResource_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdoptedResource_State.Descriptor instead.
+func (AdoptedResource_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{38, 0}
+}
+
 // IstioControlPlane defines an Istio control plane
 //
 // <!-- crd generation tags
@@ -315,7 +485,7 @@ type IstioControlPlaneSpec struct {
 	// ProxyWasm configuration options.
 	ProxyWasm *ProxyWasmConfiguration `protobuf:"bytes,10,opt,name=proxyWasm,proto3" json:"proxyWasm,omitempty"`
 	// Whether to restrict the applications namespace the controller manages.
-	// If not set, controller watches all namespaces
+	// If not set, controller watches all namespaces. Use discoverySelectors to watch a set of namespaces.
 	WatchOneNamespace *wrappers.BoolValue `protobuf:"bytes,11,opt,name=watchOneNamespace,proto3" json:"watchOneNamespace,omitempty"`
 	// Configure the policy for validating JWT.
 	// Currently, two options are supported: "third-party-jwt" and "first-party-jwt".
@@ -346,12 +516,39 @@ type IstioControlPlaneSpec struct {
 	ClusterID string `protobuf:"bytes,22,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	// Network defines the network this cluster belongs to. This name
 	// corresponds to the networks in the map of mesh networks.
-	// +default=network1
+	// Defaults to network1 if it is set neither here nor in the referenced profile.
 	NetworkName string `protobuf:"bytes,23,opt,name=networkName,proto3" json:"networkName,omitempty"`
 	// Standalone sidecar injector configuration.
 	SidecarInjector *SidecarInjectorConfiguration `protobuf:"bytes,24,opt,name=sidecarInjector,proto3" json:"sidecarInjector,omitempty"`
 	// Tracing defines configuration for the tracing performed by Envoy instances.
 	Tracer *v1alpha1.Tracing `protobuf:"bytes,25,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	// Network level settings of this cluster used for generating mesh networks and locality failover settings.
+	Network *NetworkConfiguration `protobuf:"bytes,26,opt,name=network,proto3" json:"network,omitempty"`
+	// Default Sidecar resource generation for the namespaces which are enabled for injection.
+	DefaultSidecar *DefaultSidecarConfiguration `protobuf:"bytes,27,opt,name=defaultSidecar,proto3" json:"defaultSidecar,omitempty"`
+	// Egress traffic control configuration.
+	Egress *EgressConfiguration `protobuf:"bytes,28,opt,name=egress,proto3" json:"egress,omitempty"`
+	// Mesh wide mTLS configuration.
+	Mtls *MTLSConfiguration `protobuf:"bytes,29,opt,name=mtls,proto3" json:"mtls,omitempty"`
+	// Policy of which IstioMeshGateways are allowed to attach to the control plane.
+	MeshGatewayAttachment *MeshGatewayAttachmentPolicy `protobuf:"bytes,30,opt,name=meshGatewayAttachment,proto3" json:"meshGatewayAttachment,omitempty"`
+	// User-defined components rendered from external Helm charts and managed together with the control plane.
+	Addons []*AddonComponent `protobuf:"bytes,31,rep,name=addons,proto3" json:"addons,omitempty"`
+	// Policies of how the changes made by others to the resources of the components are handled.
+	DriftPolicies []*DriftPolicy `protobuf:"bytes,32,rep,name=driftPolicies,proto3" json:"driftPolicies,omitempty"`
+	// Name of the IstioControlPlaneProfile resource used as the baseline configuration of the control plane.
+	// The fields set in this spec override the ones coming from the profile.
+	Profile string `protobuf:"bytes,33,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Adoption of the resources of an existing Istio installation (e.g. installed by Helm or istioctl) by the control plane.
+	Adoption *AdoptionConfiguration `protobuf:"bytes,34,opt,name=adoption,proto3" json:"adoption,omitempty"`
+	// Health gates checked after the spec of the control plane is applied, and the revert to the last known-good spec.
+	HealthGates *HealthGatesConfiguration `protobuf:"bytes,35,opt,name=healthGates,proto3" json:"healthGates,omitempty"`
+	// Periodic self-test of the sidecar injection with dry-run pod admissions.
+	InjectionSelfTest *InjectionSelfTestConfiguration `protobuf:"bytes,36,opt,name=injectionSelfTest,proto3" json:"injectionSelfTest,omitempty"`
+	// Label selectors of the namespaces istiod discovers, a namespace is discovered when any of the selectors matches it.
+	// The selectors are set as the discoverySelectors of the mesh config, overriding the ones set in meshConfig.
+	// Only the namespaces matching the selectors are set for injection for the control plane.
+	DiscoverySelectors []*v1.LabelSelector `protobuf:"bytes,37,rep,name=discoverySelectors,proto3" json:"discoverySelectors,omitempty"`
 }
 
 func (x *IstioControlPlaneSpec) Reset() {
@@ -561,6 +758,90 @@ func (x *IstioControlPlaneSpec) GetTracer() *v1alpha1.Tracing {
 	return nil
 }
 
+func (x *IstioControlPlaneSpec) GetNetwork() *NetworkConfiguration {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetDefaultSidecar() *DefaultSidecarConfiguration {
+	if x != nil {
+		return x.DefaultSidecar
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetEgress() *EgressConfiguration {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetMtls() *MTLSConfiguration {
+	if x != nil {
+		return x.Mtls
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetMeshGatewayAttachment() *MeshGatewayAttachmentPolicy {
+	if x != nil {
+		return x.MeshGatewayAttachment
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetAddons() []*AddonComponent {
+	if x != nil {
+		return x.Addons
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetDriftPolicies() []*DriftPolicy {
+	if x != nil {
+		return x.DriftPolicies
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *IstioControlPlaneSpec) GetAdoption() *AdoptionConfiguration {
+	if x != nil {
+		return x.Adoption
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetHealthGates() *HealthGatesConfiguration {
+	if x != nil {
+		return x.HealthGates
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetInjectionSelfTest() *InjectionSelfTestConfiguration {
+	if x != nil {
+		return x.InjectionSelfTest
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneSpec) GetDiscoverySelectors() []*v1.LabelSelector {
+	if x != nil {
+		return x.DiscoverySelectors
+	}
+	return nil
+}
+
 type SidecarInjectorConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -761,6 +1042,10 @@ type MeshExpansionConfiguration struct {
 	Webhook *MeshExpansionConfiguration_Webhook `protobuf:"bytes,4,opt,name=webhook,proto3" json:"webhook,omitempty"`
 	// cluster services configuration
 	ClusterServices *MeshExpansionConfiguration_ClusterServices `protobuf:"bytes,5,opt,name=clusterServices,proto3" json:"clusterServices,omitempty"`
+	// Additional mesh expansion gateways, e.g. for running a gateway per zone or for separating
+	// the exposure of istiod and the webhook from the cluster services traffic.
+	// The addresses of all the mesh expansion gateways are used for reaching the cluster.
+	AdditionalGateways []*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration `protobuf:"bytes,6,rep,name=additionalGateways,proto3" json:"additionalGateways,omitempty"`
 }
 
 func (x *MeshExpansionConfiguration) Reset() {
@@ -830,20 +1115,41 @@ func (x *MeshExpansionConfiguration) GetClusterServices() *MeshExpansionConfigur
 	return nil
 }
 
-// Comma-separated minimum per-scope logging level of messages to output, in the form of <scope>:<level>,<scope>:<level>
-// The control plane has different scopes depending on component, but can configure default log level across all components
-// If empty, default scope and level will be used as configured in code
-type LoggingConfiguration struct {
+func (x *MeshExpansionConfiguration) GetAdditionalGateways() []*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration {
+	if x != nil {
+		return x.AdditionalGateways
+	}
+	return nil
+}
+
+// NetworkConfiguration defines network level settings of the cluster
+type NetworkConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// +kubebuilder:validation:Pattern=`^([a-zA-Z]+:[a-zA-Z]+,?)+$`
-	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-}
-
-func (x *LoggingConfiguration) Reset() {
-	*x = LoggingConfiguration{}
+	// Port of the cross-network gateway on which the other networks reach this network.
+	// Defaults to 15443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	GatewayPort *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=gatewayPort,proto3" json:"gatewayPort,omitempty"`
+	// Fully qualified name of the cross-network gateway service in the service registry,
+	// e.g. istio-meshexpansion-cp-v117x.istio-system.svc.cluster.local.
+	// If set, the gateway is referenced by this name in the mesh networks instead of by its addresses.
+	GatewayRegistryServiceName string `protobuf:"bytes,2,opt,name=gatewayRegistryServiceName,proto3" json:"gatewayRegistryServiceName,omitempty"`
+	// Weight of this network. When generating locality failover settings, regions of networks
+	// with higher weight are preferred as failover targets. The gateways of the mesh networks
+	// have no weight in Istio, so it does not affect the mesh networks configuration.
+	Weight *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Region this cluster belongs to. If not set, it is detected from the
+	// topology.kubernetes.io/region label of the nodes.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Locality failover configuration
+	LocalityFailover *LocalityFailoverConfiguration `protobuf:"bytes,5,opt,name=localityFailover,proto3" json:"localityFailover,omitempty"`
+}
+
+func (x *NetworkConfiguration) Reset() {
+	*x = NetworkConfiguration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,13 +1157,13 @@ func (x *LoggingConfiguration) Reset() {
 	}
 }
 
-func (x *LoggingConfiguration) String() string {
+func (x *NetworkConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoggingConfiguration) ProtoMessage() {}
+func (*NetworkConfiguration) ProtoMessage() {}
 
-func (x *LoggingConfiguration) ProtoReflect() protoreflect.Message {
+func (x *NetworkConfiguration) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,33 +1175,61 @@ func (x *LoggingConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoggingConfiguration.ProtoReflect.Descriptor instead.
-func (*LoggingConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkConfiguration.ProtoReflect.Descriptor instead.
+func (*NetworkConfiguration) Descriptor() ([]byte, []int) {
 	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LoggingConfiguration) GetLevel() string {
+func (x *NetworkConfiguration) GetGatewayPort() *wrappers.UInt32Value {
 	if x != nil {
-		return x.Level
+		return x.GatewayPort
+	}
+	return nil
+}
+
+func (x *NetworkConfiguration) GetGatewayRegistryServiceName() string {
+	if x != nil {
+		return x.GatewayRegistryServiceName
 	}
 	return ""
 }
 
-// SDSConfiguration defines Secret Discovery Service config options
-type SDSConfiguration struct {
+func (x *NetworkConfiguration) GetWeight() *wrappers.UInt32Value {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *NetworkConfiguration) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NetworkConfiguration) GetLocalityFailover() *LocalityFailoverConfiguration {
+	if x != nil {
+		return x.LocalityFailover
+	}
+	return nil
+}
+
+// LocalityFailoverConfiguration defines settings for generating a mesh wide DestinationRule
+// with locality load balancer failover settings derived from the regions of the peer control planes
+type LocalityFailoverConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The JWT token for SDS and the aud field of such JWT. See RFC 7519, section 4.1.3.
-	// When a CSR is sent from Citadel Agent to the CA (e.g. Citadel), this aud is to make sure the
-	//
-	//	JWT is intended for the CA.
-	TokenAudience string `protobuf:"bytes,1,opt,name=tokenAudience,proto3" json:"tokenAudience,omitempty"`
+	// Whether to generate the locality failover DestinationRule.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Host the DestinationRule applies to. Defaults to *.<cluster domain>.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
 }
 
-func (x *SDSConfiguration) Reset() {
-	*x = SDSConfiguration{}
+func (x *LocalityFailoverConfiguration) Reset() {
+	*x = LocalityFailoverConfiguration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,13 +1237,13 @@ func (x *SDSConfiguration) Reset() {
 	}
 }
 
-func (x *SDSConfiguration) String() string {
+func (x *LocalityFailoverConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SDSConfiguration) ProtoMessage() {}
+func (*LocalityFailoverConfiguration) ProtoMessage() {}
 
-func (x *SDSConfiguration) ProtoReflect() protoreflect.Message {
+func (x *LocalityFailoverConfiguration) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,58 +1255,45 @@ func (x *SDSConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SDSConfiguration.ProtoReflect.Descriptor instead.
-func (*SDSConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocalityFailoverConfiguration.ProtoReflect.Descriptor instead.
+func (*LocalityFailoverConfiguration) Descriptor() ([]byte, []int) {
 	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SDSConfiguration) GetTokenAudience() string {
+func (x *LocalityFailoverConfiguration) GetEnabled() *wrappers.BoolValue {
 	if x != nil {
-		return x.TokenAudience
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *LocalityFailoverConfiguration) GetHost() string {
+	if x != nil {
+		return x.Host
 	}
 	return ""
 }
 
-// ProxyConfiguration defines config options for Proxy
-type ProxyConfiguration struct {
+// DefaultSidecarConfiguration defines settings for generating a default Sidecar resource in every namespace
+// which is enabled for injection for the control plane. The generated Sidecar resources only allow egress to
+// the namespace of the control plane, to the namespace itself and to the namespaces listed in the
+// sidecar.istio.servicemesh.cisco.com/egress-namespaces annotation of the namespace.
+// Namespaces with a user defined namespace wide Sidecar resource are skipped.
+type DefaultSidecarConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	// If set to true, istio-proxy container will have privileged securityContext
-	Privileged *wrappers.BoolValue `protobuf:"bytes,2,opt,name=privileged,proto3" json:"privileged,omitempty"`
-	// If set, newly injected sidecars will have core dumps enabled.
-	EnableCoreDump *wrappers.BoolValue `protobuf:"bytes,3,opt,name=enableCoreDump,proto3" json:"enableCoreDump,omitempty"`
-	// Log level for proxy, applies to gateways and sidecars. If left empty, "warning" is used.
-	// Expected values are: trace|debug|info|warning|error|critical|off
-	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARNING;ERROR;CRITICAL;OFF
-	LogLevel ProxyLogLevel `protobuf:"varint,4,opt,name=logLevel,proto3,enum=istio_operator.v2.api.v1alpha1.ProxyLogLevel" json:"logLevel,omitempty"`
-	// Per Component log level for proxy, applies to gateways and sidecars. If a component level is
-	// not set, then the "LogLevel" will be used. If left empty, "misc:error" is used.
-	ComponentLogLevel string `protobuf:"bytes,5,opt,name=componentLogLevel,proto3" json:"componentLogLevel,omitempty"`
-	// cluster domain. Default value is "cluster.local"
-	ClusterDomain string `protobuf:"bytes,6,opt,name=clusterDomain,proto3" json:"clusterDomain,omitempty"`
-	// Controls if sidecar is injected at the front of the container list and blocks
-	// the start of the other containers until the proxy is ready
-	// Default value is 'false'.
-	HoldApplicationUntilProxyStarts *wrappers.BoolValue   `protobuf:"bytes,7,opt,name=holdApplicationUntilProxyStarts,proto3" json:"holdApplicationUntilProxyStarts,omitempty"`
-	Lifecycle                       *v1.Lifecycle         `protobuf:"bytes,8,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
-	Resources                       *ResourceRequirements `protobuf:"bytes,9,opt,name=resources,proto3" json:"resources,omitempty"`
-	// IncludeIPRanges the range where to capture egress traffic
-	IncludeIPRanges string `protobuf:"bytes,10,opt,name=includeIPRanges,proto3" json:"includeIPRanges,omitempty"`
-	// ExcludeIPRanges the range where not to capture egress traffic
-	ExcludeIPRanges string `protobuf:"bytes,11,opt,name=excludeIPRanges,proto3" json:"excludeIPRanges,omitempty"`
-	// ExcludeInboundPorts the comma separated list of inbound ports to be excluded from redirection to Envoy
-	ExcludeInboundPorts string `protobuf:"bytes,12,opt,name=excludeInboundPorts,proto3" json:"excludeInboundPorts,omitempty"`
-	// ExcludeOutboundPorts the comma separated list of outbound ports to be excluded from redirection to Envoy
-	ExcludeOutboundPorts string `protobuf:"bytes,13,opt,name=excludeOutboundPorts,proto3" json:"excludeOutboundPorts,omitempty"`
-	// Specify which tracer to use. One of: zipkin, lightstep, datadog, stackdriver
-	Tracer *string `protobuf:"bytes,14,opt,name=tracer,proto3,oneof" json:"tracer,omitempty"`
+	// Whether to generate the default Sidecar resources.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Namespaces which are allowed as egress for every namespace.
+	EgressNamespaces []string `protobuf:"bytes,2,rep,name=egressNamespaces,proto3" json:"egressNamespaces,omitempty"`
+	// Learning mode configuration
+	LearningMode *SidecarLearningModeConfiguration `protobuf:"bytes,3,opt,name=learningMode,proto3" json:"learningMode,omitempty"`
 }
 
-func (x *ProxyConfiguration) Reset() {
-	*x = ProxyConfiguration{}
+func (x *DefaultSidecarConfiguration) Reset() {
+	*x = DefaultSidecarConfiguration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1301,13 @@ func (x *ProxyConfiguration) Reset() {
 	}
 }
 
-func (x *ProxyConfiguration) String() string {
+func (x *DefaultSidecarConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyConfiguration) ProtoMessage() {}
+func (*DefaultSidecarConfiguration) ProtoMessage() {}
 
-func (x *ProxyConfiguration) ProtoReflect() protoreflect.Message {
+func (x *DefaultSidecarConfiguration) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,137 +1319,141 @@ func (x *ProxyConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyConfiguration.ProtoReflect.Descriptor instead.
-func (*ProxyConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefaultSidecarConfiguration.ProtoReflect.Descriptor instead.
+func (*DefaultSidecarConfiguration) Descriptor() ([]byte, []int) {
 	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ProxyConfiguration) GetImage() string {
+func (x *DefaultSidecarConfiguration) GetEnabled() *wrappers.BoolValue {
 	if x != nil {
-		return x.Image
+		return x.Enabled
 	}
-	return ""
+	return nil
 }
 
-func (x *ProxyConfiguration) GetPrivileged() *wrappers.BoolValue {
+func (x *DefaultSidecarConfiguration) GetEgressNamespaces() []string {
 	if x != nil {
-		return x.Privileged
+		return x.EgressNamespaces
 	}
 	return nil
 }
 
-func (x *ProxyConfiguration) GetEnableCoreDump() *wrappers.BoolValue {
+func (x *DefaultSidecarConfiguration) GetLearningMode() *SidecarLearningModeConfiguration {
 	if x != nil {
-		return x.EnableCoreDump
+		return x.LearningMode
 	}
 	return nil
 }
 
-func (x *ProxyConfiguration) GetLogLevel() ProxyLogLevel {
-	if x != nil {
-		return x.LogLevel
-	}
-	return ProxyLogLevel_ProxyLogLevel_UNSPECIFIED
+// SidecarLearningModeConfiguration defines settings for learning the dependencies of the namespaces.
+// While learning mode is enabled, the egress of the generated Sidecar resources is not restricted and the
+// namespaces called from a namespace are recorded in the sidecar.istio.servicemesh.cisco.com/learned-egress-namespaces
+// annotation of the namespace, based on the Istio standard metrics queried every 5 minutes. Namespaces which were not
+// called within the lookback are removed from the annotation. Once learning mode is disabled, egress to the
+// recorded namespaces is allowed by the generated Sidecar resources.
+type SidecarLearningModeConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether learning mode is enabled.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Address of the Prometheus server which collects the Istio standard metrics,
+	// e.g. http://prometheus.istio-system.svc:9090
+	PrometheusAddress string `protobuf:"bytes,2,opt,name=prometheusAddress,proto3" json:"prometheusAddress,omitempty"`
+	// Time range of the service calls to take into account. Defaults to 1h.
+	Lookback *duration.Duration `protobuf:"bytes,3,opt,name=lookback,proto3" json:"lookback,omitempty"`
 }
 
-func (x *ProxyConfiguration) GetComponentLogLevel() string {
-	if x != nil {
-		return x.ComponentLogLevel
-	}
-	return ""
-}
-
-func (x *ProxyConfiguration) GetClusterDomain() string {
-	if x != nil {
-		return x.ClusterDomain
+func (x *SidecarLearningModeConfiguration) Reset() {
+	*x = SidecarLearningModeConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ProxyConfiguration) GetHoldApplicationUntilProxyStarts() *wrappers.BoolValue {
-	if x != nil {
-		return x.HoldApplicationUntilProxyStarts
-	}
-	return nil
+func (x *SidecarLearningModeConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProxyConfiguration) GetLifecycle() *v1.Lifecycle {
-	if x != nil {
-		return x.Lifecycle
-	}
-	return nil
-}
+func (*SidecarLearningModeConfiguration) ProtoMessage() {}
 
-func (x *ProxyConfiguration) GetResources() *ResourceRequirements {
-	if x != nil {
-		return x.Resources
+func (x *SidecarLearningModeConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProxyConfiguration) GetIncludeIPRanges() string {
-	if x != nil {
-		return x.IncludeIPRanges
-	}
-	return ""
+// Deprecated: Use SidecarLearningModeConfiguration.ProtoReflect.Descriptor instead.
+func (*SidecarLearningModeConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProxyConfiguration) GetExcludeIPRanges() string {
+func (x *SidecarLearningModeConfiguration) GetEnabled() *wrappers.BoolValue {
 	if x != nil {
-		return x.ExcludeIPRanges
+		return x.Enabled
 	}
-	return ""
+	return nil
 }
 
-func (x *ProxyConfiguration) GetExcludeInboundPorts() string {
+func (x *SidecarLearningModeConfiguration) GetPrometheusAddress() string {
 	if x != nil {
-		return x.ExcludeInboundPorts
+		return x.PrometheusAddress
 	}
 	return ""
 }
 
-func (x *ProxyConfiguration) GetExcludeOutboundPorts() string {
+func (x *SidecarLearningModeConfiguration) GetLookback() *duration.Duration {
 	if x != nil {
-		return x.ExcludeOutboundPorts
-	}
-	return ""
-}
-
-func (x *ProxyConfiguration) GetTracer() string {
-	if x != nil && x.Tracer != nil {
-		return *x.Tracer
+		return x.Lookback
 	}
-	return ""
+	return nil
 }
 
-// ProxyInitConfiguration defines config options for Proxy Init containers
-type ProxyInitConfiguration struct {
+// EgressConfiguration defines settings for controlling the traffic leaving the mesh.
+// When enabled, the outbound traffic policy of the mesh is set to REGISTRY_ONLY, so only the
+// hosts known by the service registry are reachable, and ServiceEntries are generated for the allowed external hosts.
+// If an egress gateway is referenced, the traffic to the allowed hosts is routed through that gateway.
+type EgressConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image     string                `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Resources *ResourceRequirements `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Cni       *CNIConfiguration     `protobuf:"bytes,3,opt,name=cni,proto3" json:"cni,omitempty"`
+	// Whether egress traffic control is enabled.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Reference to an IstioMeshGateway of type egress which the traffic to the allowed hosts is routed through.
+	// The namespace defaults to the namespace of the control plane.
+	// The ports of the allowed hosts must be exposed on the service of the gateway.
+	Gateway *NamespacedName `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// External hosts which are allowed to be reached from the mesh.
+	Hosts []*EgressHost `protobuf:"bytes,3,rep,name=hosts,proto3" json:"hosts,omitempty"`
 }
 
-func (x *ProxyInitConfiguration) Reset() {
-	*x = ProxyInitConfiguration{}
+func (x *EgressConfiguration) Reset() {
+	*x = EgressConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProxyInitConfiguration) String() string {
+func (x *EgressConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyInitConfiguration) ProtoMessage() {}
+func (*EgressConfiguration) ProtoMessage() {}
 
-func (x *ProxyInitConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8]
+func (x *EgressConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,69 +1464,68 @@ func (x *ProxyInitConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyInitConfiguration.ProtoReflect.Descriptor instead.
-func (*ProxyInitConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EgressConfiguration.ProtoReflect.Descriptor instead.
+func (*EgressConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ProxyInitConfiguration) GetImage() string {
+func (x *EgressConfiguration) GetEnabled() *wrappers.BoolValue {
 	if x != nil {
-		return x.Image
+		return x.Enabled
 	}
-	return ""
+	return nil
 }
 
-func (x *ProxyInitConfiguration) GetResources() *ResourceRequirements {
+func (x *EgressConfiguration) GetGateway() *NamespacedName {
 	if x != nil {
-		return x.Resources
+		return x.Gateway
 	}
 	return nil
 }
 
-func (x *ProxyInitConfiguration) GetCni() *CNIConfiguration {
+func (x *EgressConfiguration) GetHosts() []*EgressHost {
 	if x != nil {
-		return x.Cni
+		return x.Hosts
 	}
 	return nil
 }
 
-type CNIConfiguration struct {
+// EgressHost defines an external host which is allowed to be reached from the mesh
+type EgressHost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled            *wrappers.BoolValue                   `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Chained            *wrappers.BoolValue                   `protobuf:"bytes,2,opt,name=chained,proto3" json:"chained,omitempty"`
-	BinDir             string                                `protobuf:"bytes,4,opt,name=binDir,proto3" json:"binDir,omitempty"`
-	ConfDir            string                                `protobuf:"bytes,5,opt,name=confDir,proto3" json:"confDir,omitempty"`
-	ExcludeNamespaces  []string                              `protobuf:"bytes,6,rep,name=excludeNamespaces,proto3" json:"excludeNamespaces,omitempty"`
-	IncludeNamespaces  []string                              `protobuf:"bytes,7,rep,name=includeNamespaces,proto3" json:"includeNamespaces,omitempty"`
-	LogLevel           string                                `protobuf:"bytes,8,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
-	ConfFileName       string                                `protobuf:"bytes,9,opt,name=confFileName,proto3" json:"confFileName,omitempty"`
-	PspClusterRoleName string                                `protobuf:"bytes,10,opt,name=pspClusterRoleName,proto3" json:"pspClusterRoleName,omitempty"`
-	Repair             *CNIConfiguration_RepairConfiguration `protobuf:"bytes,11,opt,name=repair,proto3" json:"repair,omitempty"`
-	Taint              *CNIConfiguration_TaintConfiguration  `protobuf:"bytes,12,opt,name=taint,proto3" json:"taint,omitempty"`
-	ResourceQuotas     *CNIConfiguration_ResourceQuotas      `protobuf:"bytes,13,opt,name=resourceQuotas,proto3" json:"resourceQuotas,omitempty"`
-	Daemonset          *BaseKubernetesResourceConfig         `protobuf:"bytes,14,opt,name=daemonset,proto3" json:"daemonset,omitempty"`
-}
-
-func (x *CNIConfiguration) Reset() {
-	*x = CNIConfiguration{}
+	// Host name, e.g. www.example.com. Wildcard hosts (e.g. *.example.com) are allowed, but the traffic
+	// to them is not routed through the egress gateway.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Port of the host.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Protocol of the traffic. Hosts with TCP protocol must use distinct ports when routed through the egress gateway.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TLS;TCP
+	// +kubebuilder:default=TLS
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *EgressHost) Reset() {
+	*x = EgressHost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CNIConfiguration) String() string {
+func (x *EgressHost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CNIConfiguration) ProtoMessage() {}
+func (*EgressHost) ProtoMessage() {}
 
-func (x *CNIConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9]
+func (x *EgressHost) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,148 +1536,199 @@ func (x *CNIConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CNIConfiguration.ProtoReflect.Descriptor instead.
-func (*CNIConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EgressHost.ProtoReflect.Descriptor instead.
+func (*EgressHost) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CNIConfiguration) GetEnabled() *wrappers.BoolValue {
+func (x *EgressHost) GetHost() string {
 	if x != nil {
-		return x.Enabled
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *CNIConfiguration) GetChained() *wrappers.BoolValue {
+func (x *EgressHost) GetPort() uint32 {
 	if x != nil {
-		return x.Chained
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *CNIConfiguration) GetBinDir() string {
+func (x *EgressHost) GetProtocol() string {
 	if x != nil {
-		return x.BinDir
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *CNIConfiguration) GetConfDir() string {
-	if x != nil {
-		return x.ConfDir
+// MTLSConfiguration defines settings for managing the mTLS mode of the mesh and for migrating namespaces to STRICT mode
+type MTLSConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Mesh wide mTLS mode, set in the mesh-mtls PeerAuthentication of the root namespace.
+	// The mesh wide PeerAuthentication is not managed if not set. Only one control plane of the root namespace
+	// can manage it, the conflict is reported in the MeshPeerAuthenticationReconciled condition of the others.
+	// +kubebuilder:validation:Enum=PERMISSIVE;STRICT;DISABLE
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Namespaces to migrate to STRICT mTLS mode. A namespace wide STRICT PeerAuthentication is only created
+	// once the namespace is enabled for injection for the control plane, all of its pods are injected by the control plane
+	// and, if a Prometheus address is set, no plaintext traffic to its workloads is observed.
+	// The blockers of the migration are reported in the status. Once migrated, the namespace stays in STRICT mode
+	// until it is removed from this list.
+	StrictNamespaces []string `protobuf:"bytes,2,rep,name=strictNamespaces,proto3" json:"strictNamespaces,omitempty"`
+	// Address of the Prometheus server which collects the Istio standard metrics, used for detecting plaintext traffic
+	// to the namespaces to be migrated, e.g. http://prometheus.istio-system.svc:9090
+	PrometheusAddress string `protobuf:"bytes,3,opt,name=prometheusAddress,proto3" json:"prometheusAddress,omitempty"`
+	// Time range of the traffic to take into account when detecting plaintext traffic. Defaults to 1h.
+	Lookback *duration.Duration `protobuf:"bytes,4,opt,name=lookback,proto3" json:"lookback,omitempty"`
+}
+
+func (x *MTLSConfiguration) Reset() {
+	*x = MTLSConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CNIConfiguration) GetExcludeNamespaces() []string {
-	if x != nil {
-		return x.ExcludeNamespaces
-	}
-	return nil
+func (x *MTLSConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CNIConfiguration) GetIncludeNamespaces() []string {
-	if x != nil {
-		return x.IncludeNamespaces
+func (*MTLSConfiguration) ProtoMessage() {}
+
+func (x *MTLSConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CNIConfiguration) GetLogLevel() string {
+// Deprecated: Use MTLSConfiguration.ProtoReflect.Descriptor instead.
+func (*MTLSConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MTLSConfiguration) GetMode() string {
 	if x != nil {
-		return x.LogLevel
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *CNIConfiguration) GetConfFileName() string {
+func (x *MTLSConfiguration) GetStrictNamespaces() []string {
 	if x != nil {
-		return x.ConfFileName
+		return x.StrictNamespaces
 	}
-	return ""
+	return nil
 }
 
-func (x *CNIConfiguration) GetPspClusterRoleName() string {
+func (x *MTLSConfiguration) GetPrometheusAddress() string {
 	if x != nil {
-		return x.PspClusterRoleName
+		return x.PrometheusAddress
 	}
 	return ""
 }
 
-func (x *CNIConfiguration) GetRepair() *CNIConfiguration_RepairConfiguration {
+func (x *MTLSConfiguration) GetLookback() *duration.Duration {
 	if x != nil {
-		return x.Repair
+		return x.Lookback
 	}
 	return nil
 }
 
-func (x *CNIConfiguration) GetTaint() *CNIConfiguration_TaintConfiguration {
-	if x != nil {
-		return x.Taint
+// MeshGatewayAttachmentPolicy defines which IstioMeshGateways are allowed to attach to the control plane
+type MeshGatewayAttachmentPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Selectors of the IstioMeshGateways allowed to attach to the control plane. A gateway is allowed to attach
+	// if it matches any of the selectors. Gateways in the namespace of the control plane are always allowed to attach.
+	// Any gateway is allowed to attach if the policy is not set.
+	AllowedGateways []*MeshGatewaySelector `protobuf:"bytes,1,rep,name=allowedGateways,proto3" json:"allowedGateways,omitempty"`
+}
+
+func (x *MeshGatewayAttachmentPolicy) Reset() {
+	*x = MeshGatewayAttachmentPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CNIConfiguration) GetResourceQuotas() *CNIConfiguration_ResourceQuotas {
-	if x != nil {
-		return x.ResourceQuotas
+func (x *MeshGatewayAttachmentPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayAttachmentPolicy) ProtoMessage() {}
+
+func (x *MeshGatewayAttachmentPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CNIConfiguration) GetDaemonset() *BaseKubernetesResourceConfig {
+// Deprecated: Use MeshGatewayAttachmentPolicy.ProtoReflect.Descriptor instead.
+func (*MeshGatewayAttachmentPolicy) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MeshGatewayAttachmentPolicy) GetAllowedGateways() []*MeshGatewaySelector {
 	if x != nil {
-		return x.Daemonset
+		return x.AllowedGateways
 	}
 	return nil
 }
 
-// IstiodConfiguration defines config options for Istiod
-type IstiodConfiguration struct {
+// MeshGatewaySelector selects IstioMeshGateways by their namespace and labels.
+// Every condition that is set must match, an empty selector matches every gateway.
+type MeshGatewaySelector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deployment spec
-	Deployment *BaseKubernetesResourceConfig `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
-	// If enabled, pilot will run Istio analyzers and write analysis errors to the Status field of any Istio Resources
-	EnableAnalysis *wrappers.BoolValue `protobuf:"bytes,2,opt,name=enableAnalysis,proto3" json:"enableAnalysis,omitempty"`
-	// If enabled, pilot will update the CRD Status field of all Istio resources with reconciliation status
-	EnableStatus *wrappers.BoolValue `protobuf:"bytes,3,opt,name=enableStatus,proto3" json:"enableStatus,omitempty"`
-	// Settings for local istiod to control remote clusters as well
-	ExternalIstiod *ExternalIstiodConfiguration `protobuf:"bytes,4,opt,name=externalIstiod,proto3" json:"externalIstiod,omitempty"`
-	TraceSampling  *wrappers.FloatValue         `protobuf:"bytes,5,opt,name=traceSampling,proto3" json:"traceSampling,omitempty"`
-	// If enabled, protocol sniffing will be used for outbound listeners whose port protocol is not specified or unsupported
-	EnableProtocolSniffingOutbound *wrappers.BoolValue `protobuf:"bytes,6,opt,name=enableProtocolSniffingOutbound,proto3" json:"enableProtocolSniffingOutbound,omitempty"`
-	// If enabled, protocol sniffing will be used for inbound listeners whose port protocol is not specified or unsupported
-	EnableProtocolSniffingInbound *wrappers.BoolValue `protobuf:"bytes,7,opt,name=enableProtocolSniffingInbound,proto3" json:"enableProtocolSniffingInbound,omitempty"`
-	// Configure the certificate provider for control plane communication.
-	// Currently, two providers are supported: "kubernetes" and "istiod".
-	// As some platforms may not have kubernetes signing APIs,
-	// Istiod is the default
-	// +kubebuilder:validation:Enum=KUBERNETES;ISTIOD
-	CertProvider PilotCertProviderType `protobuf:"varint,8,opt,name=certProvider,proto3,enum=istio_operator.v2.api.v1alpha1.PilotCertProviderType" json:"certProvider,omitempty"`
-	// SPIFFE configuration of Pilot
-	Spiffe *SPIFFEConfiguration `protobuf:"bytes,9,opt,name=spiffe,proto3" json:"spiffe,omitempty"`
+	// Names of the namespaces of the gateways
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Labels of the namespaces of the gateways
+	NamespaceLabels map[string]string `protobuf:"bytes,2,rep,name=namespaceLabels,proto3" json:"namespaceLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Labels of the gateways
+	GatewayLabels map[string]string `protobuf:"bytes,3,rep,name=gatewayLabels,proto3" json:"gatewayLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *IstiodConfiguration) Reset() {
-	*x = IstiodConfiguration{}
+func (x *MeshGatewaySelector) Reset() {
+	*x = MeshGatewaySelector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IstiodConfiguration) String() string {
+func (x *MeshGatewaySelector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IstiodConfiguration) ProtoMessage() {}
+func (*MeshGatewaySelector) ProtoMessage() {}
 
-func (x *IstiodConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10]
+func (x *MeshGatewaySelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,100 +1739,157 @@ func (x *IstiodConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IstiodConfiguration.ProtoReflect.Descriptor instead.
-func (*IstiodConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MeshGatewaySelector.ProtoReflect.Descriptor instead.
+func (*MeshGatewaySelector) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IstiodConfiguration) GetDeployment() *BaseKubernetesResourceConfig {
+func (x *MeshGatewaySelector) GetNamespaces() []string {
 	if x != nil {
-		return x.Deployment
+		return x.Namespaces
 	}
 	return nil
 }
 
-func (x *IstiodConfiguration) GetEnableAnalysis() *wrappers.BoolValue {
+func (x *MeshGatewaySelector) GetNamespaceLabels() map[string]string {
 	if x != nil {
-		return x.EnableAnalysis
+		return x.NamespaceLabels
 	}
 	return nil
 }
 
-func (x *IstiodConfiguration) GetEnableStatus() *wrappers.BoolValue {
+func (x *MeshGatewaySelector) GetGatewayLabels() map[string]string {
 	if x != nil {
-		return x.EnableStatus
+		return x.GatewayLabels
 	}
 	return nil
 }
 
-func (x *IstiodConfiguration) GetExternalIstiod() *ExternalIstiodConfiguration {
-	if x != nil {
-		return x.ExternalIstiod
-	}
-	return nil
+// AddonComponent defines a user-defined component rendered from an external Helm chart.
+// The chart is rendered with the values produced by the values template, which is a Go template executed
+// with the IstioControlPlane as data, the same way as the values of the built-in components.
+// The rendered resources are owned by the control plane and removed when the addon is disabled or the control plane is deleted.
+// To remove an addon, disable it first and drop it from the list once its resources are gone.
+// The operator must have the RBAC permissions to manage the resources rendered from the chart.
+type AddonComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the addon, used as the component and Helm release name. It must not collide with the names of
+	// the built-in components.
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the addon is enabled. Defaults to true.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Source of the Helm chart.
+	Chart *AddonChartSource `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Go template producing the values of the chart, executed with the IstioControlPlane as data.
+	// The values.yaml.tpl file of the chart is used if not set.
+	ValuesTemplate string `protobuf:"bytes,4,opt,name=valuesTemplate,proto3" json:"valuesTemplate,omitempty"`
+	// Names of the components which must be reconciled before this addon, e.g. istio-discovery or other addons.
+	Dependencies []string `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *AddonComponent) Reset() {
+	*x = AddonComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *IstiodConfiguration) GetTraceSampling() *wrappers.FloatValue {
+func (x *AddonComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddonComponent) ProtoMessage() {}
+
+func (x *AddonComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddonComponent.ProtoReflect.Descriptor instead.
+func (*AddonComponent) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddonComponent) GetName() string {
 	if x != nil {
-		return x.TraceSampling
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *IstiodConfiguration) GetEnableProtocolSniffingOutbound() *wrappers.BoolValue {
+func (x *AddonComponent) GetEnabled() *wrappers.BoolValue {
 	if x != nil {
-		return x.EnableProtocolSniffingOutbound
+		return x.Enabled
 	}
 	return nil
 }
 
-func (x *IstiodConfiguration) GetEnableProtocolSniffingInbound() *wrappers.BoolValue {
+func (x *AddonComponent) GetChart() *AddonChartSource {
 	if x != nil {
-		return x.EnableProtocolSniffingInbound
+		return x.Chart
 	}
 	return nil
 }
 
-func (x *IstiodConfiguration) GetCertProvider() PilotCertProviderType {
+func (x *AddonComponent) GetValuesTemplate() string {
 	if x != nil {
-		return x.CertProvider
+		return x.ValuesTemplate
 	}
-	return PilotCertProviderType_PilotCertProviderType_UNSPECIFIED
+	return ""
 }
 
-func (x *IstiodConfiguration) GetSpiffe() *SPIFFEConfiguration {
+func (x *AddonComponent) GetDependencies() []string {
 	if x != nil {
-		return x.Spiffe
+		return x.Dependencies
 	}
 	return nil
 }
 
-// ExternalIstiodConfiguration defines settings for local istiod to control remote clusters as well
-type ExternalIstiodConfiguration struct {
+// AddonChartSource defines where to load a Helm chart from. Exactly one of the sources must be set.
+type AddonChartSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// ConfigMap in the namespace of the control plane containing the packaged chart.
+	ConfigMap *AddonChartConfigMapSource `protobuf:"bytes,1,opt,name=configMap,proto3" json:"configMap,omitempty"`
+	// Reference of the chart stored as an OCI artifact, e.g. oci://registry.local:5000/charts/ratelimit:1.0.0
+	// Registries on localhost are accessed over plain HTTP.
+	Oci string `protobuf:"bytes,2,opt,name=oci,proto3" json:"oci,omitempty"`
+	// Path of a chart directory or packaged chart on the file system of the operator, e.g. a mounted volume.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ExternalIstiodConfiguration) Reset() {
-	*x = ExternalIstiodConfiguration{}
+func (x *AddonChartSource) Reset() {
+	*x = AddonChartSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalIstiodConfiguration) String() string {
+func (x *AddonChartSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalIstiodConfiguration) ProtoMessage() {}
+func (*AddonChartSource) ProtoMessage() {}
 
-func (x *ExternalIstiodConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11]
+func (x *AddonChartSource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,44 +1900,61 @@ func (x *ExternalIstiodConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalIstiodConfiguration.ProtoReflect.Descriptor instead.
-func (*ExternalIstiodConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddonChartSource.ProtoReflect.Descriptor instead.
+func (*AddonChartSource) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ExternalIstiodConfiguration) GetEnabled() *wrappers.BoolValue {
+func (x *AddonChartSource) GetConfigMap() *AddonChartConfigMapSource {
 	if x != nil {
-		return x.Enabled
+		return x.ConfigMap
 	}
 	return nil
 }
 
-// SPIFFEConfiguration is for SPIFFE configuration of Pilot
-type SPIFFEConfiguration struct {
+func (x *AddonChartSource) GetOci() string {
+	if x != nil {
+		return x.Oci
+	}
+	return ""
+}
+
+func (x *AddonChartSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// AddonChartConfigMapSource references a packaged Helm chart stored in a ConfigMap
+type AddonChartConfigMapSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OperatorEndpoints *OperatorEndpointsConfiguration `protobuf:"bytes,1,opt,name=operatorEndpoints,proto3" json:"operatorEndpoints,omitempty"`
+	// Name of the ConfigMap.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Key of the packaged chart (.tgz) in the binaryData of the ConfigMap. Defaults to chart.tgz.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *SPIFFEConfiguration) Reset() {
-	*x = SPIFFEConfiguration{}
+func (x *AddonChartConfigMapSource) Reset() {
+	*x = AddonChartConfigMapSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SPIFFEConfiguration) String() string {
+func (x *AddonChartConfigMapSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SPIFFEConfiguration) ProtoMessage() {}
+func (*AddonChartConfigMapSource) ProtoMessage() {}
 
-func (x *SPIFFEConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12]
+func (x *AddonChartConfigMapSource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,44 +1965,54 @@ func (x *SPIFFEConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SPIFFEConfiguration.ProtoReflect.Descriptor instead.
-func (*SPIFFEConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddonChartConfigMapSource.ProtoReflect.Descriptor instead.
+func (*AddonChartConfigMapSource) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SPIFFEConfiguration) GetOperatorEndpoints() *OperatorEndpointsConfiguration {
+func (x *AddonChartConfigMapSource) GetName() string {
 	if x != nil {
-		return x.OperatorEndpoints
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// OperatorEndpointsConfiguration defines config options for automatic SPIFFE endpoints
-type OperatorEndpointsConfiguration struct {
+func (x *AddonChartConfigMapSource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Comma-separated minimum per-scope logging level of messages to output, in the form of <scope>:<level>,<scope>:<level>
+// The control plane has different scopes depending on component, but can configure default log level across all components
+// If empty, default scope and level will be used as configured in code
+type LoggingConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]+:[a-zA-Z]+,?)+$`
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 }
 
-func (x *OperatorEndpointsConfiguration) Reset() {
-	*x = OperatorEndpointsConfiguration{}
+func (x *LoggingConfiguration) Reset() {
+	*x = LoggingConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperatorEndpointsConfiguration) String() string {
+func (x *LoggingConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperatorEndpointsConfiguration) ProtoMessage() {}
+func (*LoggingConfiguration) ProtoMessage() {}
 
-func (x *OperatorEndpointsConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13]
+func (x *LoggingConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,43 +2023,48 @@ func (x *OperatorEndpointsConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperatorEndpointsConfiguration.ProtoReflect.Descriptor instead.
-func (*OperatorEndpointsConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LoggingConfiguration.ProtoReflect.Descriptor instead.
+func (*LoggingConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *OperatorEndpointsConfiguration) GetEnabled() *wrappers.BoolValue {
+func (x *LoggingConfiguration) GetLevel() string {
 	if x != nil {
-		return x.Enabled
+		return x.Level
 	}
-	return nil
+	return ""
 }
 
-type TelemetryV2Configuration struct {
+// SDSConfiguration defines Secret Discovery Service config options
+type SDSConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The JWT token for SDS and the aud field of such JWT. See RFC 7519, section 4.1.3.
+	// When a CSR is sent from Citadel Agent to the CA (e.g. Citadel), this aud is to make sure the
+	//
+	//	JWT is intended for the CA.
+	TokenAudience string `protobuf:"bytes,1,opt,name=tokenAudience,proto3" json:"tokenAudience,omitempty"`
 }
 
-func (x *TelemetryV2Configuration) Reset() {
-	*x = TelemetryV2Configuration{}
+func (x *SDSConfiguration) Reset() {
+	*x = SDSConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TelemetryV2Configuration) String() string {
+func (x *SDSConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelemetryV2Configuration) ProtoMessage() {}
+func (*SDSConfiguration) ProtoMessage() {}
 
-func (x *TelemetryV2Configuration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14]
+func (x *SDSConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,44 +2075,73 @@ func (x *TelemetryV2Configuration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelemetryV2Configuration.ProtoReflect.Descriptor instead.
-func (*TelemetryV2Configuration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SDSConfiguration.ProtoReflect.Descriptor instead.
+func (*SDSConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TelemetryV2Configuration) GetEnabled() *wrappers.BoolValue {
+func (x *SDSConfiguration) GetTokenAudience() string {
 	if x != nil {
-		return x.Enabled
+		return x.TokenAudience
 	}
-	return nil
+	return ""
 }
 
-// ProxyWasmConfiguration defines config options for Envoy wasm
-type ProxyWasmConfiguration struct {
+// ProxyConfiguration defines config options for Proxy
+type ProxyConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// If set to true, istio-proxy container will have privileged securityContext
+	Privileged *wrappers.BoolValue `protobuf:"bytes,2,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	// If set, newly injected sidecars will have core dumps enabled.
+	EnableCoreDump *wrappers.BoolValue `protobuf:"bytes,3,opt,name=enableCoreDump,proto3" json:"enableCoreDump,omitempty"`
+	// Log level for proxy, applies to gateways and sidecars. If left empty, "warning" is used.
+	// Expected values are: trace|debug|info|warning|error|critical|off
+	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARNING;ERROR;CRITICAL;OFF
+	LogLevel ProxyLogLevel `protobuf:"varint,4,opt,name=logLevel,proto3,enum=istio_operator.v2.api.v1alpha1.ProxyLogLevel" json:"logLevel,omitempty"`
+	// Per Component log level for proxy, applies to gateways and sidecars. If a component level is
+	// not set, then the "LogLevel" will be used. If left empty, "misc:error" is used.
+	ComponentLogLevel string `protobuf:"bytes,5,opt,name=componentLogLevel,proto3" json:"componentLogLevel,omitempty"`
+	// cluster domain. Default value is "cluster.local"
+	ClusterDomain string `protobuf:"bytes,6,opt,name=clusterDomain,proto3" json:"clusterDomain,omitempty"`
+	// Controls if sidecar is injected at the front of the container list and blocks
+	// the start of the other containers until the proxy is ready
+	// Default value is 'false'.
+	HoldApplicationUntilProxyStarts *wrappers.BoolValue   `protobuf:"bytes,7,opt,name=holdApplicationUntilProxyStarts,proto3" json:"holdApplicationUntilProxyStarts,omitempty"`
+	Lifecycle                       *v11.Lifecycle        `protobuf:"bytes,8,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	Resources                       *ResourceRequirements `protobuf:"bytes,9,opt,name=resources,proto3" json:"resources,omitempty"`
+	// IncludeIPRanges the range where to capture egress traffic
+	IncludeIPRanges string `protobuf:"bytes,10,opt,name=includeIPRanges,proto3" json:"includeIPRanges,omitempty"`
+	// ExcludeIPRanges the range where not to capture egress traffic
+	ExcludeIPRanges string `protobuf:"bytes,11,opt,name=excludeIPRanges,proto3" json:"excludeIPRanges,omitempty"`
+	// ExcludeInboundPorts the comma separated list of inbound ports to be excluded from redirection to Envoy
+	ExcludeInboundPorts string `protobuf:"bytes,12,opt,name=excludeInboundPorts,proto3" json:"excludeInboundPorts,omitempty"`
+	// ExcludeOutboundPorts the comma separated list of outbound ports to be excluded from redirection to Envoy
+	ExcludeOutboundPorts string `protobuf:"bytes,13,opt,name=excludeOutboundPorts,proto3" json:"excludeOutboundPorts,omitempty"`
+	// Specify which tracer to use. One of: zipkin, lightstep, datadog, stackdriver
+	Tracer *string `protobuf:"bytes,14,opt,name=tracer,proto3,oneof" json:"tracer,omitempty"`
 }
 
-func (x *ProxyWasmConfiguration) Reset() {
-	*x = ProxyWasmConfiguration{}
+func (x *ProxyConfiguration) Reset() {
+	*x = ProxyConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProxyWasmConfiguration) String() string {
+func (x *ProxyConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyWasmConfiguration) ProtoMessage() {}
+func (*ProxyConfiguration) ProtoMessage() {}
 
-func (x *ProxyWasmConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15]
+func (x *ProxyConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,44 +2152,1566 @@ func (x *ProxyWasmConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyWasmConfiguration.ProtoReflect.Descriptor instead.
-func (*ProxyWasmConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ProxyConfiguration.ProtoReflect.Descriptor instead.
+func (*ProxyConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ProxyWasmConfiguration) GetEnabled() *wrappers.BoolValue {
+func (x *ProxyConfiguration) GetImage() string {
 	if x != nil {
-		return x.Enabled
+		return x.Image
 	}
-	return nil
+	return ""
 }
 
-// PDBConfiguration holds Pod Disruption Budget related config options
-type PDBConfiguration struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+func (x *ProxyConfiguration) GetPrivileged() *wrappers.BoolValue {
+	if x != nil {
+		return x.Privileged
+	}
+	return nil
 }
 
-func (x *PDBConfiguration) Reset() {
-	*x = PDBConfiguration{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProxyConfiguration) GetEnableCoreDump() *wrappers.BoolValue {
+	if x != nil {
+		return x.EnableCoreDump
 	}
+	return nil
 }
 
-func (x *PDBConfiguration) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProxyConfiguration) GetLogLevel() ProxyLogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ProxyLogLevel_ProxyLogLevel_UNSPECIFIED
+}
+
+func (x *ProxyConfiguration) GetComponentLogLevel() string {
+	if x != nil {
+		return x.ComponentLogLevel
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetClusterDomain() string {
+	if x != nil {
+		return x.ClusterDomain
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetHoldApplicationUntilProxyStarts() *wrappers.BoolValue {
+	if x != nil {
+		return x.HoldApplicationUntilProxyStarts
+	}
+	return nil
+}
+
+func (x *ProxyConfiguration) GetLifecycle() *v11.Lifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return nil
+}
+
+func (x *ProxyConfiguration) GetResources() *ResourceRequirements {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ProxyConfiguration) GetIncludeIPRanges() string {
+	if x != nil {
+		return x.IncludeIPRanges
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetExcludeIPRanges() string {
+	if x != nil {
+		return x.ExcludeIPRanges
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetExcludeInboundPorts() string {
+	if x != nil {
+		return x.ExcludeInboundPorts
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetExcludeOutboundPorts() string {
+	if x != nil {
+		return x.ExcludeOutboundPorts
+	}
+	return ""
+}
+
+func (x *ProxyConfiguration) GetTracer() string {
+	if x != nil && x.Tracer != nil {
+		return *x.Tracer
+	}
+	return ""
+}
+
+// ProxyInitConfiguration defines config options for Proxy Init containers
+type ProxyInitConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image     string                `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Resources *ResourceRequirements `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Cni       *CNIConfiguration     `protobuf:"bytes,3,opt,name=cni,proto3" json:"cni,omitempty"`
+}
+
+func (x *ProxyInitConfiguration) Reset() {
+	*x = ProxyInitConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyInitConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyInitConfiguration) ProtoMessage() {}
+
+func (x *ProxyInitConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyInitConfiguration.ProtoReflect.Descriptor instead.
+func (*ProxyInitConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProxyInitConfiguration) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ProxyInitConfiguration) GetResources() *ResourceRequirements {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ProxyInitConfiguration) GetCni() *CNIConfiguration {
+	if x != nil {
+		return x.Cni
+	}
+	return nil
+}
+
+type CNIConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled            *wrappers.BoolValue                   `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Chained            *wrappers.BoolValue                   `protobuf:"bytes,2,opt,name=chained,proto3" json:"chained,omitempty"`
+	BinDir             string                                `protobuf:"bytes,4,opt,name=binDir,proto3" json:"binDir,omitempty"`
+	ConfDir            string                                `protobuf:"bytes,5,opt,name=confDir,proto3" json:"confDir,omitempty"`
+	ExcludeNamespaces  []string                              `protobuf:"bytes,6,rep,name=excludeNamespaces,proto3" json:"excludeNamespaces,omitempty"`
+	IncludeNamespaces  []string                              `protobuf:"bytes,7,rep,name=includeNamespaces,proto3" json:"includeNamespaces,omitempty"`
+	LogLevel           string                                `protobuf:"bytes,8,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	ConfFileName       string                                `protobuf:"bytes,9,opt,name=confFileName,proto3" json:"confFileName,omitempty"`
+	PspClusterRoleName string                                `protobuf:"bytes,10,opt,name=pspClusterRoleName,proto3" json:"pspClusterRoleName,omitempty"`
+	Repair             *CNIConfiguration_RepairConfiguration `protobuf:"bytes,11,opt,name=repair,proto3" json:"repair,omitempty"`
+	Taint              *CNIConfiguration_TaintConfiguration  `protobuf:"bytes,12,opt,name=taint,proto3" json:"taint,omitempty"`
+	ResourceQuotas     *CNIConfiguration_ResourceQuotas      `protobuf:"bytes,13,opt,name=resourceQuotas,proto3" json:"resourceQuotas,omitempty"`
+	Daemonset          *BaseKubernetesResourceConfig         `protobuf:"bytes,14,opt,name=daemonset,proto3" json:"daemonset,omitempty"`
+}
+
+func (x *CNIConfiguration) Reset() {
+	*x = CNIConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CNIConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CNIConfiguration) ProtoMessage() {}
+
+func (x *CNIConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CNIConfiguration.ProtoReflect.Descriptor instead.
+func (*CNIConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CNIConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetChained() *wrappers.BoolValue {
+	if x != nil {
+		return x.Chained
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetBinDir() string {
+	if x != nil {
+		return x.BinDir
+	}
+	return ""
+}
+
+func (x *CNIConfiguration) GetConfDir() string {
+	if x != nil {
+		return x.ConfDir
+	}
+	return ""
+}
+
+func (x *CNIConfiguration) GetExcludeNamespaces() []string {
+	if x != nil {
+		return x.ExcludeNamespaces
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetIncludeNamespaces() []string {
+	if x != nil {
+		return x.IncludeNamespaces
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *CNIConfiguration) GetConfFileName() string {
+	if x != nil {
+		return x.ConfFileName
+	}
+	return ""
+}
+
+func (x *CNIConfiguration) GetPspClusterRoleName() string {
+	if x != nil {
+		return x.PspClusterRoleName
+	}
+	return ""
+}
+
+func (x *CNIConfiguration) GetRepair() *CNIConfiguration_RepairConfiguration {
+	if x != nil {
+		return x.Repair
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetTaint() *CNIConfiguration_TaintConfiguration {
+	if x != nil {
+		return x.Taint
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetResourceQuotas() *CNIConfiguration_ResourceQuotas {
+	if x != nil {
+		return x.ResourceQuotas
+	}
+	return nil
+}
+
+func (x *CNIConfiguration) GetDaemonset() *BaseKubernetesResourceConfig {
+	if x != nil {
+		return x.Daemonset
+	}
+	return nil
+}
+
+// IstiodConfiguration defines config options for Istiod
+type IstiodConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deployment spec
+	Deployment *BaseKubernetesResourceConfig `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	// If enabled, pilot will run Istio analyzers and write analysis errors to the Status field of any Istio Resources
+	EnableAnalysis *wrappers.BoolValue `protobuf:"bytes,2,opt,name=enableAnalysis,proto3" json:"enableAnalysis,omitempty"`
+	// If enabled, pilot will update the CRD Status field of all Istio resources with reconciliation status
+	EnableStatus *wrappers.BoolValue `protobuf:"bytes,3,opt,name=enableStatus,proto3" json:"enableStatus,omitempty"`
+	// Settings for local istiod to control remote clusters as well
+	ExternalIstiod *ExternalIstiodConfiguration `protobuf:"bytes,4,opt,name=externalIstiod,proto3" json:"externalIstiod,omitempty"`
+	TraceSampling  *wrappers.FloatValue         `protobuf:"bytes,5,opt,name=traceSampling,proto3" json:"traceSampling,omitempty"`
+	// If enabled, protocol sniffing will be used for outbound listeners whose port protocol is not specified or unsupported
+	EnableProtocolSniffingOutbound *wrappers.BoolValue `protobuf:"bytes,6,opt,name=enableProtocolSniffingOutbound,proto3" json:"enableProtocolSniffingOutbound,omitempty"`
+	// If enabled, protocol sniffing will be used for inbound listeners whose port protocol is not specified or unsupported
+	EnableProtocolSniffingInbound *wrappers.BoolValue `protobuf:"bytes,7,opt,name=enableProtocolSniffingInbound,proto3" json:"enableProtocolSniffingInbound,omitempty"`
+	// Configure the certificate provider for control plane communication.
+	// Currently, two providers are supported: "kubernetes" and "istiod".
+	// As some platforms may not have kubernetes signing APIs,
+	// Istiod is the default
+	// +kubebuilder:validation:Enum=KUBERNETES;ISTIOD
+	CertProvider PilotCertProviderType `protobuf:"varint,8,opt,name=certProvider,proto3,enum=istio_operator.v2.api.v1alpha1.PilotCertProviderType" json:"certProvider,omitempty"`
+	// SPIFFE configuration of Pilot
+	Spiffe *SPIFFEConfiguration `protobuf:"bytes,9,opt,name=spiffe,proto3" json:"spiffe,omitempty"`
+}
+
+func (x *IstiodConfiguration) Reset() {
+	*x = IstiodConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstiodConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstiodConfiguration) ProtoMessage() {}
+
+func (x *IstiodConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstiodConfiguration.ProtoReflect.Descriptor instead.
+func (*IstiodConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IstiodConfiguration) GetDeployment() *BaseKubernetesResourceConfig {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetEnableAnalysis() *wrappers.BoolValue {
+	if x != nil {
+		return x.EnableAnalysis
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetEnableStatus() *wrappers.BoolValue {
+	if x != nil {
+		return x.EnableStatus
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetExternalIstiod() *ExternalIstiodConfiguration {
+	if x != nil {
+		return x.ExternalIstiod
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetTraceSampling() *wrappers.FloatValue {
+	if x != nil {
+		return x.TraceSampling
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetEnableProtocolSniffingOutbound() *wrappers.BoolValue {
+	if x != nil {
+		return x.EnableProtocolSniffingOutbound
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetEnableProtocolSniffingInbound() *wrappers.BoolValue {
+	if x != nil {
+		return x.EnableProtocolSniffingInbound
+	}
+	return nil
+}
+
+func (x *IstiodConfiguration) GetCertProvider() PilotCertProviderType {
+	if x != nil {
+		return x.CertProvider
+	}
+	return PilotCertProviderType_PilotCertProviderType_UNSPECIFIED
+}
+
+func (x *IstiodConfiguration) GetSpiffe() *SPIFFEConfiguration {
+	if x != nil {
+		return x.Spiffe
+	}
+	return nil
+}
+
+// ExternalIstiodConfiguration defines settings for local istiod to control remote clusters as well
+type ExternalIstiodConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ExternalIstiodConfiguration) Reset() {
+	*x = ExternalIstiodConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalIstiodConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalIstiodConfiguration) ProtoMessage() {}
+
+func (x *ExternalIstiodConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalIstiodConfiguration.ProtoReflect.Descriptor instead.
+func (*ExternalIstiodConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExternalIstiodConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+// SPIFFEConfiguration is for SPIFFE configuration of Pilot
+type SPIFFEConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatorEndpoints *OperatorEndpointsConfiguration `protobuf:"bytes,1,opt,name=operatorEndpoints,proto3" json:"operatorEndpoints,omitempty"`
+}
+
+func (x *SPIFFEConfiguration) Reset() {
+	*x = SPIFFEConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPIFFEConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPIFFEConfiguration) ProtoMessage() {}
+
+func (x *SPIFFEConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPIFFEConfiguration.ProtoReflect.Descriptor instead.
+func (*SPIFFEConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SPIFFEConfiguration) GetOperatorEndpoints() *OperatorEndpointsConfiguration {
+	if x != nil {
+		return x.OperatorEndpoints
+	}
+	return nil
+}
+
+// OperatorEndpointsConfiguration defines config options for automatic SPIFFE endpoints
+type OperatorEndpointsConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *OperatorEndpointsConfiguration) Reset() {
+	*x = OperatorEndpointsConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatorEndpointsConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorEndpointsConfiguration) ProtoMessage() {}
+
+func (x *OperatorEndpointsConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatorEndpointsConfiguration.ProtoReflect.Descriptor instead.
+func (*OperatorEndpointsConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OperatorEndpointsConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type TelemetryV2Configuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *TelemetryV2Configuration) Reset() {
+	*x = TelemetryV2Configuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemetryV2Configuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetryV2Configuration) ProtoMessage() {}
+
+func (x *TelemetryV2Configuration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetryV2Configuration.ProtoReflect.Descriptor instead.
+func (*TelemetryV2Configuration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TelemetryV2Configuration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+// ProxyWasmConfiguration defines config options for Envoy wasm
+type ProxyWasmConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProxyWasmConfiguration) Reset() {
+	*x = ProxyWasmConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyWasmConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyWasmConfiguration) ProtoMessage() {}
+
+func (x *ProxyWasmConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyWasmConfiguration.ProtoReflect.Descriptor instead.
+func (*ProxyWasmConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProxyWasmConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+// PDBConfiguration holds Pod Disruption Budget related config options
+type PDBConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *PDBConfiguration) Reset() {
+	*x = PDBConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDBConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*PDBConfiguration) ProtoMessage() {}
 
-func (x *PDBConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16]
+func (x *PDBConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDBConfiguration.ProtoReflect.Descriptor instead.
+func (*PDBConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PDBConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type HTTPProxyEnvsConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpProxy  string `protobuf:"bytes,1,opt,name=httpProxy,proto3" json:"httpProxy,omitempty"`
+	HttpsProxy string `protobuf:"bytes,2,opt,name=httpsProxy,proto3" json:"httpsProxy,omitempty"`
+	NoProxy    string `protobuf:"bytes,3,opt,name=noProxy,proto3" json:"noProxy,omitempty"`
+}
+
+func (x *HTTPProxyEnvsConfiguration) Reset() {
+	*x = HTTPProxyEnvsConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPProxyEnvsConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPProxyEnvsConfiguration) ProtoMessage() {}
+
+func (x *HTTPProxyEnvsConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPProxyEnvsConfiguration.ProtoReflect.Descriptor instead.
+func (*HTTPProxyEnvsConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HTTPProxyEnvsConfiguration) GetHttpProxy() string {
+	if x != nil {
+		return x.HttpProxy
+	}
+	return ""
+}
+
+func (x *HTTPProxyEnvsConfiguration) GetHttpsProxy() string {
+	if x != nil {
+		return x.HttpsProxy
+	}
+	return ""
+}
+
+func (x *HTTPProxyEnvsConfiguration) GetNoProxy() string {
+	if x != nil {
+		return x.NoProxy
+	}
+	return ""
+}
+
+// AdoptionConfiguration determines how the resources of an existing Istio installation are taken over by the control plane.
+// The existing resources are matched to the rendered ones by kind, namespace and name. The istiod Deployment and Service,
+// the mesh config and sidecar injector ConfigMaps and the webhook configurations are also matched by their upstream names,
+// as the control plane names them differently. Those resources are only labelled and owned by the control plane in adopt mode,
+// except the webhook configurations which are deleted once their rendered replacements exist.
+type AdoptionConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode AdoptionConfiguration_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=istio_operator.v2.api.v1alpha1.AdoptionConfiguration_Mode" json:"mode,omitempty"`
+	// Istio revision of the existing installation, the value of the istio.io/rev label of its resources.
+	// Defaults to the revision of the control plane, use "default" for installations without revision.
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *AdoptionConfiguration) Reset() {
+	*x = AdoptionConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptionConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptionConfiguration) ProtoMessage() {}
+
+func (x *AdoptionConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptionConfiguration.ProtoReflect.Descriptor instead.
+func (*AdoptionConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AdoptionConfiguration) GetMode() AdoptionConfiguration_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return AdoptionConfiguration_unspecified
+}
+
+func (x *AdoptionConfiguration) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+// HealthGatesConfiguration defines the checks which have to pass after the spec of the control plane is applied:
+// the istiod Deployment is rolled out and available, the sidecar injector webhook answers a dry-run pod admission
+// and the mesh config parses. The last specs which passed the gates are kept in the <name>-spec-history ConfigMap as
+// they were set on the resource, so a reverted spec still refers to its profile. The spec can be reverted to a known-good one by annotating the resource with
+// controlplane.istio.servicemesh.cisco.com/revert-spec set to "last-known-good" or to the hash of a spec in the history.
+type HealthGatesConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the health gates are checked.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Time the gates have to pass within after the spec changed. Defaults to 5m.
+	Timeout *duration.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Number of known-good specs kept in the history. Defaults to 5.
+	HistoryLimit *wrappers.Int32Value `protobuf:"bytes,3,opt,name=historyLimit,proto3" json:"historyLimit,omitempty"`
+	// Whether the spec is reverted to the last known-good one when the gates do not pass within the timeout.
+	// Specs which passed the gates before are never reverted automatically.
+	AutoRevert *wrappers.BoolValue `protobuf:"bytes,4,opt,name=autoRevert,proto3" json:"autoRevert,omitempty"`
+}
+
+func (x *HealthGatesConfiguration) Reset() {
+	*x = HealthGatesConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthGatesConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthGatesConfiguration) ProtoMessage() {}
+
+func (x *HealthGatesConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthGatesConfiguration.ProtoReflect.Descriptor instead.
+func (*HealthGatesConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HealthGatesConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *HealthGatesConfiguration) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *HealthGatesConfiguration) GetHistoryLimit() *wrappers.Int32Value {
+	if x != nil {
+		return x.HistoryLimit
+	}
+	return nil
+}
+
+func (x *HealthGatesConfiguration) GetAutoRevert() *wrappers.BoolValue {
+	if x != nil {
+		return x.AutoRevert
+	}
+	return nil
+}
+
+// HealthGatesStatus describes the result of the health gates of the current spec.
+type HealthGatesStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the spec the gates are checked for.
+	SpecHash string                  `protobuf:"bytes,1,opt,name=specHash,proto3" json:"specHash,omitempty"`
+	State    HealthGatesStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=istio_operator.v2.api.v1alpha1.HealthGatesStatus_State" json:"state,omitempty"`
+	// Time the checks of the spec started, in RFC 3339 format.
+	Since string        `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Gates []*HealthGate `protobuf:"bytes,4,rep,name=gates,proto3" json:"gates,omitempty"`
+	// Hash of the last spec which was reverted.
+	LastRevertedSpecHash string `protobuf:"bytes,5,opt,name=lastRevertedSpecHash,proto3" json:"lastRevertedSpecHash,omitempty"`
+}
+
+func (x *HealthGatesStatus) Reset() {
+	*x = HealthGatesStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthGatesStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthGatesStatus) ProtoMessage() {}
+
+func (x *HealthGatesStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthGatesStatus.ProtoReflect.Descriptor instead.
+func (*HealthGatesStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HealthGatesStatus) GetSpecHash() string {
+	if x != nil {
+		return x.SpecHash
+	}
+	return ""
+}
+
+func (x *HealthGatesStatus) GetState() HealthGatesStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return HealthGatesStatus_unspecified
+}
+
+func (x *HealthGatesStatus) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *HealthGatesStatus) GetGates() []*HealthGate {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *HealthGatesStatus) GetLastRevertedSpecHash() string {
+	if x != nil {
+		return x.LastRevertedSpecHash
+	}
+	return ""
+}
+
+// HealthGate describes the result of a health gate.
+type HealthGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed  bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *HealthGate) Reset() {
+	*x = HealthGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthGate) ProtoMessage() {}
+
+func (x *HealthGate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthGate.ProtoReflect.Descriptor instead.
+func (*HealthGate) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HealthGate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HealthGate) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *HealthGate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// InjectionSelfTestConfiguration defines the periodic self-test of the sidecar injection. A pod selected by the
+// injector of the control plane is created in dry-run mode in the tested namespaces. The pod complies with the
+// restricted pod security standard and requests 10m CPU and 16Mi memory with the same limits. The admitted pod has to
+// contain the istio-proxy container, the istio-init container (or the annotations of the CNI plugin when it is
+// enabled) and the revision of the control plane. The result is published in the SidecarInjectionReady condition
+// and the istio_operator_sidecar_injection_self_test_success metric.
+type InjectionSelfTestConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the self-test is run.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Time between the tests of a namespace. Namespaces are tested again right away when the sidecar injector
+	// configuration changes. Defaults to 5m.
+	Interval *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Namespace the test pods are created in. When not set, every namespace set for injection for the control plane is tested.
+	CanaryNamespace string `protobuf:"bytes,3,opt,name=canaryNamespace,proto3" json:"canaryNamespace,omitempty"`
+}
+
+func (x *InjectionSelfTestConfiguration) Reset() {
+	*x = InjectionSelfTestConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionSelfTestConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionSelfTestConfiguration) ProtoMessage() {}
+
+func (x *InjectionSelfTestConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectionSelfTestConfiguration.ProtoReflect.Descriptor instead.
+func (*InjectionSelfTestConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InjectionSelfTestConfiguration) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *InjectionSelfTestConfiguration) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *InjectionSelfTestConfiguration) GetCanaryNamespace() string {
+	if x != nil {
+		return x.CanaryNamespace
+	}
+	return ""
+}
+
+// InjectionSelfTestResult describes the result of the sidecar injection self-test in a namespace.
+type InjectionSelfTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Passed    bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Time of the test, in RFC 3339 format.
+	Time string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Checksum of the sidecar injector configuration the test ran with.
+	InjectorChecksum string `protobuf:"bytes,5,opt,name=injectorChecksum,proto3" json:"injectorChecksum,omitempty"`
+}
+
+func (x *InjectionSelfTestResult) Reset() {
+	*x = InjectionSelfTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionSelfTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionSelfTestResult) ProtoMessage() {}
+
+func (x *InjectionSelfTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectionSelfTestResult.ProtoReflect.Descriptor instead.
+func (*InjectionSelfTestResult) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InjectionSelfTestResult) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InjectionSelfTestResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *InjectionSelfTestResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InjectionSelfTestResult) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *InjectionSelfTestResult) GetInjectorChecksum() string {
+	if x != nil {
+		return x.InjectorChecksum
+	}
+	return ""
+}
+
+// InjectionTemplateError describes a custom sidecar injection template which failed to render for a sample pod.
+type InjectionTemplateError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the template, sidecar, gateway or the name of a custom template.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Line of the template the error occurred at, if known.
+	Line  int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *InjectionTemplateError) Reset() {
+	*x = InjectionTemplateError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionTemplateError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionTemplateError) ProtoMessage() {}
+
+func (x *InjectionTemplateError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectionTemplateError.ProtoReflect.Descriptor instead.
+func (*InjectionTemplateError) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InjectionTemplateError) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *InjectionTemplateError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *InjectionTemplateError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Condition describes an aspect of the state of the control plane.
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the condition, e.g. SidecarInjectionReady.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status of the condition, one of True, False or Unknown.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Machine readable reason of the last transition.
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Time of the last change of the status, in RFC 3339 format.
+	LastTransitionTime string `protobuf:"bytes,5,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Condition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Condition) GetLastTransitionTime() string {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return ""
+}
+
+// AdoptedResource describes a resource of an existing Istio installation and its adoption state.
+type AdoptedResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string                `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string                `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace  string                `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string                `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	State      AdoptedResource_State `protobuf:"varint,5,opt,name=state,proto3,enum=istio_operator.v2.api.v1alpha1.AdoptedResource_State" json:"state,omitempty"`
+	// Dot separated paths of the rendered fields which differ from the existing resource.
+	Fields []string `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Additional information about the adoption of the resource.
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Name of the rendered resource taking the place of the resource if the control plane names it differently
+	// than the upstream Helm charts and istioctl.
+	RenderedName string `protobuf:"bytes,8,opt,name=renderedName,proto3" json:"renderedName,omitempty"`
+}
+
+func (x *AdoptedResource) Reset() {
+	*x = AdoptedResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptedResource) ProtoMessage() {}
+
+func (x *AdoptedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptedResource.ProtoReflect.Descriptor instead.
+func (*AdoptedResource) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AdoptedResource) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *AdoptedResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AdoptedResource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AdoptedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdoptedResource) GetState() AdoptedResource_State {
+	if x != nil {
+		return x.State
+	}
+	return AdoptedResource_unspecified
+}
+
+func (x *AdoptedResource) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AdoptedResource) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AdoptedResource) GetRenderedName() string {
+	if x != nil {
+		return x.RenderedName
+	}
+	return ""
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioControlPlaneStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reconciliation status of the Istio control plane
+	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
+	// Cluster ID
+	ClusterID string `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	// Name of the IstioControlPlane resource
+	// It is used on remote clusters in the PeerIstioControlPlane resource status
+	// to identify the original Istio control plane
+	IstioControlPlaneName string `protobuf:"bytes,3,opt,name=istioControlPlaneName,proto3" json:"istioControlPlaneName,omitempty"`
+	// Current addresses for the corresponding gateways
+	GatewayAddress []string `protobuf:"bytes,4,rep,name=gatewayAddress,proto3" json:"gatewayAddress,omitempty"`
+	// Current addresses for the corresponding istiod pods
+	IstiodAddresses []string `protobuf:"bytes,5,rep,name=istiodAddresses,proto3" json:"istiodAddresses,omitempty"`
+	// Namespaces which are set for injection for this control plane
+	InjectionNamespaces []string `protobuf:"bytes,6,rep,name=injectionNamespaces,proto3" json:"injectionNamespaces,omitempty"`
+	// Istio CA root certificate
+	CaRootCertificate string `protobuf:"bytes,7,opt,name=caRootCertificate,proto3" json:"caRootCertificate,omitempty"`
+	// Reconciliation error message if any
+	ErrorMessage string               `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	MeshConfig   *v1alpha1.MeshConfig `protobuf:"bytes,9,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
+	Checksums    *StatusChecksums     `protobuf:"bytes,10,opt,name=checksums,proto3" json:"checksums,omitempty"`
+	// Region of the cluster
+	Region string `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`
+	// Current hostnames for the corresponding gateways
+	GatewayHostnames []string `protobuf:"bytes,12,rep,name=gatewayHostnames,proto3" json:"gatewayHostnames,omitempty"`
+	// STRICT mTLS migration state of the namespaces
+	MtlsNamespaces []*MTLSNamespaceStatus `protobuf:"bytes,13,rep,name=mtlsNamespaces,proto3" json:"mtlsNamespaces,omitempty"`
+	// Status of the mesh expansion gateways
+	MeshExpansionGateways []*MeshExpansionGatewayStatus `protobuf:"bytes,14,rep,name=meshExpansionGateways,proto3" json:"meshExpansionGateways,omitempty"`
+	// Hashes of the values the components were last rendered with, keyed by the component names.
+	// Components with unchanged values are only checked for drift instead of being rendered and applied again.
+	ComponentValuesHashes map[string]string `protobuf:"bytes,15,rep,name=componentValuesHashes,proto3" json:"componentValuesHashes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources of the components which were changed by others, as detected during the last reconciliation
+	Drifts []*ResourceDrift `protobuf:"bytes,16,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	// The spec of the control plane resolved from the referenced profile and the overrides of the resource
+	ResolvedSpec *IstioControlPlaneSpec `protobuf:"bytes,17,opt,name=resolvedSpec,proto3" json:"resolvedSpec,omitempty"`
+	// Resources of the existing Istio installation detected while the adoption is configured
+	AdoptedResources []*AdoptedResource `protobuf:"bytes,18,rep,name=adoptedResources,proto3" json:"adoptedResources,omitempty"`
+	// Health gates of the current spec
+	HealthGates *HealthGatesStatus `protobuf:"bytes,19,opt,name=healthGates,proto3" json:"healthGates,omitempty"`
+	// Conditions of the control plane
+	Conditions []*Condition `protobuf:"bytes,20,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// Results of the sidecar injection self-test by namespace
+	InjectionSelfTests []*InjectionSelfTestResult `protobuf:"bytes,21,rep,name=injectionSelfTests,proto3" json:"injectionSelfTests,omitempty"`
+	// Custom sidecar injection templates which failed to render, the control plane is not reconciled until they are fixed
+	InvalidInjectionTemplates []*InjectionTemplateError `protobuf:"bytes,22,rep,name=invalidInjectionTemplates,proto3" json:"invalidInjectionTemplates,omitempty"`
+}
+
+func (x *IstioControlPlaneStatus) Reset() {
+	*x = IstioControlPlaneStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstioControlPlaneStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstioControlPlaneStatus) ProtoMessage() {}
+
+func (x *IstioControlPlaneStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,132 +3719,209 @@ func (x *PDBConfiguration) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstioControlPlaneStatus.ProtoReflect.Descriptor instead.
+func (*IstioControlPlaneStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IstioControlPlaneStatus) GetStatus() ConfigState {
+	if x != nil {
+		return x.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (x *IstioControlPlaneStatus) GetClusterID() string {
+	if x != nil {
+		return x.ClusterID
+	}
+	return ""
+}
+
+func (x *IstioControlPlaneStatus) GetIstioControlPlaneName() string {
+	if x != nil {
+		return x.IstioControlPlaneName
+	}
+	return ""
+}
+
+func (x *IstioControlPlaneStatus) GetGatewayAddress() []string {
+	if x != nil {
+		return x.GatewayAddress
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneStatus) GetIstiodAddresses() []string {
+	if x != nil {
+		return x.IstiodAddresses
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneStatus) GetInjectionNamespaces() []string {
+	if x != nil {
+		return x.InjectionNamespaces
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneStatus) GetCaRootCertificate() string {
+	if x != nil {
+		return x.CaRootCertificate
+	}
+	return ""
+}
+
+func (x *IstioControlPlaneStatus) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *IstioControlPlaneStatus) GetMeshConfig() *v1alpha1.MeshConfig {
+	if x != nil {
+		return x.MeshConfig
+	}
+	return nil
+}
+
+func (x *IstioControlPlaneStatus) GetChecksums() *StatusChecksums {
+	if x != nil {
+		return x.Checksums
+	}
+	return nil
 }
 
-// Deprecated: Use PDBConfiguration.ProtoReflect.Descriptor instead.
-func (*PDBConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{16}
+func (x *IstioControlPlaneStatus) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
 }
 
-func (x *PDBConfiguration) GetEnabled() *wrappers.BoolValue {
+func (x *IstioControlPlaneStatus) GetGatewayHostnames() []string {
 	if x != nil {
-		return x.Enabled
+		return x.GatewayHostnames
 	}
 	return nil
 }
 
-type HTTPProxyEnvsConfiguration struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *IstioControlPlaneStatus) GetMtlsNamespaces() []*MTLSNamespaceStatus {
+	if x != nil {
+		return x.MtlsNamespaces
+	}
+	return nil
+}
 
-	HttpProxy  string `protobuf:"bytes,1,opt,name=httpProxy,proto3" json:"httpProxy,omitempty"`
-	HttpsProxy string `protobuf:"bytes,2,opt,name=httpsProxy,proto3" json:"httpsProxy,omitempty"`
-	NoProxy    string `protobuf:"bytes,3,opt,name=noProxy,proto3" json:"noProxy,omitempty"`
+func (x *IstioControlPlaneStatus) GetMeshExpansionGateways() []*MeshExpansionGatewayStatus {
+	if x != nil {
+		return x.MeshExpansionGateways
+	}
+	return nil
 }
 
-func (x *HTTPProxyEnvsConfiguration) Reset() {
-	*x = HTTPProxyEnvsConfiguration{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *IstioControlPlaneStatus) GetComponentValuesHashes() map[string]string {
+	if x != nil {
+		return x.ComponentValuesHashes
 	}
+	return nil
 }
 
-func (x *HTTPProxyEnvsConfiguration) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IstioControlPlaneStatus) GetDrifts() []*ResourceDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
 }
 
-func (*HTTPProxyEnvsConfiguration) ProtoMessage() {}
+func (x *IstioControlPlaneStatus) GetResolvedSpec() *IstioControlPlaneSpec {
+	if x != nil {
+		return x.ResolvedSpec
+	}
+	return nil
+}
 
-func (x *HTTPProxyEnvsConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *IstioControlPlaneStatus) GetAdoptedResources() []*AdoptedResource {
+	if x != nil {
+		return x.AdoptedResources
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use HTTPProxyEnvsConfiguration.ProtoReflect.Descriptor instead.
-func (*HTTPProxyEnvsConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{17}
+func (x *IstioControlPlaneStatus) GetHealthGates() *HealthGatesStatus {
+	if x != nil {
+		return x.HealthGates
+	}
+	return nil
 }
 
-func (x *HTTPProxyEnvsConfiguration) GetHttpProxy() string {
+func (x *IstioControlPlaneStatus) GetConditions() []*Condition {
 	if x != nil {
-		return x.HttpProxy
+		return x.Conditions
 	}
-	return ""
+	return nil
 }
 
-func (x *HTTPProxyEnvsConfiguration) GetHttpsProxy() string {
+func (x *IstioControlPlaneStatus) GetInjectionSelfTests() []*InjectionSelfTestResult {
 	if x != nil {
-		return x.HttpsProxy
+		return x.InjectionSelfTests
 	}
-	return ""
+	return nil
 }
 
-func (x *HTTPProxyEnvsConfiguration) GetNoProxy() string {
+func (x *IstioControlPlaneStatus) GetInvalidInjectionTemplates() []*InjectionTemplateError {
 	if x != nil {
-		return x.NoProxy
+		return x.InvalidInjectionTemplates
 	}
-	return ""
+	return nil
 }
 
-// <!-- go code generation tags
-// +genclient
-// +k8s:deepcopy-gen=true
-// -->
-type IstioControlPlaneStatus struct {
+// MeshExpansionGatewayStatus describes the state of a mesh expansion gateway
+type MeshExpansionGatewayStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reconciliation status of the Istio control plane
-	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
-	// Cluster ID
-	ClusterID string `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	// Name of the IstioControlPlane resource
-	// It is used on remote clusters in the PeerIstioControlPlane resource status
-	// to identify the original Istio control plane
-	IstioControlPlaneName string `protobuf:"bytes,3,opt,name=istioControlPlaneName,proto3" json:"istioControlPlaneName,omitempty"`
-	// Current addresses for the corresponding gateways
-	GatewayAddress []string `protobuf:"bytes,4,rep,name=gatewayAddress,proto3" json:"gatewayAddress,omitempty"`
-	// Current addresses for the corresponding istiod pods
-	IstiodAddresses []string `protobuf:"bytes,5,rep,name=istiodAddresses,proto3" json:"istiodAddresses,omitempty"`
-	// Namespaces which are set for injection for this control plane
-	InjectionNamespaces []string `protobuf:"bytes,6,rep,name=injectionNamespaces,proto3" json:"injectionNamespaces,omitempty"`
-	// Istio CA root certificate
-	CaRootCertificate string `protobuf:"bytes,7,opt,name=caRootCertificate,proto3" json:"caRootCertificate,omitempty"`
+	// Name of the IstioMeshGateway resource
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Reconciliation status of the gateway
+	Status ConfigState `protobuf:"varint,2,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
 	// Reconciliation error message if any
-	ErrorMessage string               `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	MeshConfig   *v1alpha1.MeshConfig `protobuf:"bytes,9,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
-	Checksums    *StatusChecksums     `protobuf:"bytes,10,opt,name=checksums,proto3" json:"checksums,omitempty"`
-}
-
-func (x *IstioControlPlaneStatus) Reset() {
-	*x = IstioControlPlaneStatus{}
+	ErrorMessage string `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// Current addresses of the gateway
+	GatewayAddress []string `protobuf:"bytes,4,rep,name=gatewayAddress,proto3" json:"gatewayAddress,omitempty"`
+	// Current hostnames of the gateway
+	GatewayHostnames []string `protobuf:"bytes,5,rep,name=gatewayHostnames,proto3" json:"gatewayHostnames,omitempty"`
+	// Whether istiod is exposed on the gateway
+	ExposeIstiod bool `protobuf:"varint,6,opt,name=exposeIstiod,proto3" json:"exposeIstiod,omitempty"`
+	// Whether the webhook is exposed on the gateway
+	ExposeWebhook bool `protobuf:"varint,7,opt,name=exposeWebhook,proto3" json:"exposeWebhook,omitempty"`
+	// Whether the cluster services are exposed on the gateway
+	ExposeClusterServices bool `protobuf:"varint,8,opt,name=exposeClusterServices,proto3" json:"exposeClusterServices,omitempty"`
+}
+
+func (x *MeshExpansionGatewayStatus) Reset() {
+	*x = MeshExpansionGatewayStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IstioControlPlaneStatus) String() string {
+func (x *MeshExpansionGatewayStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IstioControlPlaneStatus) ProtoMessage() {}
+func (*MeshExpansionGatewayStatus) ProtoMessage() {}
 
-func (x *IstioControlPlaneStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18]
+func (x *MeshExpansionGatewayStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,77 +3932,130 @@ func (x *IstioControlPlaneStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IstioControlPlaneStatus.ProtoReflect.Descriptor instead.
-func (*IstioControlPlaneStatus) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MeshExpansionGatewayStatus.ProtoReflect.Descriptor instead.
+func (*MeshExpansionGatewayStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *IstioControlPlaneStatus) GetStatus() ConfigState {
+func (x *MeshExpansionGatewayStatus) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return ConfigState_Unspecified
+	return ""
 }
 
-func (x *IstioControlPlaneStatus) GetClusterID() string {
+func (x *MeshExpansionGatewayStatus) GetStatus() ConfigState {
 	if x != nil {
-		return x.ClusterID
+		return x.Status
 	}
-	return ""
+	return ConfigState_Unspecified
 }
 
-func (x *IstioControlPlaneStatus) GetIstioControlPlaneName() string {
+func (x *MeshExpansionGatewayStatus) GetErrorMessage() string {
 	if x != nil {
-		return x.IstioControlPlaneName
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *IstioControlPlaneStatus) GetGatewayAddress() []string {
+func (x *MeshExpansionGatewayStatus) GetGatewayAddress() []string {
 	if x != nil {
 		return x.GatewayAddress
 	}
 	return nil
 }
 
-func (x *IstioControlPlaneStatus) GetIstiodAddresses() []string {
+func (x *MeshExpansionGatewayStatus) GetGatewayHostnames() []string {
 	if x != nil {
-		return x.IstiodAddresses
+		return x.GatewayHostnames
 	}
 	return nil
 }
 
-func (x *IstioControlPlaneStatus) GetInjectionNamespaces() []string {
+func (x *MeshExpansionGatewayStatus) GetExposeIstiod() bool {
 	if x != nil {
-		return x.InjectionNamespaces
+		return x.ExposeIstiod
 	}
-	return nil
+	return false
 }
 
-func (x *IstioControlPlaneStatus) GetCaRootCertificate() string {
+func (x *MeshExpansionGatewayStatus) GetExposeWebhook() bool {
 	if x != nil {
-		return x.CaRootCertificate
+		return x.ExposeWebhook
 	}
-	return ""
+	return false
 }
 
-func (x *IstioControlPlaneStatus) GetErrorMessage() string {
+func (x *MeshExpansionGatewayStatus) GetExposeClusterServices() bool {
 	if x != nil {
-		return x.ErrorMessage
+		return x.ExposeClusterServices
+	}
+	return false
+}
+
+// MTLSNamespaceStatus describes the STRICT mTLS migration state of a namespace
+type MTLSNamespaceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the namespace
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Whether STRICT mTLS mode is enforced for the namespace
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+	// Reasons which prevent the migration of the namespace to STRICT mTLS mode
+	Blockers []string `protobuf:"bytes,3,rep,name=blockers,proto3" json:"blockers,omitempty"`
+}
+
+func (x *MTLSNamespaceStatus) Reset() {
+	*x = MTLSNamespaceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MTLSNamespaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MTLSNamespaceStatus) ProtoMessage() {}
+
+func (x *MTLSNamespaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MTLSNamespaceStatus.ProtoReflect.Descriptor instead.
+func (*MTLSNamespaceStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MTLSNamespaceStatus) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *IstioControlPlaneStatus) GetMeshConfig() *v1alpha1.MeshConfig {
+func (x *MTLSNamespaceStatus) GetStrict() bool {
 	if x != nil {
-		return x.MeshConfig
+		return x.Strict
 	}
-	return nil
+	return false
 }
 
-func (x *IstioControlPlaneStatus) GetChecksums() *StatusChecksums {
+func (x *MTLSNamespaceStatus) GetBlockers() []string {
 	if x != nil {
-		return x.Checksums
+		return x.Blockers
 	}
 	return nil
 }
@@ -1931,7 +4076,7 @@ type StatusChecksums struct {
 func (x *StatusChecksums) Reset() {
 	*x = StatusChecksums{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1944,7 +4089,7 @@ func (x *StatusChecksums) String() string {
 func (*StatusChecksums) ProtoMessage() {}
 
 func (x *StatusChecksums) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,7 +4102,7 @@ func (x *StatusChecksums) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusChecksums.ProtoReflect.Descriptor instead.
 func (*StatusChecksums) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{19}
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *StatusChecksums) GetMeshConfig() string {
@@ -1985,7 +4130,7 @@ type MeshExpansionConfiguration_Istiod struct {
 func (x *MeshExpansionConfiguration_Istiod) Reset() {
 	*x = MeshExpansionConfiguration_Istiod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1998,7 +4143,7 @@ func (x *MeshExpansionConfiguration_Istiod) String() string {
 func (*MeshExpansionConfiguration_Istiod) ProtoMessage() {}
 
 func (x *MeshExpansionConfiguration_Istiod) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,7 +4177,7 @@ type MeshExpansionConfiguration_Webhook struct {
 func (x *MeshExpansionConfiguration_Webhook) Reset() {
 	*x = MeshExpansionConfiguration_Webhook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2045,7 +4190,7 @@ func (x *MeshExpansionConfiguration_Webhook) String() string {
 func (*MeshExpansionConfiguration_Webhook) ProtoMessage() {}
 
 func (x *MeshExpansionConfiguration_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +4224,7 @@ type MeshExpansionConfiguration_ClusterServices struct {
 func (x *MeshExpansionConfiguration_ClusterServices) Reset() {
 	*x = MeshExpansionConfiguration_ClusterServices{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2092,7 +4237,7 @@ func (x *MeshExpansionConfiguration_ClusterServices) String() string {
 func (*MeshExpansionConfiguration_ClusterServices) ProtoMessage() {}
 
 func (x *MeshExpansionConfiguration_ClusterServices) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,20 +4280,111 @@ type MeshExpansionConfiguration_IstioMeshGatewayConfiguration struct {
 func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Reset() {
 	*x = MeshExpansionConfiguration_IstioMeshGatewayConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExpansionConfiguration_IstioMeshGatewayConfiguration) ProtoMessage() {}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExpansionConfiguration_IstioMeshGatewayConfiguration.ProtoReflect.Descriptor instead.
+func (*MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetMetadata() *K8SObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetDeployment() *BaseKubernetesResourceConfig {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetService() *UnprotectedService {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetRunAsRoot() *wrappers.BoolValue {
+	if x != nil {
+		return x.RunAsRoot
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetK8SResourceOverlays() []*K8SResourceOverlayPatch {
+	if x != nil {
+		return x.K8SResourceOverlays
+	}
+	return nil
+}
+
+type MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the gateway, used as a suffix of the generated resource names
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Istio Mesh gateway metadata
+	Metadata *K8SObjectMeta `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Deployment spec
+	Deployment *BaseKubernetesResourceConfig `protobuf:"bytes,3,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	// Service spec
+	Service *UnprotectedService `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	// Whether to run the gateway in a privileged container
+	RunAsRoot *wrappers.BoolValue `protobuf:"bytes,5,opt,name=runAsRoot,proto3" json:"runAsRoot,omitempty"`
+	// istiod exposure on this gateway, defaults to the istiod component configuration of the mesh expansion
+	Istiod *MeshExpansionConfiguration_Istiod `protobuf:"bytes,6,opt,name=istiod,proto3" json:"istiod,omitempty"`
+	// webhook exposure on this gateway, defaults to the webhook component configuration of the mesh expansion
+	Webhook *MeshExpansionConfiguration_Webhook `protobuf:"bytes,7,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// cluster services exposure on this gateway, defaults to the cluster services configuration of the mesh expansion
+	ClusterServices *MeshExpansionConfiguration_ClusterServices `protobuf:"bytes,8,opt,name=clusterServices,proto3" json:"clusterServices,omitempty"`
+}
+
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) Reset() {
+	*x = MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) String() string {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeshExpansionConfiguration_IstioMeshGatewayConfiguration) ProtoMessage() {}
+func (*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) ProtoMessage() {}
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23]
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,42 +4395,63 @@ func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeshExpansionConfiguration_IstioMeshGatewayConfiguration.ProtoReflect.Descriptor instead.
-func (*MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{4, 3}
+// Deprecated: Use MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration.ProtoReflect.Descriptor instead.
+func (*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{4, 4}
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetMetadata() *K8SObjectMeta {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetMetadata() *K8SObjectMeta {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetDeployment() *BaseKubernetesResourceConfig {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetDeployment() *BaseKubernetesResourceConfig {
 	if x != nil {
 		return x.Deployment
 	}
 	return nil
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetService() *UnprotectedService {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetService() *UnprotectedService {
 	if x != nil {
 		return x.Service
 	}
 	return nil
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetRunAsRoot() *wrappers.BoolValue {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetRunAsRoot() *wrappers.BoolValue {
 	if x != nil {
 		return x.RunAsRoot
 	}
 	return nil
 }
 
-func (x *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetK8SResourceOverlays() []*K8SResourceOverlayPatch {
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetIstiod() *MeshExpansionConfiguration_Istiod {
 	if x != nil {
-		return x.K8SResourceOverlays
+		return x.Istiod
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetWebhook() *MeshExpansionConfiguration_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) GetClusterServices() *MeshExpansionConfiguration_ClusterServices {
+	if x != nil {
+		return x.ClusterServices
 	}
 	return nil
 }
@@ -2215,7 +4472,7 @@ type CNIConfiguration_RepairConfiguration struct {
 func (x *CNIConfiguration_RepairConfiguration) Reset() {
 	*x = CNIConfiguration_RepairConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2228,7 +4485,7 @@ func (x *CNIConfiguration_RepairConfiguration) String() string {
 func (*CNIConfiguration_RepairConfiguration) ProtoMessage() {}
 
 func (x *CNIConfiguration_RepairConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,7 +4498,7 @@ func (x *CNIConfiguration_RepairConfiguration) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CNIConfiguration_RepairConfiguration.ProtoReflect.Descriptor instead.
 func (*CNIConfiguration_RepairConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{9, 0}
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *CNIConfiguration_RepairConfiguration) GetEnabled() *wrappers.BoolValue {
@@ -2298,7 +4555,7 @@ type CNIConfiguration_TaintConfiguration struct {
 func (x *CNIConfiguration_TaintConfiguration) Reset() {
 	*x = CNIConfiguration_TaintConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2311,7 +4568,7 @@ func (x *CNIConfiguration_TaintConfiguration) String() string {
 func (*CNIConfiguration_TaintConfiguration) ProtoMessage() {}
 
 func (x *CNIConfiguration_TaintConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,7 +4581,7 @@ func (x *CNIConfiguration_TaintConfiguration) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CNIConfiguration_TaintConfiguration.ProtoReflect.Descriptor instead.
 func (*CNIConfiguration_TaintConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{9, 1}
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *CNIConfiguration_TaintConfiguration) GetEnabled() *wrappers.BoolValue {
@@ -2354,7 +4611,7 @@ type CNIConfiguration_ResourceQuotas struct {
 func (x *CNIConfiguration_ResourceQuotas) Reset() {
 	*x = CNIConfiguration_ResourceQuotas{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26]
+		mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2367,7 +4624,7 @@ func (x *CNIConfiguration_ResourceQuotas) String() string {
 func (*CNIConfiguration_ResourceQuotas) ProtoMessage() {}
 
 func (x *CNIConfiguration_ResourceQuotas) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26]
+	mi := &file_api_v1alpha1_istiocontrolplane_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2380,7 +4637,7 @@ func (x *CNIConfiguration_ResourceQuotas) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CNIConfiguration_ResourceQuotas.ProtoReflect.Descriptor instead.
 func (*CNIConfiguration_ResourceQuotas) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{9, 2}
+	return file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP(), []int{21, 2}
 }
 
 func (x *CNIConfiguration_ResourceQuotas) GetEnabled() *wrappers.BoolValue {
@@ -2417,479 +4674,863 @@ var file_api_v1alpha1_istiocontrolplane_proto_rawDesc = []byte{
 	0x69, 0x73, 0x74, 0x69, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x6b, 0x38, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6d, 0x65, 0x73, 0x68,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xd0, 0x0d, 0x0a, 0x15, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
-	0x41, 0x01, 0x02, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x74,
-	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x4e, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x12, 0x42, 0x0a, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x74, 0x6c, 0x73, 0x43,
-	0x65, 0x72, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f,
-	0x64, 0x12, 0x48, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x61, 0x74, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x34, 0x6b, 0x38, 0x73, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x15, 0x0a, 0x15, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x54, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69,
-	0x74, 0x12, 0x5a, 0x0a, 0x0b, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x32,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x56, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x32, 0x12, 0x42, 0x0a,
-	0x03, 0x73, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x74,
+	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x74,
 	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x44, 0x53, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x73, 0x64,
-	0x73, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x61, 0x73, 0x6d, 0x18, 0x0a,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x48, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
 	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x61, 0x73, 0x6d, 0x43,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x57, 0x61, 0x73, 0x6d, 0x12, 0x48, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x63, 0x68,
-	0x4f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
-	0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x4b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x6a, 0x77, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x60, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x45, 0x6e, 0x76, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e,
-	0x76, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x69, 0x0a, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65,
-	0x72, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x73, 0x68, 0x49, 0x44, 0x12, 0x7d, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73,
-	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70,
-	0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69,
+	0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x5a, 0x0a, 0x0b, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x56, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69,
 	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x68, 0x45, 0x78,
-	0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x56, 0x32, 0x12, 0x42, 0x0a, 0x03, 0x73, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
-	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x34, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x72, 0x22, 0x98, 0x02, 0x0a, 0x1c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74,
+	0x31, 0x2e, 0x53, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x03, 0x73, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x57, 0x61, 0x73, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x73, 0x74,
 	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x74,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x57, 0x61, 0x73, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x61, 0x73, 0x6d, 0x12, 0x48, 0x0a,
+	0x11, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74,
 	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x22, 0xba, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x12, 0x69, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69, 0x73,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6a, 0x77, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x73, 0x74, 0x69, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x69, 0x0a, 0x13, 0x6b, 0x38, 0x73,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x44, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x44, 0x12, 0x7d, 0x0a, 0x1b,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0d, 0x6d,
+	0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a,
+	0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69,
+	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x63, 0x0a, 0x0e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x12, 0x4b, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a,
+	0x04, 0x6d, 0x74, 0x6c, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
 	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0f, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a,
-	0x1f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e,
-	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x22, 0xf7, 0x08, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x72, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x58, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x54, 0x4c,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x6d, 0x74, 0x6c, 0x73, 0x12, 0x71, 0x0a, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e,
+	0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x12,
+	0x51, 0x0a, 0x0d, 0x64, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x06, 0x69, 0x73, 0x74,
-	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x73, 0x74, 0x69,
-	0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45,
-	0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x69, 0x73,
-	0x74, 0x69, 0x6f, 0x64, 0x12, 0x5c, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
-	0x6f, 0x6b, 0x12, 0x74, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x69, 0x73,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x08,
+	0x61, 0x64, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x64, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5a, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x11, 0x69,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74,
+	0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x69, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x98,
+	0x02, 0x0a, 0x1c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x57, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x69, 0x0a, 0x0f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x1f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xb2, 0x0f, 0x0a, 0x1a, 0x4d, 0x65,
+	0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x72,
+	0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x58, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x59, 0x0a, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49,
+	0x73, 0x74, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x5c, 0x0a,
+	0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x74, 0x0a, 0x0f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x92, 0x01, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x62,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64,
+	0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x1a, 0x3d, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x73, 0x65, 0x1a, 0x45, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x1a, 0xbb, 0x03, 0x0a, 0x1d, 0x49,
+	0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73,
 	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x49, 0x73, 0x74, 0x69,
-	0x6f, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x1a, 0x3d, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65,
-	0x78, 0x70, 0x6f, 0x73, 0x65, 0x1a, 0x45, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x1a, 0xbb, 0x03, 0x0a,
-	0x1d, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x6f, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x69, 0x0a,
+	0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0xa3, 0x05, 0x0a, 0x27, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52,
 	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
 	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
 	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42,
 	0x61, 0x73, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x65, 0x70,
 	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f,
 	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x72, 0x6f, 0x74,
 	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x6f,
-	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x69, 0x0a, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69,
-	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38,
-	0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x44, 0x53, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x22, 0xa2, 0x06, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x12,
-	0x49, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x59, 0x0a, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x64, 0x52, 0x06, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x5c, 0x0a, 0x07, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x74, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x4a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x64,
-	0x0a, 0x1f, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x0f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xcf,
+	0x02, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x1a, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x22, 0x69, 0x0a, 0x1d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x61, 0x69, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x1b,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x64, 0x0a,
+	0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x20, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x4c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x6b, 0x62,
+	0x61, 0x63, 0x6b, 0x22, 0xd7, 0x01, 0x0a, 0x13, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x48, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x5c, 0x0a,
+	0x0a, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xb8, 0x01, 0x0a, 0x11,
+	0x4d, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x35, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x7c, 0x0a, 0x1b, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5d, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x6c, 0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x42,
+	0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xfa, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x74, 0x69,
+	0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x63, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x63,
+	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2c,
+	0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x38, 0x0a, 0x10,
+	0x53, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa2, 0x06, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x1f, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x69, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x66,
-	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
-	0x65, 0x12, 0x52, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x49, 0x50, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x50, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
-	0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x50, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x49, 0x50, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x12,
+	0x42, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x75, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x44,
+	0x75, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x64, 0x0a, 0x1f, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1f, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x38,
+	0x73, 0x2e, 0x69, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x49, 0x50, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x50, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x50,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x50, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x13, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65,
 	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x1b, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x22, 0xc6, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73,
-	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x03,
-	0x63, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x74, 0x69,
-	0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x63, 0x6e, 0x69,
-	0x22, 0x84, 0x0b, 0x0a, 0x10, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x66, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66,
-	0x44, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x12, 0x70, 0x73, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x73, 0x70,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x5c, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x44, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x22, 0xc6, 0x01, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
 	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x59, 0x0a,
-	0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x69,
-	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x4e,
-	0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54,
-	0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x03, 0x63, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x63, 0x6e, 0x69, 0x22, 0x84, 0x0b, 0x0a, 0x10, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x66, 0x44, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x73, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x70, 0x73, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x12, 0x59, 0x0a, 0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x43, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0x12, 0x5a, 0x0a, 0x09, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
+	0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
 	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x09, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x1a, 0xcf, 0x02,
-	0x0a, 0x13, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x50, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x64,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e,
-	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x11, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x72, 0x6f, 0x6b,
-	0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a,
-	0x13, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x72, 0x6f, 0x6b,
-	0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
-	0xae, 0x01, 0x0a, 0x12, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x62, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x44, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f,
-	0x74, 0x61, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0x93, 0x06, 0x0a, 0x13, 0x49, 0x73, 0x74, 0x69,
-	0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x5a, 0x0a, 0x09, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73,
+	0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65,
+	0x74, 0x1a, 0xcf, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x38, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0xae, 0x01, 0x0a, 0x12, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x62, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
 	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a,
-	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x63, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74,
-	0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73, 0x74, 0x69,
+	0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x64,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0x93, 0x06, 0x0a, 0x13,
+	0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x42, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x47, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x69, 0x6e, 0x67, 0x12, 0x62, 0x0a, 0x1e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x60, 0x0a, 0x1d, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x59, 0x0a, 0x0c, 0x63, 0x65, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x35, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x70, 0x69, 0x66, 0x66,
+	0x65, 0x22, 0x53, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x53, 0x50, 0x49, 0x46, 0x46,
+	0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c,
+	0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x69, 0x73, 0x74, 0x69,
 	0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x47, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
-	0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x62, 0x0a, 0x1e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x1e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x60, 0x0a, 0x1d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x6e, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x59, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x69, 0x73,
-	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x69, 0x6c,
-	0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x4b, 0x0a, 0x06, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x33, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x22, 0x53, 0x0a,
-	0x1b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x11, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x1e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x6e,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x1e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x18, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x56, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x57,
+	0x61, 0x73, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x44, 0x42, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e,
 	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
 	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x22, 0x50, 0x0a, 0x18, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x32, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x61, 0x73, 0x6d, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x74, 0x0a, 0x1a,
-	0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74,
-	0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68,
-	0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70,
-	0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x22, 0x98, 0x04, 0x0a, 0x17, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
+	0x22, 0x74, 0x0a, 0x1a, 0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x76,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x68, 0x74, 0x74, 0x70, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x64, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x10, 0x02, 0x22, 0x82, 0x02, 0x0a,
+	0x18, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x22, 0xd8, 0x02, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x4d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x37, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4c,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x10, 0x04, 0x22, 0x52, 0x0a, 0x0a,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xb7, 0x01, 0x0a, 0x1e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x49,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x5e, 0x0a, 0x16, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xdd, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x6f,
+	0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x41, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x65,
+	0x64, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x10, 0x03, 0x22, 0xfe, 0x0c, 0x0a, 0x17, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
 	0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
 	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
@@ -2922,40 +5563,140 @@ var file_api_v1alpha1_istiocontrolplane_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x73, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x22, 0x5b, 0x0a,
-	0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2a, 0x3d, 0x0a, 0x08, 0x4d, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x06, 0x12,
-	0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x07, 0x2a, 0x5a, 0x0a, 0x15, 0x50, 0x69, 0x6c, 0x6f,
-	0x74, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45,
-	0x52, 0x4e, 0x45, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x53, 0x54, 0x49,
-	0x4f, 0x44, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x0d, 0x4a, 0x57, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4a, 0x57, 0x54, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x59, 0x5f, 0x4a, 0x57, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x52,
-	0x53, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x4a, 0x57, 0x54, 0x10, 0x02, 0x42, 0x37,
-	0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
-	0x7a, 0x61, 0x69, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x73, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x0e, 0x6d, 0x74, 0x6c, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x74, 0x69,
+	0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e,
+	0x6d, 0x74, 0x6c, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x70,
+	0x0a, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x45,
+	0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73,
+	0x12, 0x88, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x52, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x64,
+	0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66,
+	0x74, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x53, 0x70,
+	0x65, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x5b, 0x0a,
+	0x10, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x49, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x67, 0x0a, 0x12, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x12, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x74, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x19,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x48, 0x0a, 0x1a, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xed, 0x02, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x61,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x34, 0x0a,
+	0x15, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x4d, 0x54, 0x4c, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x28, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2a, 0x3d, 0x0a, 0x08, 0x4d, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x07,
+	0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x07, 0x2a, 0x5a, 0x0a, 0x15, 0x50, 0x69, 0x6c, 0x6f, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x21, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45, 0x52,
+	0x4e, 0x45, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x53, 0x54, 0x49, 0x4f,
+	0x44, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x0d, 0x4a, 0x57, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4a, 0x57, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x59, 0x5f, 0x4a, 0x57, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x52, 0x53,
+	0x54, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x4a, 0x57, 0x54, 0x10, 0x02, 0x42, 0x37, 0x5a,
+	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x7a,
+	0x61, 0x69, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2970,134 +5711,229 @@ func file_api_v1alpha1_istiocontrolplane_proto_rawDescGZIP() []byte {
 	return file_api_v1alpha1_istiocontrolplane_proto_rawDescData
 }
 
-var file_api_v1alpha1_istiocontrolplane_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_v1alpha1_istiocontrolplane_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_v1alpha1_istiocontrolplane_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_v1alpha1_istiocontrolplane_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_api_v1alpha1_istiocontrolplane_proto_goTypes = []interface{}{
 	(ModeType)(0),                                                    // 0: istio_operator.v2.api.v1alpha1.ModeType
 	(ProxyLogLevel)(0),                                               // 1: istio_operator.v2.api.v1alpha1.ProxyLogLevel
 	(PilotCertProviderType)(0),                                       // 2: istio_operator.v2.api.v1alpha1.PilotCertProviderType
 	(JWTPolicyType)(0),                                               // 3: istio_operator.v2.api.v1alpha1.JWTPolicyType
-	(*IstioControlPlaneSpec)(nil),                                    // 4: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec
-	(*SidecarInjectorConfiguration)(nil),                             // 5: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration
-	(*SidecarInjectionTemplates)(nil),                                // 6: istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates
-	(*CustomSidecarInjectionTemplates)(nil),                          // 7: istio_operator.v2.api.v1alpha1.CustomSidecarInjectionTemplates
-	(*MeshExpansionConfiguration)(nil),                               // 8: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration
-	(*LoggingConfiguration)(nil),                                     // 9: istio_operator.v2.api.v1alpha1.LoggingConfiguration
-	(*SDSConfiguration)(nil),                                         // 10: istio_operator.v2.api.v1alpha1.SDSConfiguration
-	(*ProxyConfiguration)(nil),                                       // 11: istio_operator.v2.api.v1alpha1.ProxyConfiguration
-	(*ProxyInitConfiguration)(nil),                                   // 12: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration
-	(*CNIConfiguration)(nil),                                         // 13: istio_operator.v2.api.v1alpha1.CNIConfiguration
-	(*IstiodConfiguration)(nil),                                      // 14: istio_operator.v2.api.v1alpha1.IstiodConfiguration
-	(*ExternalIstiodConfiguration)(nil),                              // 15: istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration
-	(*SPIFFEConfiguration)(nil),                                      // 16: istio_operator.v2.api.v1alpha1.SPIFFEConfiguration
-	(*OperatorEndpointsConfiguration)(nil),                           // 17: istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration
-	(*TelemetryV2Configuration)(nil),                                 // 18: istio_operator.v2.api.v1alpha1.TelemetryV2Configuration
-	(*ProxyWasmConfiguration)(nil),                                   // 19: istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration
-	(*PDBConfiguration)(nil),                                         // 20: istio_operator.v2.api.v1alpha1.PDBConfiguration
-	(*HTTPProxyEnvsConfiguration)(nil),                               // 21: istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration
-	(*IstioControlPlaneStatus)(nil),                                  // 22: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus
-	(*StatusChecksums)(nil),                                          // 23: istio_operator.v2.api.v1alpha1.StatusChecksums
-	(*MeshExpansionConfiguration_Istiod)(nil),                        // 24: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod
-	(*MeshExpansionConfiguration_Webhook)(nil),                       // 25: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook
-	(*MeshExpansionConfiguration_ClusterServices)(nil),               // 26: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices
-	(*MeshExpansionConfiguration_IstioMeshGatewayConfiguration)(nil), // 27: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration
-	(*CNIConfiguration_RepairConfiguration)(nil),                     // 28: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration
-	(*CNIConfiguration_TaintConfiguration)(nil),                      // 29: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration
-	(*CNIConfiguration_ResourceQuotas)(nil),                          // 30: istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas
-	(*wrappers.BoolValue)(nil),                                       // 31: google.protobuf.BoolValue
-	(*v1alpha1.MeshConfig)(nil),                                      // 32: istio.mesh.v1alpha1.MeshConfig
-	(*K8SResourceOverlayPatch)(nil),                                  // 33: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	(*ContainerImageConfiguration)(nil),                              // 34: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
-	(*v1alpha1.Tracing)(nil),                                         // 35: istio.mesh.v1alpha1.Tracing
-	(*BaseKubernetesResourceConfig)(nil),                             // 36: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	(*Service)(nil),                                                  // 37: istio_operator.v2.api.v1alpha1.Service
-	(*v1.Lifecycle)(nil),                                             // 38: k8s.io.api.core.v1.Lifecycle
-	(*ResourceRequirements)(nil),                                     // 39: istio_operator.v2.api.v1alpha1.ResourceRequirements
-	(*wrappers.FloatValue)(nil),                                      // 40: google.protobuf.FloatValue
-	(ConfigState)(0),                                                 // 41: istio_operator.v2.api.v1alpha1.ConfigState
-	(*K8SObjectMeta)(nil),                                            // 42: istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	(*UnprotectedService)(nil),                                       // 43: istio_operator.v2.api.v1alpha1.UnprotectedService
-	(*BaseKubernetesContainerConfiguration)(nil),                     // 44: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
+	(AdoptionConfiguration_Mode)(0),                                  // 4: istio_operator.v2.api.v1alpha1.AdoptionConfiguration.Mode
+	(HealthGatesStatus_State)(0),                                     // 5: istio_operator.v2.api.v1alpha1.HealthGatesStatus.State
+	(AdoptedResource_State)(0),                                       // 6: istio_operator.v2.api.v1alpha1.AdoptedResource.State
+	(*IstioControlPlaneSpec)(nil),                                    // 7: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec
+	(*SidecarInjectorConfiguration)(nil),                             // 8: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration
+	(*SidecarInjectionTemplates)(nil),                                // 9: istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates
+	(*CustomSidecarInjectionTemplates)(nil),                          // 10: istio_operator.v2.api.v1alpha1.CustomSidecarInjectionTemplates
+	(*MeshExpansionConfiguration)(nil),                               // 11: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration
+	(*NetworkConfiguration)(nil),                                     // 12: istio_operator.v2.api.v1alpha1.NetworkConfiguration
+	(*LocalityFailoverConfiguration)(nil),                            // 13: istio_operator.v2.api.v1alpha1.LocalityFailoverConfiguration
+	(*DefaultSidecarConfiguration)(nil),                              // 14: istio_operator.v2.api.v1alpha1.DefaultSidecarConfiguration
+	(*SidecarLearningModeConfiguration)(nil),                         // 15: istio_operator.v2.api.v1alpha1.SidecarLearningModeConfiguration
+	(*EgressConfiguration)(nil),                                      // 16: istio_operator.v2.api.v1alpha1.EgressConfiguration
+	(*EgressHost)(nil),                                               // 17: istio_operator.v2.api.v1alpha1.EgressHost
+	(*MTLSConfiguration)(nil),                                        // 18: istio_operator.v2.api.v1alpha1.MTLSConfiguration
+	(*MeshGatewayAttachmentPolicy)(nil),                              // 19: istio_operator.v2.api.v1alpha1.MeshGatewayAttachmentPolicy
+	(*MeshGatewaySelector)(nil),                                      // 20: istio_operator.v2.api.v1alpha1.MeshGatewaySelector
+	(*AddonComponent)(nil),                                           // 21: istio_operator.v2.api.v1alpha1.AddonComponent
+	(*AddonChartSource)(nil),                                         // 22: istio_operator.v2.api.v1alpha1.AddonChartSource
+	(*AddonChartConfigMapSource)(nil),                                // 23: istio_operator.v2.api.v1alpha1.AddonChartConfigMapSource
+	(*LoggingConfiguration)(nil),                                     // 24: istio_operator.v2.api.v1alpha1.LoggingConfiguration
+	(*SDSConfiguration)(nil),                                         // 25: istio_operator.v2.api.v1alpha1.SDSConfiguration
+	(*ProxyConfiguration)(nil),                                       // 26: istio_operator.v2.api.v1alpha1.ProxyConfiguration
+	(*ProxyInitConfiguration)(nil),                                   // 27: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration
+	(*CNIConfiguration)(nil),                                         // 28: istio_operator.v2.api.v1alpha1.CNIConfiguration
+	(*IstiodConfiguration)(nil),                                      // 29: istio_operator.v2.api.v1alpha1.IstiodConfiguration
+	(*ExternalIstiodConfiguration)(nil),                              // 30: istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration
+	(*SPIFFEConfiguration)(nil),                                      // 31: istio_operator.v2.api.v1alpha1.SPIFFEConfiguration
+	(*OperatorEndpointsConfiguration)(nil),                           // 32: istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration
+	(*TelemetryV2Configuration)(nil),                                 // 33: istio_operator.v2.api.v1alpha1.TelemetryV2Configuration
+	(*ProxyWasmConfiguration)(nil),                                   // 34: istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration
+	(*PDBConfiguration)(nil),                                         // 35: istio_operator.v2.api.v1alpha1.PDBConfiguration
+	(*HTTPProxyEnvsConfiguration)(nil),                               // 36: istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration
+	(*AdoptionConfiguration)(nil),                                    // 37: istio_operator.v2.api.v1alpha1.AdoptionConfiguration
+	(*HealthGatesConfiguration)(nil),                                 // 38: istio_operator.v2.api.v1alpha1.HealthGatesConfiguration
+	(*HealthGatesStatus)(nil),                                        // 39: istio_operator.v2.api.v1alpha1.HealthGatesStatus
+	(*HealthGate)(nil),                                               // 40: istio_operator.v2.api.v1alpha1.HealthGate
+	(*InjectionSelfTestConfiguration)(nil),                           // 41: istio_operator.v2.api.v1alpha1.InjectionSelfTestConfiguration
+	(*InjectionSelfTestResult)(nil),                                  // 42: istio_operator.v2.api.v1alpha1.InjectionSelfTestResult
+	(*InjectionTemplateError)(nil),                                   // 43: istio_operator.v2.api.v1alpha1.InjectionTemplateError
+	(*Condition)(nil),                                                // 44: istio_operator.v2.api.v1alpha1.Condition
+	(*AdoptedResource)(nil),                                          // 45: istio_operator.v2.api.v1alpha1.AdoptedResource
+	(*IstioControlPlaneStatus)(nil),                                  // 46: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus
+	(*MeshExpansionGatewayStatus)(nil),                               // 47: istio_operator.v2.api.v1alpha1.MeshExpansionGatewayStatus
+	(*MTLSNamespaceStatus)(nil),                                      // 48: istio_operator.v2.api.v1alpha1.MTLSNamespaceStatus
+	(*StatusChecksums)(nil),                                          // 49: istio_operator.v2.api.v1alpha1.StatusChecksums
+	(*MeshExpansionConfiguration_Istiod)(nil),                        // 50: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod
+	(*MeshExpansionConfiguration_Webhook)(nil),                       // 51: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook
+	(*MeshExpansionConfiguration_ClusterServices)(nil),               // 52: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices
+	(*MeshExpansionConfiguration_IstioMeshGatewayConfiguration)(nil), // 53: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration
+	(*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration)(nil), // 54: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration
+	nil, // 55: istio_operator.v2.api.v1alpha1.MeshGatewaySelector.NamespaceLabelsEntry
+	nil, // 56: istio_operator.v2.api.v1alpha1.MeshGatewaySelector.GatewayLabelsEntry
+	(*CNIConfiguration_RepairConfiguration)(nil), // 57: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration
+	(*CNIConfiguration_TaintConfiguration)(nil),  // 58: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration
+	(*CNIConfiguration_ResourceQuotas)(nil),      // 59: istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas
+	nil,                                          // 60: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.ComponentValuesHashesEntry
+	(*wrappers.BoolValue)(nil),                   // 61: google.protobuf.BoolValue
+	(*v1alpha1.MeshConfig)(nil),                  // 62: istio.mesh.v1alpha1.MeshConfig
+	(*K8SResourceOverlayPatch)(nil),              // 63: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	(*ContainerImageConfiguration)(nil),          // 64: istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
+	(*v1alpha1.Tracing)(nil),                     // 65: istio.mesh.v1alpha1.Tracing
+	(*DriftPolicy)(nil),                          // 66: istio_operator.v2.api.v1alpha1.DriftPolicy
+	(*v1.LabelSelector)(nil),                     // 67: k8s.io.apimachinery.pkg.apis.meta.v1.LabelSelector
+	(*BaseKubernetesResourceConfig)(nil),         // 68: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	(*Service)(nil),                              // 69: istio_operator.v2.api.v1alpha1.Service
+	(*wrappers.UInt32Value)(nil),                 // 70: google.protobuf.UInt32Value
+	(*duration.Duration)(nil),                    // 71: google.protobuf.Duration
+	(*NamespacedName)(nil),                       // 72: istio_operator.v2.api.v1alpha1.NamespacedName
+	(*v11.Lifecycle)(nil),                        // 73: k8s.io.api.core.v1.Lifecycle
+	(*ResourceRequirements)(nil),                 // 74: istio_operator.v2.api.v1alpha1.ResourceRequirements
+	(*wrappers.FloatValue)(nil),                  // 75: google.protobuf.FloatValue
+	(*wrappers.Int32Value)(nil),                  // 76: google.protobuf.Int32Value
+	(ConfigState)(0),                             // 77: istio_operator.v2.api.v1alpha1.ConfigState
+	(*ResourceDrift)(nil),                        // 78: istio_operator.v2.api.v1alpha1.ResourceDrift
+	(*K8SObjectMeta)(nil),                        // 79: istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	(*UnprotectedService)(nil),                   // 80: istio_operator.v2.api.v1alpha1.UnprotectedService
+	(*BaseKubernetesContainerConfiguration)(nil), // 81: istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
 }
 var file_api_v1alpha1_istiocontrolplane_proto_depIdxs = []int32{
-	0,  // 0: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.mode:type_name -> istio_operator.v2.api.v1alpha1.ModeType
-	9,  // 1: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.logging:type_name -> istio_operator.v2.api.v1alpha1.LoggingConfiguration
-	31, // 2: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.mountMtlsCerts:type_name -> google.protobuf.BoolValue
-	14, // 3: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.istiod:type_name -> istio_operator.v2.api.v1alpha1.IstiodConfiguration
-	11, // 4: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxy:type_name -> istio_operator.v2.api.v1alpha1.ProxyConfiguration
-	12, // 5: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxyInit:type_name -> istio_operator.v2.api.v1alpha1.ProxyInitConfiguration
-	18, // 6: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.telemetryV2:type_name -> istio_operator.v2.api.v1alpha1.TelemetryV2Configuration
-	10, // 7: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.sds:type_name -> istio_operator.v2.api.v1alpha1.SDSConfiguration
-	19, // 8: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxyWasm:type_name -> istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration
-	31, // 9: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.watchOneNamespace:type_name -> google.protobuf.BoolValue
-	3,  // 10: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.jwtPolicy:type_name -> istio_operator.v2.api.v1alpha1.JWTPolicyType
-	21, // 11: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.httpProxyEnvs:type_name -> istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration
-	32, // 12: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.meshConfig:type_name -> istio.mesh.v1alpha1.MeshConfig
-	33, // 13: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	34, // 14: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.containerImageConfiguration:type_name -> istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
-	8,  // 15: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.meshExpansion:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration
-	5,  // 16: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.sidecarInjector:type_name -> istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration
-	35, // 17: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.tracer:type_name -> istio.mesh.v1alpha1.Tracing
-	36, // 18: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	37, // 19: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.service:type_name -> istio_operator.v2.api.v1alpha1.Service
-	6,  // 20: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.templates:type_name -> istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates
-	7,  // 21: istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates.customTemplates:type_name -> istio_operator.v2.api.v1alpha1.CustomSidecarInjectionTemplates
-	31, // 22: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	27, // 23: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.gateway:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration
-	24, // 24: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.istiod:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod
-	25, // 25: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.webhook:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook
-	26, // 26: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.clusterServices:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices
-	31, // 27: istio_operator.v2.api.v1alpha1.ProxyConfiguration.privileged:type_name -> google.protobuf.BoolValue
-	31, // 28: istio_operator.v2.api.v1alpha1.ProxyConfiguration.enableCoreDump:type_name -> google.protobuf.BoolValue
-	1,  // 29: istio_operator.v2.api.v1alpha1.ProxyConfiguration.logLevel:type_name -> istio_operator.v2.api.v1alpha1.ProxyLogLevel
-	31, // 30: istio_operator.v2.api.v1alpha1.ProxyConfiguration.holdApplicationUntilProxyStarts:type_name -> google.protobuf.BoolValue
-	38, // 31: istio_operator.v2.api.v1alpha1.ProxyConfiguration.lifecycle:type_name -> k8s.io.api.core.v1.Lifecycle
-	39, // 32: istio_operator.v2.api.v1alpha1.ProxyConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
-	39, // 33: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
-	13, // 34: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration.cni:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration
-	31, // 35: istio_operator.v2.api.v1alpha1.CNIConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	31, // 36: istio_operator.v2.api.v1alpha1.CNIConfiguration.chained:type_name -> google.protobuf.BoolValue
-	28, // 37: istio_operator.v2.api.v1alpha1.CNIConfiguration.repair:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration
-	29, // 38: istio_operator.v2.api.v1alpha1.CNIConfiguration.taint:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration
-	30, // 39: istio_operator.v2.api.v1alpha1.CNIConfiguration.resourceQuotas:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas
-	36, // 40: istio_operator.v2.api.v1alpha1.CNIConfiguration.daemonset:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	36, // 41: istio_operator.v2.api.v1alpha1.IstiodConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	31, // 42: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableAnalysis:type_name -> google.protobuf.BoolValue
-	31, // 43: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableStatus:type_name -> google.protobuf.BoolValue
-	15, // 44: istio_operator.v2.api.v1alpha1.IstiodConfiguration.externalIstiod:type_name -> istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration
-	40, // 45: istio_operator.v2.api.v1alpha1.IstiodConfiguration.traceSampling:type_name -> google.protobuf.FloatValue
-	31, // 46: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableProtocolSniffingOutbound:type_name -> google.protobuf.BoolValue
-	31, // 47: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableProtocolSniffingInbound:type_name -> google.protobuf.BoolValue
-	2,  // 48: istio_operator.v2.api.v1alpha1.IstiodConfiguration.certProvider:type_name -> istio_operator.v2.api.v1alpha1.PilotCertProviderType
-	16, // 49: istio_operator.v2.api.v1alpha1.IstiodConfiguration.spiffe:type_name -> istio_operator.v2.api.v1alpha1.SPIFFEConfiguration
-	31, // 50: istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	17, // 51: istio_operator.v2.api.v1alpha1.SPIFFEConfiguration.operatorEndpoints:type_name -> istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration
-	31, // 52: istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	31, // 53: istio_operator.v2.api.v1alpha1.TelemetryV2Configuration.enabled:type_name -> google.protobuf.BoolValue
-	31, // 54: istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	31, // 55: istio_operator.v2.api.v1alpha1.PDBConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	41, // 56: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.status:type_name -> istio_operator.v2.api.v1alpha1.ConfigState
-	32, // 57: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.meshConfig:type_name -> istio.mesh.v1alpha1.MeshConfig
-	23, // 58: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.checksums:type_name -> istio_operator.v2.api.v1alpha1.StatusChecksums
-	31, // 59: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod.expose:type_name -> google.protobuf.BoolValue
-	31, // 60: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook.expose:type_name -> google.protobuf.BoolValue
-	31, // 61: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices.expose:type_name -> google.protobuf.BoolValue
-	42, // 62: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
-	36, // 63: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	43, // 64: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.service:type_name -> istio_operator.v2.api.v1alpha1.UnprotectedService
-	31, // 65: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.runAsRoot:type_name -> google.protobuf.BoolValue
-	33, // 66: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	31, // 67: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	31, // 68: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.labelPods:type_name -> google.protobuf.BoolValue
-	31, // 69: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.deletePods:type_name -> google.protobuf.BoolValue
-	31, // 70: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration.enabled:type_name -> google.protobuf.BoolValue
-	44, // 71: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration.container:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
-	31, // 72: istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas.enabled:type_name -> google.protobuf.BoolValue
-	73, // [73:73] is the sub-list for method output_type
-	73, // [73:73] is the sub-list for method input_type
-	73, // [73:73] is the sub-list for extension type_name
-	73, // [73:73] is the sub-list for extension extendee
-	0,  // [0:73] is the sub-list for field type_name
+	0,   // 0: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.mode:type_name -> istio_operator.v2.api.v1alpha1.ModeType
+	24,  // 1: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.logging:type_name -> istio_operator.v2.api.v1alpha1.LoggingConfiguration
+	61,  // 2: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.mountMtlsCerts:type_name -> google.protobuf.BoolValue
+	29,  // 3: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.istiod:type_name -> istio_operator.v2.api.v1alpha1.IstiodConfiguration
+	26,  // 4: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxy:type_name -> istio_operator.v2.api.v1alpha1.ProxyConfiguration
+	27,  // 5: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxyInit:type_name -> istio_operator.v2.api.v1alpha1.ProxyInitConfiguration
+	33,  // 6: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.telemetryV2:type_name -> istio_operator.v2.api.v1alpha1.TelemetryV2Configuration
+	25,  // 7: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.sds:type_name -> istio_operator.v2.api.v1alpha1.SDSConfiguration
+	34,  // 8: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.proxyWasm:type_name -> istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration
+	61,  // 9: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.watchOneNamespace:type_name -> google.protobuf.BoolValue
+	3,   // 10: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.jwtPolicy:type_name -> istio_operator.v2.api.v1alpha1.JWTPolicyType
+	36,  // 11: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.httpProxyEnvs:type_name -> istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration
+	62,  // 12: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.meshConfig:type_name -> istio.mesh.v1alpha1.MeshConfig
+	63,  // 13: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	64,  // 14: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.containerImageConfiguration:type_name -> istio_operator.v2.api.v1alpha1.ContainerImageConfiguration
+	11,  // 15: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.meshExpansion:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration
+	8,   // 16: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.sidecarInjector:type_name -> istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration
+	65,  // 17: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.tracer:type_name -> istio.mesh.v1alpha1.Tracing
+	12,  // 18: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.network:type_name -> istio_operator.v2.api.v1alpha1.NetworkConfiguration
+	14,  // 19: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.defaultSidecar:type_name -> istio_operator.v2.api.v1alpha1.DefaultSidecarConfiguration
+	16,  // 20: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.egress:type_name -> istio_operator.v2.api.v1alpha1.EgressConfiguration
+	18,  // 21: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.mtls:type_name -> istio_operator.v2.api.v1alpha1.MTLSConfiguration
+	19,  // 22: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.meshGatewayAttachment:type_name -> istio_operator.v2.api.v1alpha1.MeshGatewayAttachmentPolicy
+	21,  // 23: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.addons:type_name -> istio_operator.v2.api.v1alpha1.AddonComponent
+	66,  // 24: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.driftPolicies:type_name -> istio_operator.v2.api.v1alpha1.DriftPolicy
+	37,  // 25: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.adoption:type_name -> istio_operator.v2.api.v1alpha1.AdoptionConfiguration
+	38,  // 26: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.healthGates:type_name -> istio_operator.v2.api.v1alpha1.HealthGatesConfiguration
+	41,  // 27: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.injectionSelfTest:type_name -> istio_operator.v2.api.v1alpha1.InjectionSelfTestConfiguration
+	67,  // 28: istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec.discoverySelectors:type_name -> k8s.io.apimachinery.pkg.apis.meta.v1.LabelSelector
+	68,  // 29: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	69,  // 30: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.service:type_name -> istio_operator.v2.api.v1alpha1.Service
+	9,   // 31: istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration.templates:type_name -> istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates
+	10,  // 32: istio_operator.v2.api.v1alpha1.SidecarInjectionTemplates.customTemplates:type_name -> istio_operator.v2.api.v1alpha1.CustomSidecarInjectionTemplates
+	61,  // 33: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	53,  // 34: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.gateway:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration
+	50,  // 35: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.istiod:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod
+	51,  // 36: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.webhook:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook
+	52,  // 37: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.clusterServices:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices
+	54,  // 38: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.additionalGateways:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration
+	70,  // 39: istio_operator.v2.api.v1alpha1.NetworkConfiguration.gatewayPort:type_name -> google.protobuf.UInt32Value
+	70,  // 40: istio_operator.v2.api.v1alpha1.NetworkConfiguration.weight:type_name -> google.protobuf.UInt32Value
+	13,  // 41: istio_operator.v2.api.v1alpha1.NetworkConfiguration.localityFailover:type_name -> istio_operator.v2.api.v1alpha1.LocalityFailoverConfiguration
+	61,  // 42: istio_operator.v2.api.v1alpha1.LocalityFailoverConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 43: istio_operator.v2.api.v1alpha1.DefaultSidecarConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	15,  // 44: istio_operator.v2.api.v1alpha1.DefaultSidecarConfiguration.learningMode:type_name -> istio_operator.v2.api.v1alpha1.SidecarLearningModeConfiguration
+	61,  // 45: istio_operator.v2.api.v1alpha1.SidecarLearningModeConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	71,  // 46: istio_operator.v2.api.v1alpha1.SidecarLearningModeConfiguration.lookback:type_name -> google.protobuf.Duration
+	61,  // 47: istio_operator.v2.api.v1alpha1.EgressConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	72,  // 48: istio_operator.v2.api.v1alpha1.EgressConfiguration.gateway:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
+	17,  // 49: istio_operator.v2.api.v1alpha1.EgressConfiguration.hosts:type_name -> istio_operator.v2.api.v1alpha1.EgressHost
+	71,  // 50: istio_operator.v2.api.v1alpha1.MTLSConfiguration.lookback:type_name -> google.protobuf.Duration
+	20,  // 51: istio_operator.v2.api.v1alpha1.MeshGatewayAttachmentPolicy.allowedGateways:type_name -> istio_operator.v2.api.v1alpha1.MeshGatewaySelector
+	55,  // 52: istio_operator.v2.api.v1alpha1.MeshGatewaySelector.namespaceLabels:type_name -> istio_operator.v2.api.v1alpha1.MeshGatewaySelector.NamespaceLabelsEntry
+	56,  // 53: istio_operator.v2.api.v1alpha1.MeshGatewaySelector.gatewayLabels:type_name -> istio_operator.v2.api.v1alpha1.MeshGatewaySelector.GatewayLabelsEntry
+	61,  // 54: istio_operator.v2.api.v1alpha1.AddonComponent.enabled:type_name -> google.protobuf.BoolValue
+	22,  // 55: istio_operator.v2.api.v1alpha1.AddonComponent.chart:type_name -> istio_operator.v2.api.v1alpha1.AddonChartSource
+	23,  // 56: istio_operator.v2.api.v1alpha1.AddonChartSource.configMap:type_name -> istio_operator.v2.api.v1alpha1.AddonChartConfigMapSource
+	61,  // 57: istio_operator.v2.api.v1alpha1.ProxyConfiguration.privileged:type_name -> google.protobuf.BoolValue
+	61,  // 58: istio_operator.v2.api.v1alpha1.ProxyConfiguration.enableCoreDump:type_name -> google.protobuf.BoolValue
+	1,   // 59: istio_operator.v2.api.v1alpha1.ProxyConfiguration.logLevel:type_name -> istio_operator.v2.api.v1alpha1.ProxyLogLevel
+	61,  // 60: istio_operator.v2.api.v1alpha1.ProxyConfiguration.holdApplicationUntilProxyStarts:type_name -> google.protobuf.BoolValue
+	73,  // 61: istio_operator.v2.api.v1alpha1.ProxyConfiguration.lifecycle:type_name -> k8s.io.api.core.v1.Lifecycle
+	74,  // 62: istio_operator.v2.api.v1alpha1.ProxyConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
+	74,  // 63: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration.resources:type_name -> istio_operator.v2.api.v1alpha1.ResourceRequirements
+	28,  // 64: istio_operator.v2.api.v1alpha1.ProxyInitConfiguration.cni:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration
+	61,  // 65: istio_operator.v2.api.v1alpha1.CNIConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 66: istio_operator.v2.api.v1alpha1.CNIConfiguration.chained:type_name -> google.protobuf.BoolValue
+	57,  // 67: istio_operator.v2.api.v1alpha1.CNIConfiguration.repair:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration
+	58,  // 68: istio_operator.v2.api.v1alpha1.CNIConfiguration.taint:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration
+	59,  // 69: istio_operator.v2.api.v1alpha1.CNIConfiguration.resourceQuotas:type_name -> istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas
+	68,  // 70: istio_operator.v2.api.v1alpha1.CNIConfiguration.daemonset:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	68,  // 71: istio_operator.v2.api.v1alpha1.IstiodConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	61,  // 72: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableAnalysis:type_name -> google.protobuf.BoolValue
+	61,  // 73: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableStatus:type_name -> google.protobuf.BoolValue
+	30,  // 74: istio_operator.v2.api.v1alpha1.IstiodConfiguration.externalIstiod:type_name -> istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration
+	75,  // 75: istio_operator.v2.api.v1alpha1.IstiodConfiguration.traceSampling:type_name -> google.protobuf.FloatValue
+	61,  // 76: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableProtocolSniffingOutbound:type_name -> google.protobuf.BoolValue
+	61,  // 77: istio_operator.v2.api.v1alpha1.IstiodConfiguration.enableProtocolSniffingInbound:type_name -> google.protobuf.BoolValue
+	2,   // 78: istio_operator.v2.api.v1alpha1.IstiodConfiguration.certProvider:type_name -> istio_operator.v2.api.v1alpha1.PilotCertProviderType
+	31,  // 79: istio_operator.v2.api.v1alpha1.IstiodConfiguration.spiffe:type_name -> istio_operator.v2.api.v1alpha1.SPIFFEConfiguration
+	61,  // 80: istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	32,  // 81: istio_operator.v2.api.v1alpha1.SPIFFEConfiguration.operatorEndpoints:type_name -> istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration
+	61,  // 82: istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 83: istio_operator.v2.api.v1alpha1.TelemetryV2Configuration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 84: istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 85: istio_operator.v2.api.v1alpha1.PDBConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	4,   // 86: istio_operator.v2.api.v1alpha1.AdoptionConfiguration.mode:type_name -> istio_operator.v2.api.v1alpha1.AdoptionConfiguration.Mode
+	61,  // 87: istio_operator.v2.api.v1alpha1.HealthGatesConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	71,  // 88: istio_operator.v2.api.v1alpha1.HealthGatesConfiguration.timeout:type_name -> google.protobuf.Duration
+	76,  // 89: istio_operator.v2.api.v1alpha1.HealthGatesConfiguration.historyLimit:type_name -> google.protobuf.Int32Value
+	61,  // 90: istio_operator.v2.api.v1alpha1.HealthGatesConfiguration.autoRevert:type_name -> google.protobuf.BoolValue
+	5,   // 91: istio_operator.v2.api.v1alpha1.HealthGatesStatus.state:type_name -> istio_operator.v2.api.v1alpha1.HealthGatesStatus.State
+	40,  // 92: istio_operator.v2.api.v1alpha1.HealthGatesStatus.gates:type_name -> istio_operator.v2.api.v1alpha1.HealthGate
+	61,  // 93: istio_operator.v2.api.v1alpha1.InjectionSelfTestConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	71,  // 94: istio_operator.v2.api.v1alpha1.InjectionSelfTestConfiguration.interval:type_name -> google.protobuf.Duration
+	6,   // 95: istio_operator.v2.api.v1alpha1.AdoptedResource.state:type_name -> istio_operator.v2.api.v1alpha1.AdoptedResource.State
+	77,  // 96: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.status:type_name -> istio_operator.v2.api.v1alpha1.ConfigState
+	62,  // 97: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.meshConfig:type_name -> istio.mesh.v1alpha1.MeshConfig
+	49,  // 98: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.checksums:type_name -> istio_operator.v2.api.v1alpha1.StatusChecksums
+	48,  // 99: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.mtlsNamespaces:type_name -> istio_operator.v2.api.v1alpha1.MTLSNamespaceStatus
+	47,  // 100: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.meshExpansionGateways:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionGatewayStatus
+	60,  // 101: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.componentValuesHashes:type_name -> istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.ComponentValuesHashesEntry
+	78,  // 102: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.drifts:type_name -> istio_operator.v2.api.v1alpha1.ResourceDrift
+	7,   // 103: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.resolvedSpec:type_name -> istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec
+	45,  // 104: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.adoptedResources:type_name -> istio_operator.v2.api.v1alpha1.AdoptedResource
+	39,  // 105: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.healthGates:type_name -> istio_operator.v2.api.v1alpha1.HealthGatesStatus
+	44,  // 106: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.conditions:type_name -> istio_operator.v2.api.v1alpha1.Condition
+	42,  // 107: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.injectionSelfTests:type_name -> istio_operator.v2.api.v1alpha1.InjectionSelfTestResult
+	43,  // 108: istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus.invalidInjectionTemplates:type_name -> istio_operator.v2.api.v1alpha1.InjectionTemplateError
+	77,  // 109: istio_operator.v2.api.v1alpha1.MeshExpansionGatewayStatus.status:type_name -> istio_operator.v2.api.v1alpha1.ConfigState
+	61,  // 110: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod.expose:type_name -> google.protobuf.BoolValue
+	61,  // 111: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook.expose:type_name -> google.protobuf.BoolValue
+	61,  // 112: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices.expose:type_name -> google.protobuf.BoolValue
+	79,  // 113: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	68,  // 114: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	80,  // 115: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.service:type_name -> istio_operator.v2.api.v1alpha1.UnprotectedService
+	61,  // 116: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.runAsRoot:type_name -> google.protobuf.BoolValue
+	63,  // 117: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	79,  // 118: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.metadata:type_name -> istio_operator.v2.api.v1alpha1.K8sObjectMeta
+	68,  // 119: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	80,  // 120: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.service:type_name -> istio_operator.v2.api.v1alpha1.UnprotectedService
+	61,  // 121: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.runAsRoot:type_name -> google.protobuf.BoolValue
+	50,  // 122: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.istiod:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod
+	51,  // 123: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.webhook:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook
+	52,  // 124: istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.AdditionalIstioMeshGatewayConfiguration.clusterServices:type_name -> istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices
+	61,  // 125: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	61,  // 126: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.labelPods:type_name -> google.protobuf.BoolValue
+	61,  // 127: istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration.deletePods:type_name -> google.protobuf.BoolValue
+	61,  // 128: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration.enabled:type_name -> google.protobuf.BoolValue
+	81,  // 129: istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration.container:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesContainerConfiguration
+	61,  // 130: istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas.enabled:type_name -> google.protobuf.BoolValue
+	131, // [131:131] is the sub-list for method output_type
+	131, // [131:131] is the sub-list for method input_type
+	131, // [131:131] is the sub-list for extension type_name
+	131, // [131:131] is the sub-list for extension extendee
+	0,   // [0:131] is the sub-list for field type_name
 }
 
 func init() { file_api_v1alpha1_istiocontrolplane_proto_init() }
@@ -3120,8 +5956,56 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidecarInjectorConfiguration); i {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidecarInjectorConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidecarInjectionTemplates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomSidecarInjectionTemplates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExpansionConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3132,8 +6016,8 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidecarInjectionTemplates); i {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalityFailoverConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3144,8 +6028,8 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomSidecarInjectionTemplates); i {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultSidecarConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3156,8 +6040,8 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshExpansionConfiguration); i {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidecarLearningModeConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3168,7 +6052,103 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressHost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MTLSConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayAttachmentPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewaySelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddonComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddonChartSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddonChartConfigMapSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoggingConfiguration); i {
 			case 0:
 				return &v.state
@@ -3180,7 +6160,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SDSConfiguration); i {
 			case 0:
 				return &v.state
@@ -3192,7 +6172,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyConfiguration); i {
 			case 0:
 				return &v.state
@@ -3204,7 +6184,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyInitConfiguration); i {
 			case 0:
 				return &v.state
@@ -3216,7 +6196,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CNIConfiguration); i {
 			case 0:
 				return &v.state
@@ -3228,7 +6208,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IstiodConfiguration); i {
 			case 0:
 				return &v.state
@@ -3240,7 +6220,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExternalIstiodConfiguration); i {
 			case 0:
 				return &v.state
@@ -3252,7 +6232,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SPIFFEConfiguration); i {
 			case 0:
 				return &v.state
@@ -3264,7 +6244,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OperatorEndpointsConfiguration); i {
 			case 0:
 				return &v.state
@@ -3276,7 +6256,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TelemetryV2Configuration); i {
 			case 0:
 				return &v.state
@@ -3288,7 +6268,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyWasmConfiguration); i {
 			case 0:
 				return &v.state
@@ -3300,7 +6280,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PDBConfiguration); i {
 			case 0:
 				return &v.state
@@ -3312,7 +6292,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HTTPProxyEnvsConfiguration); i {
 			case 0:
 				return &v.state
@@ -3324,7 +6304,115 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptionConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthGatesConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthGatesStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InjectionSelfTestConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InjectionSelfTestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InjectionTemplateError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptedResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IstioControlPlaneStatus); i {
 			case 0:
 				return &v.state
@@ -3336,7 +6424,31 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExpansionGatewayStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MTLSNamespaceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusChecksums); i {
 			case 0:
 				return &v.state
@@ -3348,7 +6460,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshExpansionConfiguration_Istiod); i {
 			case 0:
 				return &v.state
@@ -3360,7 +6472,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshExpansionConfiguration_Webhook); i {
 			case 0:
 				return &v.state
@@ -3372,7 +6484,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshExpansionConfiguration_ClusterServices); i {
 			case 0:
 				return &v.state
@@ -3384,7 +6496,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshExpansionConfiguration_IstioMeshGatewayConfiguration); i {
 			case 0:
 				return &v.state
@@ -3396,7 +6508,19 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CNIConfiguration_RepairConfiguration); i {
 			case 0:
 				return &v.state
@@ -3408,7 +6532,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CNIConfiguration_TaintConfiguration); i {
 			case 0:
 				return &v.state
@@ -3420,7 +6544,7 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1alpha1_istiocontrolplane_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CNIConfiguration_ResourceQuotas); i {
 			case 0:
 				return &v.state
@@ -3433,14 +6557,14 @@ func file_api_v1alpha1_istiocontrolplane_proto_init() {
 			}
 		}
 	}
-	file_api_v1alpha1_istiocontrolplane_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_api_v1alpha1_istiocontrolplane_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1alpha1_istiocontrolplane_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   27,
+			NumEnums:      7,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -194,6 +194,279 @@ func (in *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) DeepCopyInte
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) DeepCopyInto(out *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) {
+	p := proto.Clone(in).(*MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration. Required by controller-gen.
+func (in *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) DeepCopy() *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration. Required by controller-gen.
+func (in *MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NetworkConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
+	p := proto.Clone(in).(*NetworkConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration. Required by controller-gen.
+func (in *NetworkConfiguration) DeepCopy() *NetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration. Required by controller-gen.
+func (in *NetworkConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using LocalityFailoverConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *LocalityFailoverConfiguration) DeepCopyInto(out *LocalityFailoverConfiguration) {
+	p := proto.Clone(in).(*LocalityFailoverConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityFailoverConfiguration. Required by controller-gen.
+func (in *LocalityFailoverConfiguration) DeepCopy() *LocalityFailoverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityFailoverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LocalityFailoverConfiguration. Required by controller-gen.
+func (in *LocalityFailoverConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DefaultSidecarConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *DefaultSidecarConfiguration) DeepCopyInto(out *DefaultSidecarConfiguration) {
+	p := proto.Clone(in).(*DefaultSidecarConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSidecarConfiguration. Required by controller-gen.
+func (in *DefaultSidecarConfiguration) DeepCopy() *DefaultSidecarConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSidecarConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSidecarConfiguration. Required by controller-gen.
+func (in *DefaultSidecarConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using SidecarLearningModeConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *SidecarLearningModeConfiguration) DeepCopyInto(out *SidecarLearningModeConfiguration) {
+	p := proto.Clone(in).(*SidecarLearningModeConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarLearningModeConfiguration. Required by controller-gen.
+func (in *SidecarLearningModeConfiguration) DeepCopy() *SidecarLearningModeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarLearningModeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new SidecarLearningModeConfiguration. Required by controller-gen.
+func (in *SidecarLearningModeConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EgressConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *EgressConfiguration) DeepCopyInto(out *EgressConfiguration) {
+	p := proto.Clone(in).(*EgressConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfiguration. Required by controller-gen.
+func (in *EgressConfiguration) DeepCopy() *EgressConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfiguration. Required by controller-gen.
+func (in *EgressConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EgressHost within kubernetes types, where deepcopy-gen is used.
+func (in *EgressHost) DeepCopyInto(out *EgressHost) {
+	p := proto.Clone(in).(*EgressHost)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressHost. Required by controller-gen.
+func (in *EgressHost) DeepCopy() *EgressHost {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EgressHost. Required by controller-gen.
+func (in *EgressHost) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using MTLSConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *MTLSConfiguration) DeepCopyInto(out *MTLSConfiguration) {
+	p := proto.Clone(in).(*MTLSConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSConfiguration. Required by controller-gen.
+func (in *MTLSConfiguration) DeepCopy() *MTLSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MTLSConfiguration. Required by controller-gen.
+func (in *MTLSConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using MeshGatewayAttachmentPolicy within kubernetes types, where deepcopy-gen is used.
+func (in *MeshGatewayAttachmentPolicy) DeepCopyInto(out *MeshGatewayAttachmentPolicy) {
+	p := proto.Clone(in).(*MeshGatewayAttachmentPolicy)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayAttachmentPolicy. Required by controller-gen.
+func (in *MeshGatewayAttachmentPolicy) DeepCopy() *MeshGatewayAttachmentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayAttachmentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayAttachmentPolicy. Required by controller-gen.
+func (in *MeshGatewayAttachmentPolicy) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using MeshGatewaySelector within kubernetes types, where deepcopy-gen is used.
+func (in *MeshGatewaySelector) DeepCopyInto(out *MeshGatewaySelector) {
+	p := proto.Clone(in).(*MeshGatewaySelector)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewaySelector. Required by controller-gen.
+func (in *MeshGatewaySelector) DeepCopy() *MeshGatewaySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewaySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewaySelector. Required by controller-gen.
+func (in *MeshGatewaySelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AddonComponent within kubernetes types, where deepcopy-gen is used.
+func (in *AddonComponent) DeepCopyInto(out *AddonComponent) {
+	p := proto.Clone(in).(*AddonComponent)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonComponent. Required by controller-gen.
+func (in *AddonComponent) DeepCopy() *AddonComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AddonComponent. Required by controller-gen.
+func (in *AddonComponent) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AddonChartSource within kubernetes types, where deepcopy-gen is used.
+func (in *AddonChartSource) DeepCopyInto(out *AddonChartSource) {
+	p := proto.Clone(in).(*AddonChartSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartSource. Required by controller-gen.
+func (in *AddonChartSource) DeepCopy() *AddonChartSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonChartSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartSource. Required by controller-gen.
+func (in *AddonChartSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AddonChartConfigMapSource within kubernetes types, where deepcopy-gen is used.
+func (in *AddonChartConfigMapSource) DeepCopyInto(out *AddonChartConfigMapSource) {
+	p := proto.Clone(in).(*AddonChartConfigMapSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartConfigMapSource. Required by controller-gen.
+func (in *AddonChartConfigMapSource) DeepCopy() *AddonChartConfigMapSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonChartConfigMapSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartConfigMapSource. Required by controller-gen.
+func (in *AddonChartConfigMapSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using LoggingConfiguration within kubernetes types, where deepcopy-gen is used.
 func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
 	p := proto.Clone(in).(*LoggingConfiguration)
@@ -530,6 +803,195 @@ func (in *HTTPProxyEnvsConfiguration) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using AdoptionConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *AdoptionConfiguration) DeepCopyInto(out *AdoptionConfiguration) {
+	p := proto.Clone(in).(*AdoptionConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptionConfiguration. Required by controller-gen.
+func (in *AdoptionConfiguration) DeepCopy() *AdoptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AdoptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AdoptionConfiguration. Required by controller-gen.
+func (in *AdoptionConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using HealthGatesConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *HealthGatesConfiguration) DeepCopyInto(out *HealthGatesConfiguration) {
+	p := proto.Clone(in).(*HealthGatesConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthGatesConfiguration. Required by controller-gen.
+func (in *HealthGatesConfiguration) DeepCopy() *HealthGatesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthGatesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HealthGatesConfiguration. Required by controller-gen.
+func (in *HealthGatesConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using HealthGatesStatus within kubernetes types, where deepcopy-gen is used.
+func (in *HealthGatesStatus) DeepCopyInto(out *HealthGatesStatus) {
+	p := proto.Clone(in).(*HealthGatesStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthGatesStatus. Required by controller-gen.
+func (in *HealthGatesStatus) DeepCopy() *HealthGatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthGatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HealthGatesStatus. Required by controller-gen.
+func (in *HealthGatesStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using HealthGate within kubernetes types, where deepcopy-gen is used.
+func (in *HealthGate) DeepCopyInto(out *HealthGate) {
+	p := proto.Clone(in).(*HealthGate)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthGate. Required by controller-gen.
+func (in *HealthGate) DeepCopy() *HealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HealthGate. Required by controller-gen.
+func (in *HealthGate) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using InjectionSelfTestConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *InjectionSelfTestConfiguration) DeepCopyInto(out *InjectionSelfTestConfiguration) {
+	p := proto.Clone(in).(*InjectionSelfTestConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectionSelfTestConfiguration. Required by controller-gen.
+func (in *InjectionSelfTestConfiguration) DeepCopy() *InjectionSelfTestConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectionSelfTestConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new InjectionSelfTestConfiguration. Required by controller-gen.
+func (in *InjectionSelfTestConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using InjectionSelfTestResult within kubernetes types, where deepcopy-gen is used.
+func (in *InjectionSelfTestResult) DeepCopyInto(out *InjectionSelfTestResult) {
+	p := proto.Clone(in).(*InjectionSelfTestResult)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectionSelfTestResult. Required by controller-gen.
+func (in *InjectionSelfTestResult) DeepCopy() *InjectionSelfTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectionSelfTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new InjectionSelfTestResult. Required by controller-gen.
+func (in *InjectionSelfTestResult) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using InjectionTemplateError within kubernetes types, where deepcopy-gen is used.
+func (in *InjectionTemplateError) DeepCopyInto(out *InjectionTemplateError) {
+	p := proto.Clone(in).(*InjectionTemplateError)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectionTemplateError. Required by controller-gen.
+func (in *InjectionTemplateError) DeepCopy() *InjectionTemplateError {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectionTemplateError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new InjectionTemplateError. Required by controller-gen.
+func (in *InjectionTemplateError) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Condition within kubernetes types, where deepcopy-gen is used.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	p := proto.Clone(in).(*Condition)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition. Required by controller-gen.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Condition. Required by controller-gen.
+func (in *Condition) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AdoptedResource within kubernetes types, where deepcopy-gen is used.
+func (in *AdoptedResource) DeepCopyInto(out *AdoptedResource) {
+	p := proto.Clone(in).(*AdoptedResource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptedResource. Required by controller-gen.
+func (in *AdoptedResource) DeepCopy() *AdoptedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AdoptedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AdoptedResource. Required by controller-gen.
+func (in *AdoptedResource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using IstioControlPlaneStatus within kubernetes types, where deepcopy-gen is used.
 func (in *IstioControlPlaneStatus) DeepCopyInto(out *IstioControlPlaneStatus) {
 	p := proto.Clone(in).(*IstioControlPlaneStatus)
@@ -551,6 +1013,48 @@ func (in *IstioControlPlaneStatus) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using MeshExpansionGatewayStatus within kubernetes types, where deepcopy-gen is used.
+func (in *MeshExpansionGatewayStatus) DeepCopyInto(out *MeshExpansionGatewayStatus) {
+	p := proto.Clone(in).(*MeshExpansionGatewayStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshExpansionGatewayStatus. Required by controller-gen.
+func (in *MeshExpansionGatewayStatus) DeepCopy() *MeshExpansionGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshExpansionGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshExpansionGatewayStatus. Required by controller-gen.
+func (in *MeshExpansionGatewayStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using MTLSNamespaceStatus within kubernetes types, where deepcopy-gen is used.
+func (in *MTLSNamespaceStatus) DeepCopyInto(out *MTLSNamespaceStatus) {
+	p := proto.Clone(in).(*MTLSNamespaceStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSNamespaceStatus. Required by controller-gen.
+func (in *MTLSNamespaceStatus) DeepCopy() *MTLSNamespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSNamespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MTLSNamespaceStatus. Required by controller-gen.
+func (in *MTLSNamespaceStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using StatusChecksums within kubernetes types, where deepcopy-gen is used.
 func (in *StatusChecksums) DeepCopyInto(out *StatusChecksums) {
 	p := proto.Clone(in).(*StatusChecksums)
@@ -30,6 +30,18 @@ const (
 	RevisionedAutoInjectionLabel       = "istio.io/rev"
 	DeprecatedAutoInjectionLabel       = "istio-injection"
 	NamespaceInjectionSourceAnnotation = "controlplane.istio.servicemesh.cisco.com/namespace-injection-source"
+	AdoptedFromRevisionAnnotation      = "controlplane.istio.servicemesh.cisco.com/adopted-from-revision"
+	RevertSpecAnnotation               = "controlplane.istio.servicemesh.cisco.com/revert-spec"
+
+	SidecarEgressNamespacesAnnotation        = "sidecar.istio.servicemesh.cisco.com/egress-namespaces"
+	SidecarLearnedEgressNamespacesAnnotation = "sidecar.istio.servicemesh.cisco.com/learned-egress-namespaces"
+	DefaultSidecarLabel                      = "sidecar.istio.servicemesh.cisco.com/default"
+
+	EgressLabel = "egress.istio.servicemesh.cisco.com/generated"
+
+	StrictMTLSLabel = "mtls.istio.servicemesh.cisco.com/strict"
+
+	DefaultNetworkName = "network1"
 )
 
 type SortableIstioControlPlaneItems []IstioControlPlane
@@ -79,6 +91,61 @@ func (icp *IstioControlPlane) GetSpec() *IstioControlPlaneSpec {
 	return nil
 }
 
+// GetResolvedSpec returns the spec resolved from the referenced profile if there is one, the spec itself otherwise
+func (icp *IstioControlPlane) GetResolvedSpec() *IstioControlPlaneSpec {
+	return resolveSpec(icp.GetSpec(), icp.Status)
+}
+
+// GetNetworkName returns the network of the control plane resolved from the referenced profile,
+// or the default network if it is not set
+func (icp *IstioControlPlane) GetNetworkName() string {
+	return networkName(icp.GetResolvedSpec())
+}
+
+// IstiodGateways returns the available mesh expansion gateways which expose istiod
+func (s *IstioControlPlaneStatus) IstiodGateways() []*MeshExpansionGatewayStatus {
+	return s.availableMeshExpansionGateways(func(gw *MeshExpansionGatewayStatus) bool {
+		return gw.GetExposeIstiod()
+	})
+}
+
+// ClusterServicesGateways returns the available mesh expansion gateways which expose the cluster services
+func (s *IstioControlPlaneStatus) ClusterServicesGateways() []*MeshExpansionGatewayStatus {
+	return s.availableMeshExpansionGateways(func(gw *MeshExpansionGatewayStatus) bool {
+		return gw.GetExposeClusterServices()
+	})
+}
+
+// availableMeshExpansionGateways returns the available mesh expansion gateways matching the filter.
+// Statuses without per gateway details are handled as a single gateway exposing everything on the aggregated addresses.
+func (s *IstioControlPlaneStatus) availableMeshExpansionGateways(filter func(gw *MeshExpansionGatewayStatus) bool) []*MeshExpansionGatewayStatus {
+	if len(s.GetMeshExpansionGateways()) == 0 {
+		if len(s.GetGatewayAddress()) == 0 && len(s.GetGatewayHostnames()) == 0 {
+			return nil
+		}
+
+		return []*MeshExpansionGatewayStatus{
+			{
+				Status:                ConfigState_Available,
+				GatewayAddress:        s.GetGatewayAddress(),
+				GatewayHostnames:      s.GetGatewayHostnames(),
+				ExposeIstiod:          true,
+				ExposeWebhook:         true,
+				ExposeClusterServices: true,
+			},
+		}
+	}
+
+	gateways := make([]*MeshExpansionGatewayStatus, 0, len(s.GetMeshExpansionGateways()))
+	for _, gw := range s.GetMeshExpansionGateways() {
+		if gw.GetStatus() == ConfigState_Available && filter(gw) {
+			gateways = append(gateways, gw)
+		}
+	}
+
+	return gateways
+}
+
 func (r *ResourceRequirements) ConvertToK8sRR() *corev1.ResourceRequirements {
 	rr := &corev1.ResourceRequirements{
 		Limits:   make(corev1.ResourceList),
@@ -230,6 +297,34 @@ func (icp *PeerIstioControlPlane) GetSpec() *IstioControlPlaneSpec {
 	return nil
 }
 
+// GetResolvedSpec returns the spec of the peer control plane resolved from the referenced profile if there is one,
+// the spec itself otherwise
+func (icp *PeerIstioControlPlane) GetResolvedSpec() *IstioControlPlaneSpec {
+	return resolveSpec(icp.GetSpec(), icp.Status)
+}
+
+// GetNetworkName returns the network of the peer control plane resolved from the referenced profile,
+// or the default network if it is not set
+func (icp *PeerIstioControlPlane) GetNetworkName() string {
+	return networkName(icp.GetResolvedSpec())
+}
+
+func resolveSpec(spec *IstioControlPlaneSpec, status *IstioControlPlaneStatus) *IstioControlPlaneSpec {
+	if spec.GetProfile() != "" && status.GetResolvedSpec() != nil {
+		return status.GetResolvedSpec()
+	}
+
+	return spec
+}
+
+func networkName(spec *IstioControlPlaneSpec) string {
+	if spec.GetNetworkName() != "" {
+		return spec.GetNetworkName()
+	}
+
+	return DefaultNetworkName
+}
+
 // PeerIstioControlPlaneList contains a list of PeerIstioControlPlane
 // +kubebuilder:object:root=true
 type PeerIstioControlPlaneList struct {
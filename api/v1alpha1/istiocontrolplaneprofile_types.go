@@ -0,0 +1,53 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// IstioControlPlaneProfile is the Schema for the istiocontrolplaneprofiles API
+// +kubebuilder:resource:path=istiocontrolplaneprofiles,scope=Cluster,shortName=icpp;istiocpprofile
+type IstioControlPlaneProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec *IstioControlPlaneProfileSpec `json:"spec,omitempty"`
+}
+
+func (p *IstioControlPlaneProfile) GetSpec() *IstioControlPlaneProfileSpec {
+	if p.Spec != nil {
+		return p.Spec
+	}
+
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// IstioControlPlaneProfileList contains a list of IstioControlPlaneProfile
+type IstioControlPlaneProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IstioControlPlaneProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IstioControlPlaneProfile{}, &IstioControlPlaneProfileList{})
+}
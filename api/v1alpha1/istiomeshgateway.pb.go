@@ -25,6 +25,7 @@
 package v1alpha1
 
 import (
+	duration "github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -103,6 +104,7 @@ func (GatewayType) EnumDescriptor() ([]byte, []int) {
 // +cue-gen:IstioMeshGateway:printerColumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the gateway"
 // +cue-gen:IstioMeshGateway:printerColumn:name="Service Type",type="string",JSONPath=".spec.service.type",description="Type of the service"
 // +cue-gen:IstioMeshGateway:printerColumn:name="Status",type="string",JSONPath=".status.Status",description="Status of the resource"
+// +cue-gen:IstioMeshGateway:printerColumn:name="Ready",type="integer",JSONPath=".status.ReadyReplicas",description="Number of ready replicas of the gateway"
 // +cue-gen:IstioMeshGateway:printerColumn:name="Ingress IPs",type="string",JSONPath=".status.GatewayAddress",description="Ingress gateway addresses of the resource"
 // +cue-gen:IstioMeshGateway:printerColumn:name="Error",type="string",JSONPath=".status.ErrorMessage",description="Error message"
 // +cue-gen:IstioMeshGateway:printerColumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -133,6 +135,14 @@ type IstioMeshGatewaySpec struct {
 	IstioControlPlane *NamespacedName `protobuf:"bytes,5,opt,name=istioControlPlane,proto3" json:"istioControlPlane,omitempty"`
 	// K8s resource overlay patches
 	K8SResourceOverlays []*K8SResourceOverlayPatch `protobuf:"bytes,6,rep,name=k8sResourceOverlays,proto3" json:"k8sResourceOverlays,omitempty"`
+	// Rollout configuration of the gateway deployment
+	Rollout *RolloutConfiguration `protobuf:"bytes,7,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// Servers of the Gateway (networking.istio.io) resource generated for the gateway.
+	// The generated Gateway selects the pods of the gateway and a VirtualService is generated
+	// for every server which has a destination set. The resources are only generated if the control plane is in ACTIVE mode.
+	Servers []*GatewayServer `protobuf:"bytes,8,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Policies of how the changes made by others to the resources of the gateway are handled.
+	DriftPolicies []*DriftPolicy `protobuf:"bytes,9,rep,name=driftPolicies,proto3" json:"driftPolicies,omitempty"`
 }
 
 func (x *IstioMeshGatewaySpec) Reset() {
@@ -209,31 +219,809 @@ func (x *IstioMeshGatewaySpec) GetK8SResourceOverlays() []*K8SResourceOverlayPat
 	return nil
 }
 
+func (x *IstioMeshGatewaySpec) GetRollout() *RolloutConfiguration {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewaySpec) GetServers() []*GatewayServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewaySpec) GetDriftPolicies() []*DriftPolicy {
+	if x != nil {
+		return x.DriftPolicies
+	}
+	return nil
+}
+
+// GatewayServer describes a server of the Gateway generated for the gateway
+type GatewayServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hosts exposed by the server
+	Hosts []string `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// Port on which the gateway listens for the hosts
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Protocol exposed on the port
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;GRPC;HTTP2;MONGO;TCP;TLS
+	// +kubebuilder:default=HTTP
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// TLS settings of the server
+	Tls *GatewayServerTLS `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Destination to route the traffic of the server to
+	Destination *GatewayServerDestination `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *GatewayServer) Reset() {
+	*x = GatewayServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayServer) ProtoMessage() {}
+
+func (x *GatewayServer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayServer.ProtoReflect.Descriptor instead.
+func (*GatewayServer) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GatewayServer) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *GatewayServer) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GatewayServer) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *GatewayServer) GetTls() *GatewayServerTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *GatewayServer) GetDestination() *GatewayServerDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+// GatewayServerTLS describes the TLS settings of a server of the generated Gateway
+type GatewayServerTLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TLS mode of the server
+	// +kubebuilder:validation:Enum=PASSTHROUGH;SIMPLE;MUTUAL;AUTO_PASSTHROUGH;ISTIO_MUTUAL
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Name of the Secret holding the TLS certificate, the key and optionally the CA certificate,
+	// in the namespace of the gateway
+	CredentialName string `protobuf:"bytes,2,opt,name=credentialName,proto3" json:"credentialName,omitempty"`
+	// Whether to send a redirect for every plain HTTP request, asking the clients to use HTTPS
+	HttpsRedirect bool `protobuf:"varint,3,opt,name=httpsRedirect,proto3" json:"httpsRedirect,omitempty"`
+	// Certificate to be issued by the operator into the credential Secret for the hosts of the server
+	Certificate *GatewayCertificate `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *GatewayServerTLS) Reset() {
+	*x = GatewayServerTLS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayServerTLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayServerTLS) ProtoMessage() {}
+
+func (x *GatewayServerTLS) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayServerTLS.ProtoReflect.Descriptor instead.
+func (*GatewayServerTLS) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatewayServerTLS) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GatewayServerTLS) GetCredentialName() string {
+	if x != nil {
+		return x.CredentialName
+	}
+	return ""
+}
+
+func (x *GatewayServerTLS) GetHttpsRedirect() bool {
+	if x != nil {
+		return x.HttpsRedirect
+	}
+	return false
+}
+
+func (x *GatewayServerTLS) GetCertificate() *GatewayCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+// GatewayCertificate describes how the certificate of a server of the generated Gateway is issued.
+// Exactly one of the issuers must be set.
+type GatewayCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cert-manager issuer to request the certificate from. A cert-manager Certificate is created for the
+	// credential Secret and the certificate is renewed by cert-manager.
+	IssuerRef *CertManagerIssuerReference `protobuf:"bytes,1,opt,name=issuerRef,proto3" json:"issuerRef,omitempty"`
+	// ACME server to request the certificate from. The HTTP-01 challenges are answered by the gateway itself,
+	// so the generated Gateway must have a plain HTTP server on port 80 without httpsRedirect for the hosts.
+	Acme *ACMEIssuer `protobuf:"bytes,2,opt,name=acme,proto3" json:"acme,omitempty"`
+	// How long before the expiry the certificate is renewed. Defaults to 720h.
+	RenewBefore *duration.Duration `protobuf:"bytes,3,opt,name=renewBefore,proto3" json:"renewBefore,omitempty"`
+}
+
+func (x *GatewayCertificate) Reset() {
+	*x = GatewayCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCertificate) ProtoMessage() {}
+
+func (x *GatewayCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCertificate.ProtoReflect.Descriptor instead.
+func (*GatewayCertificate) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GatewayCertificate) GetIssuerRef() *CertManagerIssuerReference {
+	if x != nil {
+		return x.IssuerRef
+	}
+	return nil
+}
+
+func (x *GatewayCertificate) GetAcme() *ACMEIssuer {
+	if x != nil {
+		return x.Acme
+	}
+	return nil
+}
+
+func (x *GatewayCertificate) GetRenewBefore() *duration.Duration {
+	if x != nil {
+		return x.RenewBefore
+	}
+	return nil
+}
+
+// CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer
+type CertManagerIssuerReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the issuer
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Kind of the issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=Issuer
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// API group of the issuer
+	// +kubebuilder:default=cert-manager.io
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CertManagerIssuerReference) Reset() {
+	*x = CertManagerIssuerReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertManagerIssuerReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertManagerIssuerReference) ProtoMessage() {}
+
+func (x *CertManagerIssuerReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertManagerIssuerReference.ProtoReflect.Descriptor instead.
+func (*CertManagerIssuerReference) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CertManagerIssuerReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CertManagerIssuerReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CertManagerIssuerReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// ACMEIssuer describes an ACME (RFC 8555) server to request certificates from
+type ACMEIssuer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Directory URL of the ACME server, e.g. https://acme-v02.api.letsencrypt.org/directory
+	DirectoryURL string `protobuf:"bytes,1,opt,name=directoryURL,proto3" json:"directoryURL,omitempty"`
+	// Email address to register the ACME account with
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Name of the Secret in the namespace of the gateway holding the private key of the ACME account.
+	// The Secret is created with a new key if it does not exist. Defaults to <gateway name>-acme-account.
+	AccountKeySecretName string `protobuf:"bytes,3,opt,name=accountKeySecretName,proto3" json:"accountKeySecretName,omitempty"`
+	// Whether to skip the verification of the TLS certificate of the ACME server, e.g. for local test servers
+	InsecureSkipTLSVerify bool `protobuf:"varint,4,opt,name=insecureSkipTLSVerify,proto3" json:"insecureSkipTLSVerify,omitempty"`
+}
+
+func (x *ACMEIssuer) Reset() {
+	*x = ACMEIssuer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACMEIssuer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACMEIssuer) ProtoMessage() {}
+
+func (x *ACMEIssuer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACMEIssuer.ProtoReflect.Descriptor instead.
+func (*ACMEIssuer) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ACMEIssuer) GetDirectoryURL() string {
+	if x != nil {
+		return x.DirectoryURL
+	}
+	return ""
+}
+
+func (x *ACMEIssuer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ACMEIssuer) GetAccountKeySecretName() string {
+	if x != nil {
+		return x.AccountKeySecretName
+	}
+	return ""
+}
+
+func (x *ACMEIssuer) GetInsecureSkipTLSVerify() bool {
+	if x != nil {
+		return x.InsecureSkipTLSVerify
+	}
+	return false
+}
+
+// GatewayServerDestination describes the service the traffic of a server is routed to
+type GatewayServerDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Host name of the service, e.g. reviews.default.svc.cluster.local
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Port of the service, can be omitted if the service exposes a single port
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *GatewayServerDestination) Reset() {
+	*x = GatewayServerDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayServerDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayServerDestination) ProtoMessage() {}
+
+func (x *GatewayServerDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayServerDestination.ProtoReflect.Descriptor instead.
+func (*GatewayServerDestination) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GatewayServerDestination) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GatewayServerDestination) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// RolloutConfiguration defines how the changes of the gateway deployment are rolled out
+type RolloutConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rollout strategy of the gateway deployment.
+	// With RollingUpdate strategy the gateway deployment is updated in place according to its deployment strategy.
+	// With BlueGreen strategy a parallel deployment is brought up with the changes, the service is switched over
+	// to it once all of its pods are ready, then the pods of the previous deployment are deleted after the drain duration.
+	// If the changes are reverted before the switch over, the parallel deployment is deleted instead.
+	// +kubebuilder:validation:Enum=RollingUpdate;BlueGreen
+	// +kubebuilder:default=RollingUpdate
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Time to wait after the service is switched over before deleting the pods of the previous deployment.
+	// Defaults to 30s.
+	DrainDuration *duration.Duration `protobuf:"bytes,2,opt,name=drainDuration,proto3" json:"drainDuration,omitempty"`
+}
+
+func (x *RolloutConfiguration) Reset() {
+	*x = RolloutConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutConfiguration) ProtoMessage() {}
+
+func (x *RolloutConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutConfiguration.ProtoReflect.Descriptor instead.
+func (*RolloutConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RolloutConfiguration) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *RolloutConfiguration) GetDrainDuration() *duration.Duration {
+	if x != nil {
+		return x.DrainDuration
+	}
+	return nil
+}
+
 type Properties struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Properties) Reset() {
+	*x = Properties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Properties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Properties) ProtoMessage() {}
+
+func (x *Properties) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Properties.ProtoReflect.Descriptor instead.
+func (*Properties) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Properties) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioMeshGatewayStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reconciliation status of the istio mesh gateway
+	Status ConfigState `protobuf:"varint,1,opt,name=Status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"Status,omitempty"`
+	// Current address for the gateway
+	GatewayAddress []string `protobuf:"bytes,2,rep,name=GatewayAddress,proto3" json:"GatewayAddress,omitempty"`
+	// Reconciliation error message if any
+	ErrorMessage string `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	// Current hostnames for the gateway
+	// The addresses in GatewayAddress are resolved from these hostnames periodically
+	GatewayHostnames []string `protobuf:"bytes,4,rep,name=GatewayHostnames,proto3" json:"GatewayHostnames,omitempty"`
+	// Number of desired replicas of the gateway deployment
+	DesiredReplicas int32 `protobuf:"varint,5,opt,name=DesiredReplicas,proto3" json:"DesiredReplicas,omitempty"`
+	// Number of ready replicas of the gateway deployment
+	ReadyReplicas int32 `protobuf:"varint,6,opt,name=ReadyReplicas,proto3" json:"ReadyReplicas,omitempty"`
+	// Number of replicas of the gateway deployment running the latest pod template
+	UpdatedReplicas int32 `protobuf:"varint,7,opt,name=UpdatedReplicas,proto3" json:"UpdatedReplicas,omitempty"`
+	// Type of the gateway service
+	ServiceType string `protobuf:"bytes,8,opt,name=ServiceType,proto3" json:"ServiceType,omitempty"`
+	// Ports of the gateway service
+	ServicePorts []*ServicePort `protobuf:"bytes,9,rep,name=ServicePorts,proto3" json:"ServicePorts,omitempty"`
+	// Gateway (networking.istio.io) resources bound to the gateway by selector, in namespace/name format
+	BoundGateways []string `protobuf:"bytes,10,rep,name=BoundGateways,proto3" json:"BoundGateways,omitempty"`
+	// Revisions of the control plane the running pods of the gateway are injected with, in comma separated format
+	Revision string `protobuf:"bytes,11,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	// State of the blue/green rollout of the gateway deployment
+	BlueGreenRollout *BlueGreenRolloutStatus `protobuf:"bytes,12,opt,name=BlueGreenRollout,proto3" json:"BlueGreenRollout,omitempty"`
+	// Hosts of the generated Gateway which are also claimed by other Gateways on the same port,
+	// in "host:port claimed by namespace/name" format
+	HostConflicts []string `protobuf:"bytes,13,rep,name=HostConflicts,proto3" json:"HostConflicts,omitempty"`
+	// Status of the certificates issued by the operator for the servers of the generated Gateway
+	Certificates []*GatewayCertificateStatus `protobuf:"bytes,14,rep,name=Certificates,proto3" json:"Certificates,omitempty"`
+	// Hashes of the values the components were last rendered with, keyed by the component names.
+	// Components with unchanged values are only checked for drift instead of being rendered and applied again.
+	ComponentValuesHashes map[string]string `protobuf:"bytes,15,rep,name=ComponentValuesHashes,proto3" json:"ComponentValuesHashes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources of the gateway which were changed by others, as detected during the last reconciliation
+	Drifts []*ResourceDrift `protobuf:"bytes,16,rep,name=Drifts,proto3" json:"Drifts,omitempty"`
+}
+
+func (x *IstioMeshGatewayStatus) Reset() {
+	*x = IstioMeshGatewayStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstioMeshGatewayStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstioMeshGatewayStatus) ProtoMessage() {}
+
+func (x *IstioMeshGatewayStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstioMeshGatewayStatus.ProtoReflect.Descriptor instead.
+func (*IstioMeshGatewayStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IstioMeshGatewayStatus) GetStatus() ConfigState {
+	if x != nil {
+		return x.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (x *IstioMeshGatewayStatus) GetGatewayAddress() []string {
+	if x != nil {
+		return x.GatewayAddress
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *IstioMeshGatewayStatus) GetGatewayHostnames() []string {
+	if x != nil {
+		return x.GatewayHostnames
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *IstioMeshGatewayStatus) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *IstioMeshGatewayStatus) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *IstioMeshGatewayStatus) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *IstioMeshGatewayStatus) GetServicePorts() []*ServicePort {
+	if x != nil {
+		return x.ServicePorts
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetBoundGateways() []string {
+	if x != nil {
+		return x.BoundGateways
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *IstioMeshGatewayStatus) GetBlueGreenRollout() *BlueGreenRolloutStatus {
+	if x != nil {
+		return x.BlueGreenRollout
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetHostConflicts() []string {
+	if x != nil {
+		return x.HostConflicts
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetCertificates() []*GatewayCertificateStatus {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetComponentValuesHashes() map[string]string {
+	if x != nil {
+		return x.ComponentValuesHashes
+	}
+	return nil
+}
+
+func (x *IstioMeshGatewayStatus) GetDrifts() []*ResourceDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+// BlueGreenRolloutStatus describes the state of the blue/green rollout of a gateway deployment
+type BlueGreenRolloutStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Phase of the rollout
+	// +kubebuilder:validation:Enum=Stable;Deploying;Draining
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// Color of the deployment the service points to
+	ActiveColor string `protobuf:"bytes,2,opt,name=activeColor,proto3" json:"activeColor,omitempty"`
+	// Checksum of the configuration of the active deployment
+	ActiveChecksum string `protobuf:"bytes,3,opt,name=activeChecksum,proto3" json:"activeChecksum,omitempty"`
+	// Color of the deployment being deployed or drained
+	TargetColor string `protobuf:"bytes,4,opt,name=targetColor,proto3" json:"targetColor,omitempty"`
+	// Time when draining of the previous deployment started, in RFC 3339 format
+	DrainStartTime string `protobuf:"bytes,5,opt,name=drainStartTime,proto3" json:"drainStartTime,omitempty"`
+	// Checksum of the configuration of the target deployment
+	TargetChecksum string `protobuf:"bytes,6,opt,name=targetChecksum,proto3" json:"targetChecksum,omitempty"`
 }
 
-func (x *Properties) Reset() {
-	*x = Properties{}
+func (x *BlueGreenRolloutStatus) Reset() {
+	*x = BlueGreenRolloutStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[1]
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Properties) String() string {
+func (x *BlueGreenRolloutStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Properties) ProtoMessage() {}
+func (*BlueGreenRolloutStatus) ProtoMessage() {}
 
-func (x *Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[1]
+func (x *BlueGreenRolloutStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,52 +1032,93 @@ func (x *Properties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Properties.ProtoReflect.Descriptor instead.
-func (*Properties) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BlueGreenRolloutStatus.ProtoReflect.Descriptor instead.
+func (*BlueGreenRolloutStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Properties) GetName() string {
+func (x *BlueGreenRolloutStatus) GetPhase() string {
 	if x != nil {
-		return x.Name
+		return x.Phase
 	}
 	return ""
 }
 
-// <!-- go code generation tags
-// +genclient
-// +k8s:deepcopy-gen=true
-// -->
-type IstioMeshGatewayStatus struct {
+func (x *BlueGreenRolloutStatus) GetActiveColor() string {
+	if x != nil {
+		return x.ActiveColor
+	}
+	return ""
+}
+
+func (x *BlueGreenRolloutStatus) GetActiveChecksum() string {
+	if x != nil {
+		return x.ActiveChecksum
+	}
+	return ""
+}
+
+func (x *BlueGreenRolloutStatus) GetTargetColor() string {
+	if x != nil {
+		return x.TargetColor
+	}
+	return ""
+}
+
+func (x *BlueGreenRolloutStatus) GetDrainStartTime() string {
+	if x != nil {
+		return x.DrainStartTime
+	}
+	return ""
+}
+
+func (x *BlueGreenRolloutStatus) GetTargetChecksum() string {
+	if x != nil {
+		return x.TargetChecksum
+	}
+	return ""
+}
+
+// GatewayCertificateStatus describes the state of a certificate issued for a server of the generated Gateway
+type GatewayCertificateStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reconciliation status of the istio mesh gateway
-	Status ConfigState `protobuf:"varint,1,opt,name=Status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"Status,omitempty"`
-	// Current address for the gateway
-	GatewayAddress []string `protobuf:"bytes,2,rep,name=GatewayAddress,proto3" json:"GatewayAddress,omitempty"`
-	// Reconciliation error message if any
-	ErrorMessage string `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	// Name of the credential Secret
+	SecretName string `protobuf:"bytes,1,opt,name=secretName,proto3" json:"secretName,omitempty"`
+	// Hosts the certificate is issued for
+	Hosts []string `protobuf:"bytes,2,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// Issuer of the certificate, either cert-manager or acme
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// State of the certificate
+	// +kubebuilder:validation:Enum=Pending;Issued;Failed
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Expiry of the certificate in the credential Secret, in RFC 3339 format
+	NotAfter string `protobuf:"bytes,5,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	// Details of the state of the certificate
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// URL of the ACME order in progress
+	OrderURL string `protobuf:"bytes,7,opt,name=orderURL,proto3" json:"orderURL,omitempty"`
 }
 
-func (x *IstioMeshGatewayStatus) Reset() {
-	*x = IstioMeshGatewayStatus{}
+func (x *GatewayCertificateStatus) Reset() {
+	*x = GatewayCertificateStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[2]
+		mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IstioMeshGatewayStatus) String() string {
+func (x *GatewayCertificateStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IstioMeshGatewayStatus) ProtoMessage() {}
+func (*GatewayCertificateStatus) ProtoMessage() {}
 
-func (x *IstioMeshGatewayStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[2]
+func (x *GatewayCertificateStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1alpha1_istiomeshgateway_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,28 +1129,56 @@ func (x *IstioMeshGatewayStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IstioMeshGatewayStatus.ProtoReflect.Descriptor instead.
-func (*IstioMeshGatewayStatus) Descriptor() ([]byte, []int) {
-	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GatewayCertificateStatus.ProtoReflect.Descriptor instead.
+func (*GatewayCertificateStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *IstioMeshGatewayStatus) GetStatus() ConfigState {
+func (x *GatewayCertificateStatus) GetSecretName() string {
 	if x != nil {
-		return x.Status
+		return x.SecretName
 	}
-	return ConfigState_Unspecified
+	return ""
 }
 
-func (x *IstioMeshGatewayStatus) GetGatewayAddress() []string {
+func (x *GatewayCertificateStatus) GetHosts() []string {
 	if x != nil {
-		return x.GatewayAddress
+		return x.Hosts
 	}
 	return nil
 }
 
-func (x *IstioMeshGatewayStatus) GetErrorMessage() string {
+func (x *GatewayCertificateStatus) GetIssuer() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *GatewayCertificateStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GatewayCertificateStatus) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *GatewayCertificateStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GatewayCertificateStatus) GetOrderURL() string {
+	if x != nil {
+		return x.OrderURL
 	}
 	return ""
 }
@@ -335,13 +1192,15 @@ var file_api_v1alpha1_istiomeshgateway_proto_rawDesc = []byte{
 	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65,
 	0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x22, 0x6b, 0x38, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
 	0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x04, 0x0a, 0x14, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x05, 0x0a, 0x14, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d,
 	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x5c,
 	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
@@ -374,27 +1233,197 @@ var file_api_v1alpha1_istiomeshgateway_proto_rawDesc = []byte{
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68,
 	0x52, 0x13, 0x6b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65,
-	0x72, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x74, 0x69,
-	0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x72, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x4e, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x51,
+	0x0a, 0x0d, 0x64, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0d, 0x64, 0x72, 0x69, 0x66, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2a, 0x37, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x02, 0x42, 0x37, 0x5a, 0x35,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x7a, 0x61,
-	0x69, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
+	0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xca, 0x01, 0x0a, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x73, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x54, 0x0a, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x09, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69,
+	0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x12, 0x3e, 0x0a, 0x04, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x52, 0x04, 0x61,
+	0x63, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x22, 0x60, 0x0a, 0x1a, 0x43, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x41, 0x43, 0x4d, 0x45, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52,
+	0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b,
+	0x69, 0x70, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x48, 0x0a, 0x18, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x73, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x87, 0x08, 0x0a,
+	0x16, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x10, 0x42, 0x6c, 0x75, 0x65, 0x47, 0x72, 0x65, 0x65, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42,
+	0x6c, 0x75, 0x65, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x42, 0x6c, 0x75, 0x65, 0x47, 0x72, 0x65, 0x65, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x5c, 0x0a,
+	0x0c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x15,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x51, 0x2e, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x44, 0x72, 0x69, 0x66, 0x74, 0x73, 0x1a, 0x48, 0x0a, 0x1a,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x01, 0x0a, 0x16, 0x42, 0x6c, 0x75, 0x65, 0x47,
+	0x72, 0x65, 0x65, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x2a, 0x37, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x02, 0x42,
+	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
+	0x6e, 0x7a, 0x61, 0x69, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -410,32 +1439,61 @@ func file_api_v1alpha1_istiomeshgateway_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1alpha1_istiomeshgateway_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1alpha1_istiomeshgateway_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_v1alpha1_istiomeshgateway_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_v1alpha1_istiomeshgateway_proto_goTypes = []interface{}{
 	(GatewayType)(0),                     // 0: istio_operator.v2.api.v1alpha1.GatewayType
 	(*IstioMeshGatewaySpec)(nil),         // 1: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec
-	(*Properties)(nil),                   // 2: istio_operator.v2.api.v1alpha1.Properties
-	(*IstioMeshGatewayStatus)(nil),       // 3: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus
-	(*BaseKubernetesResourceConfig)(nil), // 4: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	(*Service)(nil),                      // 5: istio_operator.v2.api.v1alpha1.Service
-	(*wrappers.BoolValue)(nil),           // 6: google.protobuf.BoolValue
-	(*NamespacedName)(nil),               // 7: istio_operator.v2.api.v1alpha1.NamespacedName
-	(*K8SResourceOverlayPatch)(nil),      // 8: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	(ConfigState)(0),                     // 9: istio_operator.v2.api.v1alpha1.ConfigState
+	(*GatewayServer)(nil),                // 2: istio_operator.v2.api.v1alpha1.GatewayServer
+	(*GatewayServerTLS)(nil),             // 3: istio_operator.v2.api.v1alpha1.GatewayServerTLS
+	(*GatewayCertificate)(nil),           // 4: istio_operator.v2.api.v1alpha1.GatewayCertificate
+	(*CertManagerIssuerReference)(nil),   // 5: istio_operator.v2.api.v1alpha1.CertManagerIssuerReference
+	(*ACMEIssuer)(nil),                   // 6: istio_operator.v2.api.v1alpha1.ACMEIssuer
+	(*GatewayServerDestination)(nil),     // 7: istio_operator.v2.api.v1alpha1.GatewayServerDestination
+	(*RolloutConfiguration)(nil),         // 8: istio_operator.v2.api.v1alpha1.RolloutConfiguration
+	(*Properties)(nil),                   // 9: istio_operator.v2.api.v1alpha1.Properties
+	(*IstioMeshGatewayStatus)(nil),       // 10: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus
+	(*BlueGreenRolloutStatus)(nil),       // 11: istio_operator.v2.api.v1alpha1.BlueGreenRolloutStatus
+	(*GatewayCertificateStatus)(nil),     // 12: istio_operator.v2.api.v1alpha1.GatewayCertificateStatus
+	nil,                                  // 13: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.ComponentValuesHashesEntry
+	(*BaseKubernetesResourceConfig)(nil), // 14: istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	(*Service)(nil),                      // 15: istio_operator.v2.api.v1alpha1.Service
+	(*wrappers.BoolValue)(nil),           // 16: google.protobuf.BoolValue
+	(*NamespacedName)(nil),               // 17: istio_operator.v2.api.v1alpha1.NamespacedName
+	(*K8SResourceOverlayPatch)(nil),      // 18: istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	(*DriftPolicy)(nil),                  // 19: istio_operator.v2.api.v1alpha1.DriftPolicy
+	(*duration.Duration)(nil),            // 20: google.protobuf.Duration
+	(ConfigState)(0),                     // 21: istio_operator.v2.api.v1alpha1.ConfigState
+	(*ServicePort)(nil),                  // 22: istio_operator.v2.api.v1alpha1.ServicePort
+	(*ResourceDrift)(nil),                // 23: istio_operator.v2.api.v1alpha1.ResourceDrift
 }
 var file_api_v1alpha1_istiomeshgateway_proto_depIdxs = []int32{
-	4, // 0: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
-	5, // 1: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.service:type_name -> istio_operator.v2.api.v1alpha1.Service
-	6, // 2: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.runAsRoot:type_name -> google.protobuf.BoolValue
-	0, // 3: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.type:type_name -> istio_operator.v2.api.v1alpha1.GatewayType
-	7, // 4: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.istioControlPlane:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
-	8, // 5: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
-	9, // 6: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.Status:type_name -> istio_operator.v2.api.v1alpha1.ConfigState
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	14, // 0: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.deployment:type_name -> istio_operator.v2.api.v1alpha1.BaseKubernetesResourceConfig
+	15, // 1: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.service:type_name -> istio_operator.v2.api.v1alpha1.Service
+	16, // 2: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.runAsRoot:type_name -> google.protobuf.BoolValue
+	0,  // 3: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.type:type_name -> istio_operator.v2.api.v1alpha1.GatewayType
+	17, // 4: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.istioControlPlane:type_name -> istio_operator.v2.api.v1alpha1.NamespacedName
+	18, // 5: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.k8sResourceOverlays:type_name -> istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch
+	8,  // 6: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.rollout:type_name -> istio_operator.v2.api.v1alpha1.RolloutConfiguration
+	2,  // 7: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.servers:type_name -> istio_operator.v2.api.v1alpha1.GatewayServer
+	19, // 8: istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec.driftPolicies:type_name -> istio_operator.v2.api.v1alpha1.DriftPolicy
+	3,  // 9: istio_operator.v2.api.v1alpha1.GatewayServer.tls:type_name -> istio_operator.v2.api.v1alpha1.GatewayServerTLS
+	7,  // 10: istio_operator.v2.api.v1alpha1.GatewayServer.destination:type_name -> istio_operator.v2.api.v1alpha1.GatewayServerDestination
+	4,  // 11: istio_operator.v2.api.v1alpha1.GatewayServerTLS.certificate:type_name -> istio_operator.v2.api.v1alpha1.GatewayCertificate
+	5,  // 12: istio_operator.v2.api.v1alpha1.GatewayCertificate.issuerRef:type_name -> istio_operator.v2.api.v1alpha1.CertManagerIssuerReference
+	6,  // 13: istio_operator.v2.api.v1alpha1.GatewayCertificate.acme:type_name -> istio_operator.v2.api.v1alpha1.ACMEIssuer
+	20, // 14: istio_operator.v2.api.v1alpha1.GatewayCertificate.renewBefore:type_name -> google.protobuf.Duration
+	20, // 15: istio_operator.v2.api.v1alpha1.RolloutConfiguration.drainDuration:type_name -> google.protobuf.Duration
+	21, // 16: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.Status:type_name -> istio_operator.v2.api.v1alpha1.ConfigState
+	22, // 17: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.ServicePorts:type_name -> istio_operator.v2.api.v1alpha1.ServicePort
+	11, // 18: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.BlueGreenRollout:type_name -> istio_operator.v2.api.v1alpha1.BlueGreenRolloutStatus
+	12, // 19: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.Certificates:type_name -> istio_operator.v2.api.v1alpha1.GatewayCertificateStatus
+	13, // 20: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.ComponentValuesHashes:type_name -> istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.ComponentValuesHashesEntry
+	23, // 21: istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus.Drifts:type_name -> istio_operator.v2.api.v1alpha1.ResourceDrift
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_v1alpha1_istiomeshgateway_proto_init() }
@@ -458,7 +1516,7 @@ func file_api_v1alpha1_istiomeshgateway_proto_init() {
 			}
 		}
 		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Properties); i {
+			switch v := v.(*GatewayServer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -470,6 +1528,90 @@ func file_api_v1alpha1_istiomeshgateway_proto_init() {
 			}
 		}
 		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayServerTLS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertManagerIssuerReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACMEIssuer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayServerDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Properties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IstioMeshGatewayStatus); i {
 			case 0:
 				return &v.state
@@ -481,6 +1623,30 @@ func file_api_v1alpha1_istiomeshgateway_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlueGreenRolloutStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1alpha1_istiomeshgateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCertificateStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -488,7 +1654,7 @@ func file_api_v1alpha1_istiomeshgateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1alpha1_istiomeshgateway_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
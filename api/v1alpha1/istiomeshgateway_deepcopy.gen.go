@@ -26,6 +26,153 @@ func (in *IstioMeshGatewaySpec) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using GatewayServer within kubernetes types, where deepcopy-gen is used.
+func (in *GatewayServer) DeepCopyInto(out *GatewayServer) {
+	p := proto.Clone(in).(*GatewayServer)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServer. Required by controller-gen.
+func (in *GatewayServer) DeepCopy() *GatewayServer {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServer. Required by controller-gen.
+func (in *GatewayServer) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using GatewayServerTLS within kubernetes types, where deepcopy-gen is used.
+func (in *GatewayServerTLS) DeepCopyInto(out *GatewayServerTLS) {
+	p := proto.Clone(in).(*GatewayServerTLS)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServerTLS. Required by controller-gen.
+func (in *GatewayServerTLS) DeepCopy() *GatewayServerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServerTLS. Required by controller-gen.
+func (in *GatewayServerTLS) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using GatewayCertificate within kubernetes types, where deepcopy-gen is used.
+func (in *GatewayCertificate) DeepCopyInto(out *GatewayCertificate) {
+	p := proto.Clone(in).(*GatewayCertificate)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCertificate. Required by controller-gen.
+func (in *GatewayCertificate) DeepCopy() *GatewayCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCertificate. Required by controller-gen.
+func (in *GatewayCertificate) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CertManagerIssuerReference within kubernetes types, where deepcopy-gen is used.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	p := proto.Clone(in).(*CertManagerIssuerReference)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference. Required by controller-gen.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference. Required by controller-gen.
+func (in *CertManagerIssuerReference) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ACMEIssuer within kubernetes types, where deepcopy-gen is used.
+func (in *ACMEIssuer) DeepCopyInto(out *ACMEIssuer) {
+	p := proto.Clone(in).(*ACMEIssuer)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuer. Required by controller-gen.
+func (in *ACMEIssuer) DeepCopy() *ACMEIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuer. Required by controller-gen.
+func (in *ACMEIssuer) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using GatewayServerDestination within kubernetes types, where deepcopy-gen is used.
+func (in *GatewayServerDestination) DeepCopyInto(out *GatewayServerDestination) {
+	p := proto.Clone(in).(*GatewayServerDestination)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServerDestination. Required by controller-gen.
+func (in *GatewayServerDestination) DeepCopy() *GatewayServerDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServerDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServerDestination. Required by controller-gen.
+func (in *GatewayServerDestination) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using RolloutConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *RolloutConfiguration) DeepCopyInto(out *RolloutConfiguration) {
+	p := proto.Clone(in).(*RolloutConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutConfiguration. Required by controller-gen.
+func (in *RolloutConfiguration) DeepCopy() *RolloutConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RolloutConfiguration. Required by controller-gen.
+func (in *RolloutConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Properties within kubernetes types, where deepcopy-gen is used.
 func (in *Properties) DeepCopyInto(out *Properties) {
 	p := proto.Clone(in).(*Properties)
@@ -67,3 +214,45 @@ func (in *IstioMeshGatewayStatus) DeepCopy() *IstioMeshGatewayStatus {
 func (in *IstioMeshGatewayStatus) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using BlueGreenRolloutStatus within kubernetes types, where deepcopy-gen is used.
+func (in *BlueGreenRolloutStatus) DeepCopyInto(out *BlueGreenRolloutStatus) {
+	p := proto.Clone(in).(*BlueGreenRolloutStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenRolloutStatus. Required by controller-gen.
+func (in *BlueGreenRolloutStatus) DeepCopy() *BlueGreenRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenRolloutStatus. Required by controller-gen.
+func (in *BlueGreenRolloutStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using GatewayCertificateStatus within kubernetes types, where deepcopy-gen is used.
+func (in *GatewayCertificateStatus) DeepCopyInto(out *GatewayCertificateStatus) {
+	p := proto.Clone(in).(*GatewayCertificateStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCertificateStatus. Required by controller-gen.
+func (in *GatewayCertificateStatus) DeepCopy() *GatewayCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCertificateStatus. Required by controller-gen.
+func (in *GatewayCertificateStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
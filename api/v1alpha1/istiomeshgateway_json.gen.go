@@ -17,6 +17,83 @@ func (this *IstioMeshGatewaySpec) UnmarshalJSON(b []byte) error {
 	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for GatewayServer
+func (this *GatewayServer) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for GatewayServer
+func (this *GatewayServer) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for GatewayServerTLS
+func (this *GatewayServerTLS) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for GatewayServerTLS
+func (this *GatewayServerTLS) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for GatewayCertificate
+func (this *GatewayCertificate) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for GatewayCertificate
+func (this *GatewayCertificate) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for CertManagerIssuerReference
+func (this *CertManagerIssuerReference) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for CertManagerIssuerReference
+func (this *CertManagerIssuerReference) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for ACMEIssuer
+func (this *ACMEIssuer) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ACMEIssuer
+func (this *ACMEIssuer) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for GatewayServerDestination
+func (this *GatewayServerDestination) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for GatewayServerDestination
+func (this *GatewayServerDestination) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for RolloutConfiguration
+func (this *RolloutConfiguration) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for RolloutConfiguration
+func (this *RolloutConfiguration) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 // MarshalJSON is a custom marshaler for Properties
 func (this *Properties) MarshalJSON() ([]byte, error) {
 	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
@@ -39,6 +116,28 @@ func (this *IstioMeshGatewayStatus) UnmarshalJSON(b []byte) error {
 	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for BlueGreenRolloutStatus
+func (this *BlueGreenRolloutStatus) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for BlueGreenRolloutStatus
+func (this *BlueGreenRolloutStatus) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for GatewayCertificateStatus
+func (this *GatewayCertificateStatus) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for GatewayCertificateStatus
+func (this *GatewayCertificateStatus) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 var (
 	IstiomeshgatewayMarshaler   = &jsonpb.Marshaler{Int64Uint64asIntegers: true}
 	IstiomeshgatewayUnmarshaler = &jsonpb.Unmarshaler{AllowUnknownFields: true}
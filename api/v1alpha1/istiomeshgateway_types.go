@@ -23,6 +23,14 @@ import (
 const (
 	SidecarInjectionChecksumAnnotation = "sidecar.istio.servicemesh.cisco.com/injection-checksum"
 	MeshConfigChecksumAnnotation       = "sidecar.istio.servicemesh.cisco.com/meshconfig-checksum"
+
+	GatewayColorLabel = "gateway-color"
+
+	GatewayCertificateLabel = "meshgateway.istio.servicemesh.cisco.com/certificate"
+	ACMEChallengeLabel      = "meshgateway.istio.servicemesh.cisco.com/acme-challenge"
+
+	RolloutStrategyRollingUpdate = "RollingUpdate"
+	RolloutStrategyBlueGreen     = "BlueGreen"
 )
 
 // +kubebuilder:object:root=true
@@ -85,13 +93,33 @@ func (p *IstioMeshGatewayWithProperties) SetDefaults() {
 
 // Properties of the IstioMeshGateway
 type IstioMeshGatewayProperties struct {
-	Revision                string             `json:"revision,omitempty"`
-	EnablePrometheusMerge   *bool              `json:"enablePrometheusMerge,omitempty"`
-	InjectionTemplate       string             `json:"injectionTemplate,omitempty"`
-	InjectionChecksum       string             `json:"injectionChecksum,omitempty"`
-	MeshConfigChecksum      string             `json:"meshConfigChecksum,omitempty"`
-	IstioControlPlane       *IstioControlPlane `json:"istioControlPlane,omitempty"`
-	GenerateExternalService bool               `json:"generateExternalService,omitempty"`
+	Revision                string               `json:"revision,omitempty"`
+	EnablePrometheusMerge   *bool                `json:"enablePrometheusMerge,omitempty"`
+	InjectionTemplate       string               `json:"injectionTemplate,omitempty"`
+	InjectionChecksum       string               `json:"injectionChecksum,omitempty"`
+	MeshConfigChecksum      string               `json:"meshConfigChecksum,omitempty"`
+	IstioControlPlane       *IstioControlPlane   `json:"istioControlPlane,omitempty"`
+	GenerateExternalService bool                 `json:"generateExternalService,omitempty"`
+	BlueGreen               *BlueGreenProperties `json:"blueGreen,omitempty"`
+}
+
+// BlueGreenProperties describes the deployments to render for a blue/green rollout of the gateway
+type BlueGreenProperties struct {
+	// Color of the deployment the service points to
+	ActiveColor string `json:"activeColor,omitempty"`
+	// Colors of the deployments to render
+	Colors []string `json:"colors,omitempty"`
+	// Colors of the deployments which must not be updated
+	FrozenColors []string `json:"frozenColors,omitempty"`
+}
+
+// GetGatewayDeploymentName returns the name of the deployment of the given color of a gateway
+func GetGatewayDeploymentName(name string, color string) string {
+	if color == "" {
+		return name
+	}
+
+	return name + "-" + color
 }
 
 func (p IstioMeshGatewayProperties) GetIstioControlPlane() *IstioControlPlane {
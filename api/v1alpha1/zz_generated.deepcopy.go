@@ -26,6 +26,31 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenProperties) DeepCopyInto(out *BlueGreenProperties) {
+	*out = *in
+	if in.Colors != nil {
+		in, out := &in.Colors, &out.Colors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FrozenColors != nil {
+		in, out := &in.FrozenColors, &out.FrozenColors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenProperties.
+func (in *BlueGreenProperties) DeepCopy() *BlueGreenProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioControlPlane) DeepCopyInto(out *IstioControlPlane) {
 	*out = *in
@@ -91,6 +116,67 @@ func (in *IstioControlPlaneList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioControlPlaneProfile) DeepCopyInto(out *IstioControlPlaneProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneProfile.
+func (in *IstioControlPlaneProfile) DeepCopy() *IstioControlPlaneProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioControlPlaneProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IstioControlPlaneProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioControlPlaneProfileList) DeepCopyInto(out *IstioControlPlaneProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IstioControlPlaneProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneProfileList.
+func (in *IstioControlPlaneProfileList) DeepCopy() *IstioControlPlaneProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioControlPlaneProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IstioControlPlaneProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioMesh) DeepCopyInto(out *IstioMesh) {
 	*out = *in
@@ -202,6 +288,11 @@ func (in *IstioMeshGatewayProperties) DeepCopyInto(out *IstioMeshGatewayProperti
 		*out = new(IstioControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenProperties)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshGatewayProperties.
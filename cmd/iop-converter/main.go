@@ -0,0 +1,177 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// iop-converter converts upstream IstioOperator manifests into IstioControlPlane and IstioMeshGateway resources.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/injectiontemplate"
+	"github.com/banzaicloud/istio-operator/v2/pkg/iopconverter"
+)
+
+func main() {
+	var filename, output string
+	var strict bool
+	var opts iopconverter.Options
+
+	flag.StringVar(&filename, "f", "-", "IstioOperator manifest file to convert, - reads the standard input.")
+	flag.StringVar(&output, "o", "", "File to write the converted resources to, defaults to the standard output.")
+	flag.StringVar(&opts.Namespace, "namespace", "", "Namespace of the resources when the IstioOperator does not define one.")
+	flag.StringVar(&opts.Version, "version", "", "Istio version of the control plane when it cannot be derived from the image tag.")
+	flag.BoolVar(&strict, "strict", false, "Fail when the IstioOperator contains fields which cannot be converted.")
+	flag.Parse()
+
+	if err := run(filename, output, strict, opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(filename, output string, strict bool, opts iopconverter.Options) error {
+	input, err := readInput(filename)
+	if err != nil {
+		return err
+	}
+
+	var converted [][]byte
+	var unsupported []string
+
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(input)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.WrapIf(err, "could not read manifest")
+		}
+
+		if !isIstioOperator(doc) {
+			continue
+		}
+
+		result, err := iopconverter.Convert(doc, opts)
+		if err != nil {
+			return err
+		}
+
+		if err := validateInjectionTemplates(result.IstioControlPlane); err != nil {
+			return err
+		}
+
+		y, err := result.YAML()
+		if err != nil {
+			return err
+		}
+
+		converted = append(converted, y)
+		for _, field := range result.UnsupportedFields {
+			unsupported = append(unsupported, fmt.Sprintf("%s/%s: %s", result.IstioControlPlane.GetNamespace(), result.IstioControlPlane.GetName(), field))
+		}
+	}
+
+	if len(converted) == 0 {
+		return errors.New("no IstioOperator found in the input")
+	}
+
+	if len(unsupported) > 0 {
+		fmt.Fprintln(os.Stderr, "the following IstioOperator fields are not supported and were not converted:")
+		for _, field := range unsupported {
+			fmt.Fprintln(os.Stderr, "  "+field)
+		}
+		if strict {
+			return errors.New("the IstioOperator contains unsupported fields")
+		}
+	}
+
+	result := bytes.Join(converted, []byte("---\n"))
+	if output == "" {
+		_, err = os.Stdout.Write(result)
+
+		return errors.WithStack(err)
+	}
+
+	return errors.WrapIfWithDetails(os.WriteFile(output, result, 0o600), "could not write output", "file", output)
+}
+
+// validateInjectionTemplates renders the converted sidecar injection templates for a sample pod the same way as the
+// operator does before applying them, so invalid templates are refused before the control plane is created
+func validateInjectionTemplates(icp *servicemeshv1alpha1.IstioControlPlane) error {
+	templates := icp.GetSpec().GetSidecarInjector().GetTemplates()
+	named := map[string]string{
+		"sidecar": templates.GetSidecar(),
+		"gateway": templates.GetGateway(),
+	}
+	for _, t := range templates.GetCustomTemplates() {
+		named[t.GetName()] = t.GetTemplate()
+	}
+
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := injectiontemplate.SampleData(nil, nil)
+
+	var combinedErr error
+	for _, name := range names {
+		if named[name] == "" {
+			continue
+		}
+
+		if err := injectiontemplate.Validate(name, named[name], data); err != nil {
+			combinedErr = errors.Append(combinedErr, errors.WithDetails(err, "namespace", icp.GetNamespace(), "name", icp.GetName()))
+		}
+	}
+
+	return combinedErr
+}
+
+func readInput(filename string) ([]byte, error) {
+	if filename == "-" {
+		input, err := io.ReadAll(os.Stdin)
+
+		return input, errors.WrapIf(err, "could not read standard input")
+	}
+
+	input, err := os.ReadFile(filename)
+
+	return input, errors.WrapIfWithDetails(err, "could not read file", "file", filename)
+}
+
+func isIstioOperator(doc []byte) bool {
+	var meta struct {
+		Kind string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(doc, &meta); err != nil {
+		return false
+	}
+
+	return meta.Kind == iopconverter.IstioOperatorKind
+}
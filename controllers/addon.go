@@ -0,0 +1,50 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/addon"
+)
+
+func (r *IstioControlPlaneReconciler) getAddonComponentReconcilers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]components.ComponentReconciler, error) {
+	reconcilers := make([]components.ComponentReconciler, 0, len(icp.GetSpec().GetAddons()))
+	for _, a := range icp.GetSpec().GetAddons() {
+		a := a
+
+		chart, err := addon.LoadChart(ctx, r.Client, icp.GetNamespace(), a.GetChart())
+		if err != nil {
+			r.Log.Error(err, "could not load addon chart", "addon", a.GetName())
+			reconcilers = append(reconcilers, addon.NewUnavailableReconciler(a, err))
+
+			continue
+		}
+
+		rec, err := NewComponentReconciler(r, "addon/"+a.GetName(), func(helmReconciler *components.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
+			return addon.NewChartReconciler(helmReconciler, cache, a, chart)
+		}, r.Log.WithName("addon").WithName(a.GetName()))
+		if err != nil {
+			return nil, err
+		}
+		reconcilers = append(reconcilers, rec)
+	}
+
+	return reconcilers, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+)
+
+const (
+	adoptionReportRequeueDuration = time.Minute
+)
+
+// adoptionReportOnly checks whether the existing Istio installation is only reported and the components of the
+// control plane must not be reconciled
+func adoptionReportOnly(icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	adoption := icp.GetSpec().GetAdoption()
+
+	return adoption != nil && adoption.GetMode() != servicemeshv1alpha1.AdoptionConfiguration_adopt && icp.DeletionTimestamp.IsZero()
+}
+
+// reconcileAdoption detects the resources of the existing Istio installation configured to be adopted by the control
+// plane and adopts the ones matching the rendered resources in adopt mode. The result is stored in the status.
+func (r *IstioControlPlaneReconciler) reconcileAdoption(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, componentReconcilers []components.ComponentReconciler) error {
+	adoption := icp.GetSpec().GetAdoption()
+	if adoption == nil || !icp.DeletionTimestamp.IsZero() {
+		icp.GetStatus().AdoptedResources = nil
+
+		return nil
+	}
+
+	revision := adoption.GetRevision()
+	if revision == "" {
+		revision = icp.Revision()
+	}
+
+	rendered := []client.Object{}
+	for _, componentReconciler := range componentReconcilers {
+		objects, err := components.RenderedObjects(componentReconciler, icp)
+		if err != nil {
+			return err
+		}
+		rendered = append(rendered, objects...)
+	}
+
+	resources, err := components.DetectAdoptableResources(ctx, r.GetClient(), icp, revision, rendered)
+	if err != nil {
+		return err
+	}
+
+	if adoption.GetMode() == servicemeshv1alpha1.AdoptionConfiguration_adopt {
+		if err := components.AdoptResources(ctx, r.GetClient(), icp, revision, resources, rendered); err != nil {
+			return err
+		}
+
+		for _, resource := range resources {
+			if resource.GetState() == servicemeshv1alpha1.AdoptedResource_adopted && r.Recorder != nil {
+				r.Recorder.Eventf(icp, corev1.EventTypeNormal, "ResourceAdopted", "%s %s/%s of revision %s was adopted", resource.GetKind(), resource.GetNamespace(), resource.GetName(), revision)
+			}
+		}
+	}
+
+	icp.GetStatus().AdoptedResources = components.MergeAdoptedResources(icp.GetStatus().GetAdoptedResources(), resources)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/util"
+)
+
+var _ = Describe("ServerSideApplyClient", func() {
+	desired := func(sideEffects admissionregistrationv1.SideEffectClass) *admissionregistrationv1.ValidatingWebhookConfiguration {
+		failurePolicy := admissionregistrationv1.Ignore
+
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "istio-validator-server-side-apply",
+			},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{
+					Name: "rev.validation.istio.io",
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						URL: pointer.String("https://istiod.istio-system.svc:443/validate"),
+					},
+					FailurePolicy:           &failurePolicy,
+					SideEffects:             &sideEffects,
+					AdmissionReviewVersions: []string{"v1"},
+				},
+			},
+		}
+	}
+
+	current := func() *admissionregistrationv1.ValidatingWebhookConfiguration {
+		webhook := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKey{Name: "istio-validator-server-side-apply"}, webhook)).To(Succeed())
+
+		return webhook
+	}
+
+	It("keeps the co-owned fields set by others", func() {
+		ctx := context.Background()
+		c := util.NewServerSideApplyClient(k8sClient, util.FieldManager, util.WebhookFailurePolicyField)
+
+		// the co-owned field is set on creation and kept by the updates of the operator
+		Expect(c.Create(ctx, desired(admissionregistrationv1.SideEffectClassNone))).To(Succeed())
+		Expect(*current().Webhooks[0].FailurePolicy).To(Equal(admissionregistrationv1.Ignore))
+		Expect(c.Update(ctx, desired(admissionregistrationv1.SideEffectClassNone))).To(Succeed())
+		Expect(*current().Webhooks[0].FailurePolicy).To(Equal(admissionregistrationv1.Ignore))
+
+		// another controller takes over the co-owned field
+		webhook := current()
+		failurePolicy := admissionregistrationv1.Fail
+		webhook.Webhooks[0].FailurePolicy = &failurePolicy
+		Expect(k8sClient.Update(ctx, webhook, client.FieldOwner("pilot-discovery"))).To(Succeed())
+		resourceVersion := current().GetResourceVersion()
+
+		// the updates of the operator neither conflict with nor revert the co-owned field
+		for i := 0; i < 2; i++ {
+			Expect(c.Update(ctx, desired(admissionregistrationv1.SideEffectClassNone))).To(Succeed())
+			Expect(*current().Webhooks[0].FailurePolicy).To(Equal(admissionregistrationv1.Fail))
+			Expect(current().GetResourceVersion()).To(Equal(resourceVersion))
+		}
+
+		// the fields owned by the operator are still updated
+		Expect(c.Update(ctx, desired(admissionregistrationv1.SideEffectClassNoneOnDryRun))).To(Succeed())
+		webhook = current()
+		Expect(*webhook.Webhooks[0].SideEffects).To(Equal(admissionregistrationv1.SideEffectClassNoneOnDryRun))
+		Expect(*webhook.Webhooks[0].FailurePolicy).To(Equal(admissionregistrationv1.Fail))
+
+		for _, managedFields := range webhook.GetManagedFields() {
+			if managedFields.Manager == util.FieldManager {
+				Expect(strings.Contains(string(managedFields.FieldsV1.Raw), "failurePolicy")).To(BeFalse())
+			}
+		}
+	})
+})
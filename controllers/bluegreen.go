@@ -0,0 +1,227 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	blueGreenPhaseStable    = "Stable"
+	blueGreenPhaseDeploying = "Deploying"
+	blueGreenPhaseDraining  = "Draining"
+
+	blueGreenColorBlue  = "blue"
+	blueGreenColorGreen = "green"
+
+	defaultBlueGreenDrainDuration     = time.Second * 30
+	blueGreenDeployingRequeueDuration = time.Second * 10
+)
+
+// reconcileBlueGreenRollout advances the blue/green rollout state machine of the gateway and returns the deployments
+// to render along with the duration after which the rollout needs to be checked again
+func (r *IstioMeshGatewayReconciler) reconcileBlueGreenRollout(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, properties servicemeshv1alpha1.IstioMeshGatewayProperties, logger logger.Logger) (*servicemeshv1alpha1.BlueGreenProperties, time.Duration, error) {
+	status := imgw.GetStatus()
+
+	if imgw.GetSpec().GetRollout().GetStrategy() != servicemeshv1alpha1.RolloutStrategyBlueGreen || !imgw.DeletionTimestamp.IsZero() {
+		status.BlueGreenRollout = nil
+
+		return nil, 0, nil
+	}
+
+	checksum, err := getBlueGreenChecksum(imgw, properties)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rollout := status.GetBlueGreenRollout()
+	if rollout == nil || rollout.GetPhase() == "" {
+		rollout = &servicemeshv1alpha1.BlueGreenRolloutStatus{}
+		status.BlueGreenRollout = rollout
+
+		// an existing gateway deployment is migrated to the first color the same way as a changed configuration
+		var deployment appsv1.Deployment
+		err := r.Get(ctx, client.ObjectKey{Name: imgw.GetName(), Namespace: imgw.GetNamespace()}, &deployment)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, 0, errors.WrapIf(err, "could not get gateway deployment")
+		}
+		if err == nil {
+			logger.Info("migrating gateway deployment to blue/green rollout")
+			rollout.Phase = blueGreenPhaseDeploying
+			rollout.ActiveColor = ""
+			rollout.TargetColor = blueGreenColorBlue
+			rollout.TargetChecksum = checksum
+		} else {
+			rollout.Phase = blueGreenPhaseStable
+			rollout.ActiveColor = blueGreenColorBlue
+			rollout.ActiveChecksum = checksum
+		}
+	}
+
+	switch rollout.GetPhase() {
+	case blueGreenPhaseDeploying:
+		if rollout.GetActiveColor() != "" && rollout.GetActiveChecksum() == checksum {
+			// the configuration was reverted before switching over, the active deployment is kept
+			logger.Info("gateway configuration reverted, removing new gateway deployment", "color", rollout.GetTargetColor())
+			rollout.Phase = blueGreenPhaseStable
+			rollout.TargetColor = ""
+			rollout.TargetChecksum = ""
+		} else if rollout.GetTargetChecksum() != checksum {
+			// the target deployment is updated with the latest configuration before switching over to it
+			rollout.TargetChecksum = checksum
+		} else {
+			ready, err := r.isGatewayDeploymentReady(ctx, imgw, rollout.GetTargetColor())
+			if err != nil {
+				return nil, 0, err
+			}
+			if ready {
+				logger.Info("switching gateway service over to the new deployment", "from", rollout.GetActiveColor(), "to", rollout.GetTargetColor())
+				rollout.Phase = blueGreenPhaseDraining
+				rollout.ActiveColor, rollout.TargetColor = rollout.GetTargetColor(), rollout.GetActiveColor()
+				rollout.ActiveChecksum = rollout.GetTargetChecksum()
+				rollout.TargetChecksum = ""
+				rollout.DrainStartTime = time.Now().UTC().Format(time.RFC3339)
+			}
+		}
+	case blueGreenPhaseDraining:
+		drainStartTime, err := time.Parse(time.RFC3339, rollout.GetDrainStartTime())
+		if err != nil {
+			drainStartTime = time.Now()
+			rollout.DrainStartTime = drainStartTime.UTC().Format(time.RFC3339)
+		}
+		if !time.Now().Before(drainStartTime.Add(getBlueGreenDrainDuration(imgw))) {
+			logger.Info("removing drained gateway deployment", "color", rollout.GetTargetColor())
+			rollout.Phase = blueGreenPhaseStable
+			rollout.TargetColor = ""
+			rollout.DrainStartTime = ""
+		}
+	default:
+		if rollout.GetActiveChecksum() != checksum {
+			rollout.Phase = blueGreenPhaseDeploying
+			rollout.TargetColor = getOtherBlueGreenColor(rollout.GetActiveColor())
+			rollout.TargetChecksum = checksum
+			logger.Info("gateway configuration changed, deploying new gateway deployment", "color", rollout.GetTargetColor())
+		}
+	}
+
+	blueGreen := &servicemeshv1alpha1.BlueGreenProperties{
+		ActiveColor: rollout.GetActiveColor(),
+		Colors:      []string{rollout.GetActiveColor()},
+	}
+
+	switch rollout.GetPhase() {
+	case blueGreenPhaseDeploying:
+		blueGreen.Colors = append(blueGreen.Colors, rollout.GetTargetColor())
+		blueGreen.FrozenColors = []string{rollout.GetActiveColor()}
+
+		return blueGreen, blueGreenDeployingRequeueDuration, nil
+	case blueGreenPhaseDraining:
+		blueGreen.Colors = append(blueGreen.Colors, rollout.GetTargetColor())
+		blueGreen.FrozenColors = []string{rollout.GetTargetColor()}
+
+		drainStartTime, _ := time.Parse(time.RFC3339, rollout.GetDrainStartTime())
+		requeueAfter := time.Until(drainStartTime.Add(getBlueGreenDrainDuration(imgw)))
+		if requeueAfter < time.Second {
+			requeueAfter = time.Second
+		}
+
+		return blueGreen, requeueAfter, nil
+	default:
+		return blueGreen, 0, nil
+	}
+}
+
+// isGatewayDeploymentReady checks whether every pod of the deployment of the given color is updated and ready
+func (r *IstioMeshGatewayReconciler) isGatewayDeploymentReady(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, color string) (bool, error) {
+	var deployment appsv1.Deployment
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      servicemeshv1alpha1.GetGatewayDeploymentName(imgw.GetName(), color),
+		Namespace: imgw.GetNamespace(),
+	}, &deployment)
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapIf(err, "could not get gateway deployment")
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.ReadyReplicas == replicas, nil
+}
+
+// getBlueGreenChecksum calculates the checksum of the configuration which results in a new gateway deployment
+func getBlueGreenChecksum(imgw *servicemeshv1alpha1.IstioMeshGateway, properties servicemeshv1alpha1.IstioMeshGatewayProperties) (string, error) {
+	deployment, err := json.Marshal(imgw.GetSpec().GetDeployment())
+	if err != nil {
+		return "", errors.WrapIf(err, "could not marshal gateway deployment configuration")
+	}
+
+	overlays, err := json.Marshal(imgw.GetSpec().GetK8SResourceOverlays())
+	if err != nil {
+		return "", errors.WrapIf(err, "could not marshal gateway resource overlays")
+	}
+
+	enablePrometheusMerge := properties.EnablePrometheusMerge != nil && *properties.EnablePrometheusMerge
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%t\n%s\n%s\n%s\n%s\n%t\n",
+		deployment,
+		overlays,
+		imgw.GetSpec().GetRunAsRoot().GetValue(),
+		properties.Revision,
+		properties.InjectionTemplate,
+		properties.InjectionChecksum,
+		properties.MeshConfigChecksum,
+		enablePrometheusMerge,
+	)
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+func getBlueGreenDrainDuration(imgw *servicemeshv1alpha1.IstioMeshGateway) time.Duration {
+	if d := imgw.GetSpec().GetRollout().GetDrainDuration(); d != nil {
+		return d.AsDuration()
+	}
+
+	return defaultBlueGreenDrainDuration
+}
+
+func getOtherBlueGreenColor(color string) string {
+	if color == blueGreenColorBlue {
+		return blueGreenColorGreen
+	}
+
+	return blueGreenColorBlue
+}
@@ -0,0 +1,391 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+func TestReconcileBlueGreenRollout(t *testing.T) {
+	t.Parallel()
+
+	drainDuration := time.Hour
+	newGateway := func(strategy string, rollout *servicemeshv1alpha1.BlueGreenRolloutStatus) *servicemeshv1alpha1.IstioMeshGateway {
+		return &servicemeshv1alpha1.IstioMeshGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-gw",
+				Namespace: "istio-system",
+			},
+			Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+				Rollout: &servicemeshv1alpha1.RolloutConfiguration{
+					Strategy:      strategy,
+					DrainDuration: durationpb.New(drainDuration),
+				},
+			},
+			Status: &servicemeshv1alpha1.IstioMeshGatewayStatus{
+				BlueGreenRollout: rollout,
+			},
+		}
+	}
+
+	properties := servicemeshv1alpha1.IstioMeshGatewayProperties{
+		Revision:          "cp-v117x.istio-system",
+		InjectionChecksum: "new",
+	}
+	previousProperties := properties
+	previousProperties.InjectionChecksum = "previous"
+
+	checksum, err := getBlueGreenChecksum(newGateway(servicemeshv1alpha1.RolloutStrategyBlueGreen, nil), properties)
+	assert.NilError(t, err)
+	previousChecksum, err := getBlueGreenChecksum(newGateway(servicemeshv1alpha1.RolloutStrategyBlueGreen, nil), previousProperties)
+	assert.NilError(t, err)
+
+	deployment := func(name string, ready bool) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "istio-system",
+				Generation: 1,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: utils.IntPointer(2),
+			},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				UpdatedReplicas:    2,
+				ReadyReplicas:      1,
+			},
+		}
+		if ready {
+			deployment.Status.ReadyReplicas = 2
+		}
+
+		return deployment
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	testCases := []struct {
+		name         string
+		strategy     string
+		rollout      *servicemeshv1alpha1.BlueGreenRolloutStatus
+		deployments  []client.Object
+		expected     *servicemeshv1alpha1.BlueGreenRolloutStatus
+		blueGreen    *servicemeshv1alpha1.BlueGreenProperties
+		requeueAfter time.Duration
+	}{
+		{
+			name:     "rolling update",
+			strategy: servicemeshv1alpha1.RolloutStrategyRollingUpdate,
+			rollout:  &servicemeshv1alpha1.BlueGreenRolloutStatus{Phase: blueGreenPhaseStable},
+		},
+		{
+			name:     "new gateway",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor: blueGreenColorBlue,
+				Colors:      []string{blueGreenColorBlue},
+			},
+		},
+		{
+			name:        "existing gateway deployment is migrated",
+			strategy:    servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			deployments: []client.Object{deployment("demo-gw", true)},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				TargetColor:    blueGreenColorBlue,
+				TargetChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				Colors:       []string{"", blueGreenColorBlue},
+				FrozenColors: []string{""},
+			},
+			requeueAfter: blueGreenDeployingRequeueDuration,
+		},
+		{
+			name:     "stable",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+			},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor: blueGreenColorGreen,
+				Colors:      []string{blueGreenColorGreen},
+			},
+		},
+		{
+			name:     "changed configuration is deployed",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: previousChecksum,
+			},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: previousChecksum,
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor:  blueGreenColorBlue,
+				Colors:       []string{blueGreenColorBlue, blueGreenColorGreen},
+				FrozenColors: []string{blueGreenColorBlue},
+			},
+			requeueAfter: blueGreenDeployingRequeueDuration,
+		},
+		{
+			name:     "deploying until the new deployment is ready",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: previousChecksum,
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: checksum,
+			},
+			deployments: []client.Object{deployment("demo-gw-green", false)},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: previousChecksum,
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor:  blueGreenColorBlue,
+				Colors:       []string{blueGreenColorBlue, blueGreenColorGreen},
+				FrozenColors: []string{blueGreenColorBlue},
+			},
+			requeueAfter: blueGreenDeployingRequeueDuration,
+		},
+		{
+			name:     "configuration changed while deploying",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: "outdated",
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: previousChecksum,
+			},
+			deployments: []client.Object{deployment("demo-gw-green", true)},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: "outdated",
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor:  blueGreenColorBlue,
+				Colors:       []string{blueGreenColorBlue, blueGreenColorGreen},
+				FrozenColors: []string{blueGreenColorBlue},
+			},
+			requeueAfter: blueGreenDeployingRequeueDuration,
+		},
+		{
+			name:     "promote",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: previousChecksum,
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: checksum,
+			},
+			deployments: []client.Object{deployment("demo-gw-green", true)},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDraining,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+				TargetColor:    blueGreenColorBlue,
+				DrainStartTime: now,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor:  blueGreenColorGreen,
+				Colors:       []string{blueGreenColorGreen, blueGreenColorBlue},
+				FrozenColors: []string{blueGreenColorBlue},
+			},
+			requeueAfter: drainDuration,
+		},
+		{
+			name:     "rollback",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDeploying,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: checksum,
+				TargetColor:    blueGreenColorGreen,
+				TargetChecksum: previousChecksum,
+			},
+			deployments: []client.Object{deployment("demo-gw-green", true)},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorBlue,
+				ActiveChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor: blueGreenColorBlue,
+				Colors:      []string{blueGreenColorBlue},
+			},
+		},
+		{
+			name:     "draining",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDraining,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+				TargetColor:    blueGreenColorBlue,
+				DrainStartTime: now,
+			},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDraining,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+				TargetColor:    blueGreenColorBlue,
+				DrainStartTime: now,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor:  blueGreenColorGreen,
+				Colors:       []string{blueGreenColorGreen, blueGreenColorBlue},
+				FrozenColors: []string{blueGreenColorBlue},
+			},
+			requeueAfter: drainDuration,
+		},
+		{
+			name:     "drained",
+			strategy: servicemeshv1alpha1.RolloutStrategyBlueGreen,
+			rollout: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseDraining,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+				TargetColor:    blueGreenColorBlue,
+				DrainStartTime: time.Now().Add(-drainDuration).UTC().Format(time.RFC3339),
+			},
+			expected: &servicemeshv1alpha1.BlueGreenRolloutStatus{
+				Phase:          blueGreenPhaseStable,
+				ActiveColor:    blueGreenColorGreen,
+				ActiveChecksum: checksum,
+			},
+			blueGreen: &servicemeshv1alpha1.BlueGreenProperties{
+				ActiveColor: blueGreenColorGreen,
+				Colors:      []string{blueGreenColorGreen},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &IstioMeshGatewayReconciler{
+				Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(tc.deployments...).Build(),
+			}
+			imgw := newGateway(tc.strategy, tc.rollout)
+
+			blueGreen, requeueAfter, err := r.reconcileBlueGreenRollout(context.Background(), imgw, properties, logger.NewWithLogrLogger(logr.Discard()))
+			assert.NilError(t, err)
+
+			assert.DeepEqual(t, imgw.GetStatus().GetBlueGreenRollout(), tc.expected, protocmp.Transform(), protocmp.IgnoreFields(&servicemeshv1alpha1.BlueGreenRolloutStatus{}, "drainStartTime"))
+			assert.Equal(t, imgw.GetStatus().GetBlueGreenRollout().GetDrainStartTime() != "", tc.expected.GetDrainStartTime() != "")
+			assert.DeepEqual(t, blueGreen, tc.blueGreen)
+			assert.Assert(t, requeueAfter <= tc.requeueAfter && requeueAfter > tc.requeueAfter-time.Minute, "requeue after %s instead of %s", requeueAfter, tc.requeueAfter)
+		})
+	}
+}
+
+func TestIsGatewayDeploymentReady(t *testing.T) {
+	t.Parallel()
+
+	imgw := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-gw",
+			Namespace: "istio-system",
+		},
+	}
+
+	deployment := func(color string, generation int64, status appsv1.DeploymentStatus) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       servicemeshv1alpha1.GetGatewayDeploymentName(imgw.GetName(), color),
+				Namespace:  imgw.GetNamespace(),
+				Generation: generation,
+			},
+			Status: status,
+		}
+	}
+
+	r := &IstioMeshGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			deployment(blueGreenColorBlue, 2, appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 1, ReadyReplicas: 1}),
+			deployment(blueGreenColorGreen, 2, appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 1, ReadyReplicas: 1}),
+			deployment("", 1, appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 1}),
+		).Build(),
+	}
+
+	testCases := []struct {
+		color string
+		ready bool
+	}{
+		{color: blueGreenColorBlue, ready: true},
+		{color: blueGreenColorGreen, ready: false},
+		{color: "", ready: false},
+		{color: "red", ready: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.color, func(t *testing.T) {
+			t.Parallel()
+
+			ready, err := r.isGatewayDeploymentReady(context.Background(), imgw, tc.color)
+			assert.NilError(t, err)
+			assert.Equal(t, ready, tc.ready)
+		})
+	}
+}
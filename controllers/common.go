@@ -17,9 +17,6 @@ limitations under the License.
 package controllers
 
 import (
-	"k8s.io/client-go/discovery"
-	ctrl "sigs.k8s.io/controller-runtime"
-
 	"github.com/banzaicloud/istio-operator/v2/internal/components"
 	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
@@ -28,20 +25,17 @@ import (
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 )
 
-func NewComponentReconciler(r components.Reconciler, newComponentFunc components.NewComponentReconcilerFunc, logger logger.Logger) (components.ComponentReconciler, error) {
-	config, err := ctrl.GetConfig()
-	if err != nil {
-		return nil, err
-	}
-
-	var d discovery.DiscoveryInterface
-	if d, err = discovery.NewDiscoveryClientForConfig(config); err != nil {
-		return nil, err
-	}
-
-	return newComponentFunc(
-		templatereconciler.NewHelmReconcilerWith(
-			r.GetClient(),
+// NewComponentReconciler creates the named component with the long-lived helm reconciler and the render cache of the reconciler
+func NewComponentReconciler(r components.Reconciler, name string, newComponentFunc components.NewComponentReconcilerFunc, logger logger.Logger) (components.ComponentReconciler, error) {
+	cache := r.GetComponentCache()
+	helmReconciler, err := cache.HelmReconciler(name, func() (*components.HelmReconciler, error) {
+		d, err := cache.Discovery()
+		if err != nil {
+			return nil, err
+		}
+
+		return templatereconciler.NewHelmReconcilerWith(
+			r.GetResourceClient(),
 			r.GetScheme(),
 			logger.GetLogrLogger(),
 			d,
@@ -55,6 +49,11 @@ func NewComponentReconciler(r components.Reconciler, newComponentFunc components
 				reconciler.WithPatchCalculateOptions(patch.IgnoreStatusFields(), reconciler.IgnoreManagedFields()),
 			),
 			templatereconciler.ManageNamespace(false),
-		),
-	), nil
+		), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newComponentFunc(helmReconciler, cache), nil
 }
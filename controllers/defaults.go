@@ -40,6 +40,11 @@ func setDynamicDefaults(ctx context.Context, kubeClient client.Client, icp *v1al
 		}
 	}
 
+	// the network is defaulted after the profile is merged, so that a default does not override the profile
+	if icp.Spec.NetworkName == "" {
+		icp.Spec.NetworkName = v1alpha1.DefaultNetworkName
+	}
+
 	if icp.Spec.ClusterID == "" {
 		icp.Spec.ClusterID = "Kubernetes"
 		if clusterRegistryAPIEnabled {
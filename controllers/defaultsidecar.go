@@ -0,0 +1,386 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/istio-operator/v2/internal/util/prometheus"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	defaultSidecarName                 = "default"
+	defaultSidecarLearningLookback     = time.Hour
+	sidecarLearningModeRequeueDuration = time.Minute * 5
+)
+
+// reconcileDefaultSidecars creates a default Sidecar resource in every namespace enabled for injection
+// for the control plane to limit the configuration the proxies receive
+func (r *IstioControlPlaneReconciler) reconcileDefaultSidecars(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if !isActive(icp) {
+		return nil
+	}
+
+	labels := utils.MergeLabels(icp.RevisionLabels(), map[string]string{
+		servicemeshv1alpha1.DefaultSidecarLabel: "true",
+	})
+
+	current := &istionetworkingv1alpha3.SidecarList{}
+	err := r.GetClient().List(ctx, current, client.MatchingLabels(labels))
+	if err != nil {
+		return errors.WrapIf(err, "could not list sidecars")
+	}
+
+	desired := make(map[client.ObjectKey]struct{})
+
+	config := icp.GetSpec().GetDefaultSidecar()
+	if icp.DeletionTimestamp.IsZero() && config.GetEnabled().GetValue() {
+		learningMode := config.GetLearningMode().GetEnabled().GetValue()
+
+		var dependencies map[string][]string
+		if learningMode {
+			dependencies, err = r.getNamespaceDependencies(ctx, icp)
+			if err != nil {
+				// the dependencies are going to be learned on the next query
+				r.Log.Error(err, "could not get namespace dependencies")
+			}
+		}
+
+		for _, name := range icp.GetStatus().InjectionNamespaces {
+			ns := &corev1.Namespace{}
+			err = r.GetClient().Get(ctx, client.ObjectKey{
+				Name: name,
+			}, ns)
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return errors.WrapIfWithDetails(err, "could not get namespace", "namespace", name)
+			}
+
+			if dependencies != nil {
+				err = r.recordLearnedEgressNamespaces(ctx, ns, dependencies[name])
+				if err != nil {
+					return err
+				}
+			}
+
+			userDefined, err := r.hasUserDefinedNamespaceSidecar(ctx, name)
+			if err != nil {
+				return err
+			}
+			if userDefined {
+				r.Log.V(1).Info("namespace has a user defined sidecar, skip default sidecar generation", "namespace", name)
+
+				continue
+			}
+
+			sidecar := &istionetworkingv1alpha3.Sidecar{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Sidecar",
+					APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      icp.WithRevision(defaultSidecarName),
+					Namespace: name,
+					Labels:    utils.MergeLabels(labels),
+				},
+			}
+			sidecar.Spec.Egress = []*networkingv1alpha3.IstioEgressListener{
+				{
+					Hosts: getDefaultSidecarEgressHosts(icp, ns, learningMode),
+				},
+			}
+
+			_, err = r.ResourceReconciler.ReconcileResource(sidecar, reconciler.StatePresent)
+			if err != nil {
+				return errors.WrapIfWithDetails(err, "could not reconcile default sidecar", "namespace", name)
+			}
+
+			desired[client.ObjectKeyFromObject(sidecar)] = struct{}{}
+		}
+	}
+
+	if !icp.DeletionTimestamp.IsZero() || !config.GetLearningMode().GetEnabled().GetValue() {
+		r.namespaceDependencies.forget(icp.GetUID())
+	}
+
+	for _, sidecar := range current.Items {
+		if _, ok := desired[client.ObjectKeyFromObject(sidecar)]; ok {
+			continue
+		}
+
+		_, err = r.ResourceReconciler.ReconcileResource(sidecar, reconciler.StateAbsent)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not remove default sidecar", "namespace", sidecar.GetNamespace())
+		}
+	}
+
+	return nil
+}
+
+// getDefaultSidecarControlPlanes returns the requests of the control planes which generate a default sidecar in the
+// namespace of the user defined Sidecar
+func (r *IstioControlPlaneReconciler) getDefaultSidecarControlPlanes(obj client.Object) []reconcile.Request {
+	if _, ok := obj.GetLabels()[servicemeshv1alpha1.DefaultSidecarLabel]; ok {
+		return nil
+	}
+
+	icps := &servicemeshv1alpha1.IstioControlPlaneList{}
+	err := r.GetClient().List(context.Background(), icps)
+	if err != nil {
+		r.Log.Error(err, "could not list istio control plane resources")
+
+		return nil
+	}
+
+	resources := make([]reconcile.Request, 0)
+	for _, icp := range icps.Items {
+		if !icp.GetResolvedSpec().GetDefaultSidecar().GetEnabled().GetValue() || !util.ContainsString(icp.GetStatus().GetInjectionNamespaces(), obj.GetNamespace()) {
+			continue
+		}
+
+		resources = append(resources, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      icp.GetName(),
+				Namespace: icp.GetNamespace(),
+			},
+		})
+	}
+
+	return resources
+}
+
+func (r *IstioControlPlaneReconciler) hasUserDefinedNamespaceSidecar(ctx context.Context, namespace string) (bool, error) {
+	sidecars := &istionetworkingv1alpha3.SidecarList{}
+	err := r.GetClient().List(ctx, sidecars, client.InNamespace(namespace))
+	if err != nil {
+		return false, errors.WrapIfWithDetails(err, "could not list sidecars", "namespace", namespace)
+	}
+
+	for _, sidecar := range sidecars.Items {
+		if _, ok := sidecar.GetLabels()[servicemeshv1alpha1.DefaultSidecarLabel]; ok {
+			continue
+		}
+		if sidecar.Spec.GetWorkloadSelector() == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getNamespaceDependencies returns the namespace dependencies observed within the lookback of the learning mode.
+// Prometheus is queried at most once per requeue interval, in between the results of the last query are used.
+func (r *IstioControlPlaneReconciler) getNamespaceDependencies(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (map[string][]string, error) {
+	config := icp.GetSpec().GetDefaultSidecar().GetLearningMode()
+
+	lookback := defaultSidecarLearningLookback
+	if config.GetLookback() != nil {
+		lookback = config.GetLookback().AsDuration()
+	}
+
+	query := namespaceDependenciesQuery{
+		address:  config.GetPrometheusAddress(),
+		lookback: lookback,
+	}
+	if dependencies, ok := r.namespaceDependencies.get(icp.GetUID(), query, time.Now()); ok {
+		return dependencies, nil
+	}
+
+	dependencies, err := prometheus.GetNamespaceDependencies(ctx, query.address, query.lookback)
+	if err != nil {
+		return nil, err
+	}
+
+	r.namespaceDependencies.set(icp.GetUID(), query, dependencies, time.Now())
+
+	return dependencies, nil
+}
+
+// recordLearnedEgressNamespaces sets the dependencies of the namespace observed within the lookback to its learned
+// egress namespaces annotation, so namespaces which are no longer called are pruned
+func (r *IstioControlPlaneReconciler) recordLearnedEgressNamespaces(ctx context.Context, ns *corev1.Namespace, dependencies []string) error {
+	learned := splitNamespaceList(ns.GetAnnotations()[servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation])
+	observed := uniqueSortedStrings(dependencies)
+
+	newNamespaces := make([]string, 0)
+	for _, name := range observed {
+		if !util.ContainsString(learned, name) {
+			newNamespaces = append(newNamespaces, name)
+		}
+	}
+
+	prunedNamespaces := make([]string, 0)
+	for _, name := range learned {
+		if !util.ContainsString(observed, name) {
+			prunedNamespaces = append(prunedNamespaces, name)
+		}
+	}
+
+	if len(newNamespaces) == 0 && len(prunedNamespaces) == 0 {
+		return nil
+	}
+
+	annotations := ns.GetAnnotations()
+	if len(observed) > 0 {
+		annotations = utils.MergeLabels(annotations, map[string]string{
+			servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation: strings.Join(observed, ","),
+		})
+	} else {
+		delete(annotations, servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation)
+	}
+	ns.SetAnnotations(annotations)
+
+	err := r.GetClient().Update(ctx, ns)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "could not record learned egress namespaces", "namespace", ns.GetName())
+	}
+
+	if r.Recorder == nil {
+		return nil
+	}
+
+	if len(newNamespaces) > 0 {
+		r.Recorder.Eventf(
+			ns,
+			corev1.EventTypeNormal,
+			"SidecarEgressNamespacesLearned",
+			"observed calls from namespace %s to namespaces %s",
+			ns.GetName(),
+			strings.Join(newNamespaces, ","),
+		)
+	}
+
+	if len(prunedNamespaces) > 0 {
+		r.Recorder.Eventf(
+			ns,
+			corev1.EventTypeNormal,
+			"SidecarEgressNamespacesPruned",
+			"no calls from namespace %s to namespaces %s observed within the lookback",
+			ns.GetName(),
+			strings.Join(prunedNamespaces, ","),
+		)
+	}
+
+	return nil
+}
+
+func getDefaultSidecarEgressHosts(icp *servicemeshv1alpha1.IstioControlPlane, ns *corev1.Namespace, learningMode bool) []string {
+	if learningMode {
+		// egress is not restricted while the dependencies are being learned
+		return []string{"*/*"}
+	}
+
+	namespaces := []string{icp.GetNamespace()}
+	namespaces = append(namespaces, icp.GetSpec().GetDefaultSidecar().GetEgressNamespaces()...)
+	namespaces = append(namespaces, splitNamespaceList(ns.GetAnnotations()[servicemeshv1alpha1.SidecarEgressNamespacesAnnotation])...)
+	namespaces = append(namespaces, splitNamespaceList(ns.GetAnnotations()[servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation])...)
+
+	hosts := []string{"./*"}
+	seen := map[string]struct{}{
+		ns.GetName(): {},
+	}
+	for _, name := range namespaces {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		hosts = append(hosts, name+"/*")
+	}
+
+	return hosts
+}
+
+func splitNamespaceList(value string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+type namespaceDependenciesQuery struct {
+	address  string
+	lookback time.Duration
+}
+
+type namespaceDependenciesEntry struct {
+	query        namespaceDependenciesQuery
+	queriedAt    time.Time
+	dependencies map[string][]string
+}
+
+// namespaceDependenciesCache holds the last queried namespace dependencies of the control planes
+type namespaceDependenciesCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]namespaceDependenciesEntry
+}
+
+func (c *namespaceDependenciesCache) get(uid types.UID, query namespaceDependenciesQuery, now time.Time) (map[string][]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[uid]
+	if !ok || entry.query != query || now.Sub(entry.queriedAt) >= sidecarLearningModeRequeueDuration {
+		return nil, false
+	}
+
+	return entry.dependencies, true
+}
+
+func (c *namespaceDependenciesCache) set(uid types.UID, query namespaceDependenciesQuery, dependencies map[string][]string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[types.UID]namespaceDependenciesEntry)
+	}
+
+	c.entries[uid] = namespaceDependenciesEntry{
+		query:        query,
+		queriedAt:    now,
+		dependencies: dependencies,
+	}
+}
+
+func (c *namespaceDependenciesCache) forget(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, uid)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestGetDefaultSidecarEgressHosts(t *testing.T) {
+	t.Parallel()
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x",
+			Namespace: "istio-system",
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			DefaultSidecar: &servicemeshv1alpha1.DefaultSidecarConfiguration{
+				EgressNamespaces: []string{"monitoring", "istio-system"},
+			},
+		},
+	}
+
+	namespace := func(annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bookinfo",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		ns           *corev1.Namespace
+		learningMode bool
+		hosts        []string
+	}{
+		{
+			name:         "learning mode",
+			ns:           namespace(nil),
+			learningMode: true,
+			hosts:        []string{"*/*"},
+		},
+		{
+			name:  "control plane and configured namespaces",
+			ns:    namespace(nil),
+			hosts: []string{"./*", "istio-system/*", "monitoring/*"},
+		},
+		{
+			name: "annotated and learned namespaces",
+			ns: namespace(map[string]string{
+				servicemeshv1alpha1.SidecarEgressNamespacesAnnotation:        "payments, monitoring",
+				servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation: "reviews,payments",
+			}),
+			hosts: []string{"./*", "istio-system/*", "monitoring/*", "payments/*", "reviews/*"},
+		},
+		{
+			name: "own namespace",
+			ns: namespace(map[string]string{
+				servicemeshv1alpha1.SidecarEgressNamespacesAnnotation: "bookinfo,",
+			}),
+			hosts: []string{"./*", "istio-system/*", "monitoring/*"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, getDefaultSidecarEgressHosts(icp, tc.ns, tc.learningMode), tc.hosts)
+		})
+	}
+}
+
+func TestRecordLearnedEgressNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		learned      string
+		dependencies []string
+		expected     string
+		events       int
+	}{
+		{
+			name:         "new namespaces",
+			dependencies: []string{"reviews", "details"},
+			expected:     "details,reviews",
+			events:       1,
+		},
+		{
+			name:         "unchanged",
+			learned:      "details,reviews",
+			dependencies: []string{"reviews", "details"},
+			expected:     "details,reviews",
+		},
+		{
+			name:         "namespaces not called within the lookback are pruned",
+			learned:      "details,reviews",
+			dependencies: []string{"reviews", "ratings"},
+			expected:     "ratings,reviews",
+			events:       2,
+		},
+		{
+			name:     "every namespace pruned",
+			learned:  "details",
+			expected: "",
+			events:   1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bookinfo",
+				},
+			}
+			if tc.learned != "" {
+				ns.Annotations = map[string]string{
+					servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation: tc.learned,
+				}
+			}
+
+			c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(ns).Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &IstioControlPlaneReconciler{
+				Client:   c,
+				Recorder: recorder,
+			}
+
+			assert.NilError(t, r.recordLearnedEgressNamespaces(context.Background(), ns.DeepCopy(), tc.dependencies))
+
+			current := &corev1.Namespace{}
+			assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(ns), current))
+			learned, ok := current.GetAnnotations()[servicemeshv1alpha1.SidecarLearnedEgressNamespacesAnnotation]
+			assert.Equal(t, ok, tc.expected != "")
+			assert.Equal(t, learned, tc.expected)
+			assert.Equal(t, len(recorder.Events), tc.events)
+		})
+	}
+}
+
+func TestNamespaceDependenciesCache(t *testing.T) {
+	t.Parallel()
+
+	var cache namespaceDependenciesCache
+	now := time.Now()
+	query := namespaceDependenciesQuery{
+		address:  "http://prometheus:9090",
+		lookback: time.Hour,
+	}
+	dependencies := map[string][]string{
+		"bookinfo": {"reviews"},
+	}
+
+	_, ok := cache.get("uid", query, now)
+	assert.Assert(t, !ok)
+
+	cache.set("uid", query, dependencies, now)
+
+	cached, ok := cache.get("uid", query, now.Add(time.Minute))
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, cached, dependencies)
+
+	_, ok = cache.get("uid", query, now.Add(sidecarLearningModeRequeueDuration))
+	assert.Assert(t, !ok, "the dependencies must be queried again after the interval")
+
+	_, ok = cache.get("uid", namespaceDependenciesQuery{address: query.address, lookback: time.Minute}, now)
+	assert.Assert(t, !ok, "the dependencies must be queried again when the query changes")
+
+	cache.forget("uid")
+	_, ok = cache.get("uid", query, now)
+	assert.Assert(t, !ok)
+}
+
+func TestGetDefaultSidecarControlPlanes(t *testing.T) {
+	t.Parallel()
+
+	icp := func(name string, enabled bool, namespaces ...string) client.Object {
+		return &servicemeshv1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "istio-system",
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				DefaultSidecar: &servicemeshv1alpha1.DefaultSidecarConfiguration{
+					Enabled: wrapperspb.Bool(enabled),
+				},
+			},
+			Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+				InjectionNamespaces: namespaces,
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, servicemeshv1alpha1.AddToScheme(scheme))
+
+	r := &IstioControlPlaneReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			icp("cp-v116x", true, "bookinfo"),
+			icp("cp-v117x", true, "payments"),
+			icp("cp-v118x", false, "bookinfo"),
+		).Build(),
+	}
+
+	sidecar := func(labels map[string]string) client.Object {
+		return &istionetworkingv1alpha3.Sidecar{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "user",
+				Namespace: "bookinfo",
+				Labels:    labels,
+			},
+		}
+	}
+
+	assert.DeepEqual(t, r.getDefaultSidecarControlPlanes(sidecar(nil)), []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: "cp-v116x", Namespace: "istio-system"}},
+	})
+	assert.Equal(t, len(r.getDefaultSidecarControlPlanes(sidecar(map[string]string{servicemeshv1alpha1.DefaultSidecarLabel: "true"}))), 0)
+}
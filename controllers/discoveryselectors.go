@@ -0,0 +1,73 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+const injectionNamespacesDiscoveredCondition = "InjectionNamespacesDiscovered"
+
+// discoverySelectors returns the selectors of the namespaces discovered by istiod, none if every namespace is discovered
+func discoverySelectors(icp *servicemeshv1alpha1.IstioControlPlane) ([]labels.Selector, error) {
+	selectors := make([]labels.Selector, 0, len(icp.GetSpec().GetDiscoverySelectors()))
+	for _, ls := range icp.GetSpec().GetDiscoverySelectors() {
+		selector, err := metav1.LabelSelectorAsSelector(ls)
+		if err != nil {
+			return nil, errors.WrapIf(err, "invalid discovery selector")
+		}
+		selectors = append(selectors, selector)
+	}
+
+	return selectors, nil
+}
+
+// namespaceDiscovered returns whether the namespace matches any of the discovery selectors
+func namespaceDiscovered(selectors []labels.Selector, ns *corev1.Namespace) bool {
+	if len(selectors) == 0 {
+		return true
+	}
+
+	for _, selector := range selectors {
+		if selector.Matches(labels.Set(ns.GetLabels())) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setDiscoveryCondition warns about the namespaces set for injection which are not discovered by istiod
+func setDiscoveryCondition(icp *servicemeshv1alpha1.IstioControlPlane, selectors []labels.Selector, undiscovered []string) {
+	switch {
+	case len(selectors) == 0:
+		removeCondition(icp, injectionNamespacesDiscoveredCondition)
+	case len(undiscovered) > 0:
+		setCondition(icp, injectionNamespacesDiscoveredCondition, metav1.ConditionFalse, "NamespacesOutsideDiscoverySelectors",
+			fmt.Sprintf("namespaces set for injection are outside the discovery selectors: %s", strings.Join(undiscovered, ", ")))
+	default:
+		setCondition(icp, injectionNamespacesDiscoveredCondition, metav1.ConditionTrue, "NamespacesDiscovered", "")
+	}
+}
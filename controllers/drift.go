@@ -0,0 +1,77 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// recordResourceDrifts emits an event on the owner for every drift which differs from the ones found by the previous
+// reconciliation, so repeated changes of the same fields by the same managers are reported once
+func recordResourceDrifts(recorder record.EventRecorder, owner runtime.Object, previous, current []*servicemeshv1alpha1.ResourceDrift) {
+	if recorder == nil {
+		return
+	}
+
+	reported := make(map[string]*servicemeshv1alpha1.ResourceDrift, len(previous))
+	for _, drift := range previous {
+		reported[resourceDriftKey(drift)] = drift
+	}
+
+	for _, drift := range current {
+		if p, ok := reported[resourceDriftKey(drift)]; ok && proto.Equal(p, drift) {
+			continue
+		}
+
+		managers := "unknown managers"
+		if len(drift.GetManagers()) > 0 {
+			managers = strings.Join(drift.GetManagers(), ", ")
+		}
+
+		recorder.Eventf(
+			owner,
+			corev1.EventTypeWarning,
+			"ResourceDrift",
+			"%s %s was changed by %s, fields: %s, action: %s",
+			drift.GetKind(),
+			resourceDriftObjectKey(drift),
+			managers,
+			strings.Join(drift.GetFields(), ", "),
+			drift.GetAction().String(),
+		)
+	}
+}
+
+func resourceDriftKey(drift *servicemeshv1alpha1.ResourceDrift) string {
+	return fmt.Sprintf("%s %s %s", drift.GetApiVersion(), drift.GetKind(), resourceDriftObjectKey(drift))
+}
+
+func resourceDriftObjectKey(drift *servicemeshv1alpha1.ResourceDrift) string {
+	if drift.GetNamespace() == "" {
+		return drift.GetName()
+	}
+
+	return drift.GetNamespace() + "/" + drift.GetName()
+}
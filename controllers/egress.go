@@ -0,0 +1,351 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	egressResourceName     = "egress"
+	egressGatewaySubset    = "egress"
+	meshGatewayName        = "mesh"
+	defaultEgressProtocol  = "TLS"
+	wildcardHostNamePrefix = "wildcard"
+)
+
+// reconcileEgress creates ServiceEntries for the allowed external hosts and, if an egress gateway is referenced,
+// the Gateway, DestinationRule and VirtualServices which route the traffic to these hosts through the egress gateway
+func (r *IstioControlPlaneReconciler) reconcileEgress(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if !isActive(icp) {
+		return nil
+	}
+
+	desired := make([]client.Object, 0)
+
+	config := icp.GetSpec().GetEgress()
+	if icp.DeletionTimestamp.IsZero() && config.GetEnabled().GetValue() {
+		var gateway *servicemeshv1alpha1.IstioMeshGateway
+		if config.GetGateway().GetName() != "" {
+			var err error
+			gateway, err = r.getEgressGateway(ctx, icp)
+			if err != nil {
+				return err
+			}
+		}
+
+		objects := getEgressResources(icp, gateway)
+		for _, object := range objects {
+			_, err := r.ResourceReconciler.ReconcileResource(object, reconciler.StatePresent)
+			if err != nil {
+				return errors.WrapIfWithDetails(err, "could not reconcile egress resource", "kind", object.GetObjectKind().GroupVersionKind().Kind, "name", object.GetName())
+			}
+		}
+		desired = append(desired, objects...)
+	}
+
+	return r.removeStaleEgressResources(ctx, icp, desired)
+}
+
+func (r *IstioControlPlaneReconciler) getEgressGateway(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (*servicemeshv1alpha1.IstioMeshGateway, error) {
+	key := client.ObjectKey{
+		Name:      icp.GetSpec().GetEgress().GetGateway().GetName(),
+		Namespace: icp.GetSpec().GetEgress().GetGateway().GetNamespace(),
+	}
+	if key.Namespace == "" {
+		key.Namespace = icp.GetNamespace()
+	}
+
+	gateway := &servicemeshv1alpha1.IstioMeshGateway{}
+	err := r.GetClient().Get(ctx, key, gateway)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get egress gateway", "name", key.Name, "namespace", key.Namespace)
+	}
+
+	if gateway.GetSpec().GetType() != servicemeshv1alpha1.GatewayType_egress {
+		return nil, errors.NewWithDetails("referenced mesh gateway is not an egress gateway", "name", key.Name, "namespace", key.Namespace)
+	}
+
+	return gateway, nil
+}
+
+func (r *IstioControlPlaneReconciler) removeStaleEgressResources(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, desired []client.Object) error {
+	keep := make(map[string]struct{}, len(desired))
+	for _, object := range desired {
+		keep[resourceKindAndName(object)] = struct{}{}
+	}
+
+	labels := utils.MergeLabels(icp.RevisionLabels(), map[string]string{
+		servicemeshv1alpha1.EgressLabel: "true",
+	})
+
+	current := make([]client.Object, 0)
+
+	serviceEntries := &istionetworkingv1alpha3.ServiceEntryList{}
+	gateways := &istionetworkingv1alpha3.GatewayList{}
+	destinationRules := &istionetworkingv1alpha3.DestinationRuleList{}
+	virtualServices := &istionetworkingv1alpha3.VirtualServiceList{}
+	for _, list := range []client.ObjectList{serviceEntries, gateways, destinationRules, virtualServices} {
+		err := r.GetClient().List(ctx, list, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(labels))
+		if err != nil {
+			return errors.WrapIf(err, "could not list egress resources")
+		}
+	}
+	for _, object := range serviceEntries.Items {
+		current = append(current, withTypeMeta(object, "ServiceEntry"))
+	}
+	for _, object := range gateways.Items {
+		current = append(current, withTypeMeta(object, "Gateway"))
+	}
+	for _, object := range destinationRules.Items {
+		current = append(current, withTypeMeta(object, "DestinationRule"))
+	}
+	for _, object := range virtualServices.Items {
+		current = append(current, withTypeMeta(object, "VirtualService"))
+	}
+
+	for _, object := range current {
+		if _, ok := keep[resourceKindAndName(object)]; ok {
+			continue
+		}
+
+		_, err := r.ResourceReconciler.ReconcileResource(object, reconciler.StateAbsent)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not remove egress resource", "kind", object.GetObjectKind().GroupVersionKind().Kind, "name", object.GetName())
+		}
+	}
+
+	return nil
+}
+
+func withTypeMeta(object client.Object, kind string) client.Object {
+	object.GetObjectKind().SetGroupVersionKind(istionetworkingv1alpha3.SchemeGroupVersion.WithKind(kind))
+
+	return object
+}
+
+func resourceKindAndName(object client.Object) string {
+	return object.GetObjectKind().GroupVersionKind().Kind + "/" + object.GetName()
+}
+
+func getEgressResources(icp *servicemeshv1alpha1.IstioControlPlane, gateway *servicemeshv1alpha1.IstioMeshGateway) []client.Object {
+	objects := make([]client.Object, 0)
+
+	servers := make([]*networkingv1alpha3.Server, 0)
+	serversByPortName := make(map[string]*networkingv1alpha3.Server)
+	for _, host := range icp.GetSpec().GetEgress().GetHosts() {
+		protocol := host.GetProtocol()
+		if protocol == "" {
+			protocol = defaultEgressProtocol
+		}
+
+		se := &istionetworkingv1alpha3.ServiceEntry{
+			ObjectMeta: getEgressObjectMeta(icp, getEgressHostResourceName(host.GetHost(), host.GetPort())),
+		}
+		se.Spec.Hosts = []string{host.GetHost()}
+		se.Spec.Ports = []*networkingv1alpha3.ServicePort{
+			{
+				Number:   host.GetPort(),
+				Protocol: protocol,
+				Name:     getEgressPortName(protocol, host.GetPort()),
+			},
+		}
+		se.Spec.Location = networkingv1alpha3.ServiceEntry_MESH_EXTERNAL
+		se.Spec.Resolution = networkingv1alpha3.ServiceEntry_DNS
+		if isWildcardHost(host.GetHost()) {
+			// DNS resolution is not supported for wildcard hosts
+			se.Spec.Resolution = networkingv1alpha3.ServiceEntry_NONE
+		}
+		objects = append(objects, withTypeMeta(se, "ServiceEntry"))
+
+		if gateway == nil || isWildcardHost(host.GetHost()) {
+			continue
+		}
+
+		// the hosts sharing a port and protocol are served by the same server, as port names must be unique
+		portName := getEgressPortName(protocol, host.GetPort())
+		if server, ok := serversByPortName[portName]; ok {
+			server.Hosts = append(server.Hosts, host.GetHost())
+		} else {
+			server := &networkingv1alpha3.Server{
+				Port: &networkingv1alpha3.Port{
+					Number:   host.GetPort(),
+					Protocol: protocol,
+					Name:     portName,
+				},
+				Hosts: []string{host.GetHost()},
+			}
+			if isTLSProtocol(protocol) {
+				server.Tls = &networkingv1alpha3.ServerTLSSettings{
+					Mode: networkingv1alpha3.ServerTLSSettings_PASSTHROUGH,
+				}
+			}
+			serversByPortName[portName] = server
+			servers = append(servers, server)
+		}
+
+		objects = append(objects, getEgressVirtualService(icp, gateway, host.GetHost(), host.GetPort(), protocol))
+	}
+
+	if len(servers) == 0 {
+		return objects
+	}
+
+	gw := &istionetworkingv1alpha3.Gateway{
+		ObjectMeta: getEgressObjectMeta(icp, egressResourceName),
+	}
+	gw.Spec.Selector = getEgressGatewaySelector(gateway)
+	gw.Spec.Servers = servers
+	objects = append(objects, withTypeMeta(gw, "Gateway"))
+
+	dr := &istionetworkingv1alpha3.DestinationRule{
+		ObjectMeta: getEgressObjectMeta(icp, egressResourceName),
+	}
+	dr.Spec.Host = getEgressGatewayHost(icp, gateway)
+	dr.Spec.Subsets = []*networkingv1alpha3.Subset{
+		{
+			Name:   egressGatewaySubset,
+			Labels: getEgressGatewaySelector(gateway),
+		},
+	}
+	objects = append(objects, withTypeMeta(dr, "DestinationRule"))
+
+	return objects
+}
+
+// getEgressVirtualService routes the traffic of the host from the sidecars to the egress gateway
+// and from the egress gateway to the external host
+func getEgressVirtualService(icp *servicemeshv1alpha1.IstioControlPlane, gateway *servicemeshv1alpha1.IstioMeshGateway, host string, port uint32, protocol string) client.Object {
+	gatewayName := icp.GetNamespace() + "/" + icp.WithRevision(egressResourceName)
+
+	toGateway := &networkingv1alpha3.Destination{
+		Host:   getEgressGatewayHost(icp, gateway),
+		Subset: egressGatewaySubset,
+		Port: &networkingv1alpha3.PortSelector{
+			Number: port,
+		},
+	}
+	toHost := &networkingv1alpha3.Destination{
+		Host: host,
+		Port: &networkingv1alpha3.PortSelector{
+			Number: port,
+		},
+	}
+
+	vs := &istionetworkingv1alpha3.VirtualService{
+		ObjectMeta: getEgressObjectMeta(icp, getEgressHostResourceName(host, port)),
+	}
+	vs.Spec.Hosts = []string{host}
+	vs.Spec.Gateways = []string{meshGatewayName, gatewayName}
+
+	switch {
+	case protocol == "HTTP":
+		vs.Spec.Http = []*networkingv1alpha3.HTTPRoute{
+			{
+				Match: []*networkingv1alpha3.HTTPMatchRequest{{Gateways: []string{meshGatewayName}, Port: port}},
+				Route: []*networkingv1alpha3.HTTPRouteDestination{{Destination: toGateway}},
+			},
+			{
+				Match: []*networkingv1alpha3.HTTPMatchRequest{{Gateways: []string{gatewayName}, Port: port}},
+				Route: []*networkingv1alpha3.HTTPRouteDestination{{Destination: toHost}},
+			},
+		}
+	case isTLSProtocol(protocol):
+		vs.Spec.Tls = []*networkingv1alpha3.TLSRoute{
+			{
+				Match: []*networkingv1alpha3.TLSMatchAttributes{{Gateways: []string{meshGatewayName}, Port: port, SniHosts: []string{host}}},
+				Route: []*networkingv1alpha3.RouteDestination{{Destination: toGateway}},
+			},
+			{
+				Match: []*networkingv1alpha3.TLSMatchAttributes{{Gateways: []string{gatewayName}, Port: port, SniHosts: []string{host}}},
+				Route: []*networkingv1alpha3.RouteDestination{{Destination: toHost}},
+			},
+		}
+	default:
+		vs.Spec.Tcp = []*networkingv1alpha3.TCPRoute{
+			{
+				Match: []*networkingv1alpha3.L4MatchAttributes{{Gateways: []string{meshGatewayName}, Port: port}},
+				Route: []*networkingv1alpha3.RouteDestination{{Destination: toGateway}},
+			},
+			{
+				Match: []*networkingv1alpha3.L4MatchAttributes{{Gateways: []string{gatewayName}, Port: port}},
+				Route: []*networkingv1alpha3.RouteDestination{{Destination: toHost}},
+			},
+		}
+	}
+
+	return withTypeMeta(vs, "VirtualService")
+}
+
+func getEgressObjectMeta(icp *servicemeshv1alpha1.IstioControlPlane, name string) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:      icp.WithRevision(name),
+		Namespace: icp.GetNamespace(),
+	}
+	k8sutil.SetICPMetadataOnObject(&meta, icp)
+	meta.SetLabels(utils.MergeLabels(meta.GetLabels(), map[string]string{
+		servicemeshv1alpha1.EgressLabel: "true",
+	}))
+
+	return meta
+}
+
+// getEgressGatewaySelector returns the labels of the egress gateway pods
+func getEgressGatewaySelector(gateway *servicemeshv1alpha1.IstioMeshGateway) map[string]string {
+	return map[string]string{
+		"gateway-name": gateway.GetName(),
+		"gateway-type": servicemeshv1alpha1.GatewayType_egress.String(),
+	}
+}
+
+func getEgressGatewayHost(icp *servicemeshv1alpha1.IstioControlPlane, gateway *servicemeshv1alpha1.IstioMeshGateway) string {
+	return fmt.Sprintf("%s.%s.svc.%s", gateway.GetName(), gateway.GetNamespace(), getClusterDomain(icp))
+}
+
+func getEgressHostResourceName(host string, port uint32) string {
+	name := strings.ToLower(host)
+	if isWildcardHost(name) {
+		name = wildcardHostNamePrefix + strings.TrimPrefix(name, "*")
+	}
+
+	return fmt.Sprintf("%s-%s-%d", egressResourceName, name, port)
+}
+
+func getEgressPortName(protocol string, port uint32) string {
+	return fmt.Sprintf("%s-%d", strings.ToLower(protocol), port)
+}
+
+func isWildcardHost(host string) bool {
+	return strings.HasPrefix(host, "*")
+}
+
+func isTLSProtocol(protocol string) bool {
+	return protocol == "TLS" || protocol == "HTTPS"
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestGetEgressResources(t *testing.T) {
+	t.Parallel()
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x",
+			Namespace: "istio-system",
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Egress: &servicemeshv1alpha1.EgressConfiguration{
+				Hosts: []*servicemeshv1alpha1.EgressHost{
+					{Host: "www.example.com", Port: 443},
+					{Host: "api.example.com", Port: 80, Protocol: "HTTP"},
+					{Host: "db.example.com", Port: 5432, Protocol: "TCP"},
+					{Host: "*.example.org", Port: 443},
+				},
+			},
+		},
+	}
+	gateway := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "egress-gw",
+			Namespace: "egress",
+		},
+		Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+			Type: servicemeshv1alpha1.GatewayType_egress,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		gateway *servicemeshv1alpha1.IstioMeshGateway
+		objects []string
+	}{
+		{
+			name: "without gateway",
+			objects: []string{
+				"ServiceEntry/egress-www.example.com-443-cp-v117x",
+				"ServiceEntry/egress-api.example.com-80-cp-v117x",
+				"ServiceEntry/egress-db.example.com-5432-cp-v117x",
+				"ServiceEntry/egress-wildcard.example.org-443-cp-v117x",
+			},
+		},
+		{
+			name:    "with gateway",
+			gateway: gateway,
+			objects: []string{
+				"ServiceEntry/egress-www.example.com-443-cp-v117x",
+				"VirtualService/egress-www.example.com-443-cp-v117x",
+				"ServiceEntry/egress-api.example.com-80-cp-v117x",
+				"VirtualService/egress-api.example.com-80-cp-v117x",
+				"ServiceEntry/egress-db.example.com-5432-cp-v117x",
+				"VirtualService/egress-db.example.com-5432-cp-v117x",
+				"ServiceEntry/egress-wildcard.example.org-443-cp-v117x",
+				"Gateway/egress-cp-v117x",
+				"DestinationRule/egress-cp-v117x",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			objects := getEgressResources(icp, tc.gateway)
+
+			names := make([]string, 0, len(objects))
+			for _, object := range objects {
+				names = append(names, resourceKindAndName(object))
+				assert.Equal(t, object.GetNamespace(), icp.GetNamespace())
+				assert.Equal(t, object.GetLabels()[servicemeshv1alpha1.EgressLabel], "true")
+			}
+			assert.DeepEqual(t, names, tc.objects)
+		})
+	}
+}
+
+func TestGetEgressResourcesRouting(t *testing.T) {
+	t.Parallel()
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x",
+			Namespace: "istio-system",
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Egress: &servicemeshv1alpha1.EgressConfiguration{
+				Hosts: []*servicemeshv1alpha1.EgressHost{
+					{Host: "www.example.com", Port: 443},
+					{Host: "*.example.org", Port: 443},
+					{Host: "api.example.com", Port: 443},
+				},
+			},
+		},
+	}
+	gateway := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "egress-gw",
+			Namespace: "egress",
+		},
+	}
+	selector := map[string]string{
+		"gateway-name": "egress-gw",
+		"gateway-type": "egress",
+	}
+
+	objects := make(map[string]client.Object)
+	for _, object := range getEgressResources(icp, gateway) {
+		objects[resourceKindAndName(object)] = object
+	}
+
+	se, ok := objects["ServiceEntry/egress-wildcard.example.org-443-cp-v117x"].(*istionetworkingv1alpha3.ServiceEntry)
+	assert.Assert(t, ok)
+	assert.Equal(t, se.Spec.GetResolution(), networkingv1alpha3.ServiceEntry_NONE)
+
+	gw, ok := objects["Gateway/egress-cp-v117x"].(*istionetworkingv1alpha3.Gateway)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, gw.Spec.GetSelector(), selector)
+	// the hosts sharing a port and protocol are served by the same server, as port names must be unique
+	assert.Equal(t, len(gw.Spec.GetServers()), 1)
+	assert.DeepEqual(t, gw.Spec.GetServers()[0].GetHosts(), []string{"www.example.com", "api.example.com"})
+	assert.Equal(t, gw.Spec.GetServers()[0].GetPort().GetName(), "tls-443")
+	assert.Equal(t, gw.Spec.GetServers()[0].GetTls().GetMode(), networkingv1alpha3.ServerTLSSettings_PASSTHROUGH)
+	for _, name := range []string{"VirtualService/egress-www.example.com-443-cp-v117x", "VirtualService/egress-api.example.com-443-cp-v117x"} {
+		_, ok := objects[name]
+		assert.Assert(t, ok, "%s is missing", name)
+	}
+
+	dr, ok := objects["DestinationRule/egress-cp-v117x"].(*istionetworkingv1alpha3.DestinationRule)
+	assert.Assert(t, ok)
+	assert.Equal(t, dr.Spec.GetHost(), "egress-gw.egress.svc.cluster.local")
+	assert.Equal(t, len(dr.Spec.GetSubsets()), 1)
+	assert.Equal(t, dr.Spec.GetSubsets()[0].GetName(), egressGatewaySubset)
+	assert.DeepEqual(t, dr.Spec.GetSubsets()[0].GetLabels(), selector)
+
+	vs, ok := objects["VirtualService/egress-www.example.com-443-cp-v117x"].(*istionetworkingv1alpha3.VirtualService)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, vs.Spec.GetGateways(), []string{meshGatewayName, "istio-system/egress-cp-v117x"})
+	assert.Equal(t, len(vs.Spec.GetTls()), 2)
+	assert.Equal(t, vs.Spec.GetTls()[0].GetRoute()[0].GetDestination().GetHost(), dr.Spec.GetHost())
+	assert.Equal(t, vs.Spec.GetTls()[0].GetRoute()[0].GetDestination().GetSubset(), egressGatewaySubset)
+	assert.Equal(t, vs.Spec.GetTls()[1].GetRoute()[0].GetDestination().GetHost(), "www.example.com")
+}
+
+func TestGetEgressVirtualService(t *testing.T) {
+	t.Parallel()
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x",
+			Namespace: "istio-system",
+		},
+	}
+	gateway := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "egress-gw",
+			Namespace: "egress",
+		},
+	}
+
+	testCases := []struct {
+		protocol string
+		http     int
+		tls      int
+		tcp      int
+	}{
+		{protocol: "HTTP", http: 2},
+		{protocol: "HTTPS", tls: 2},
+		{protocol: "TLS", tls: 2},
+		{protocol: "TCP", tcp: 2},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.protocol, func(t *testing.T) {
+			t.Parallel()
+
+			vs, ok := getEgressVirtualService(icp, gateway, "www.example.com", 8443, tc.protocol).(*istionetworkingv1alpha3.VirtualService)
+			assert.Assert(t, ok)
+			assert.Equal(t, len(vs.Spec.GetHttp()), tc.http)
+			assert.Equal(t, len(vs.Spec.GetTls()), tc.tls)
+			assert.Equal(t, len(vs.Spec.GetTcp()), tc.tcp)
+		})
+	}
+}
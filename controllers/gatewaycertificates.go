@@ -0,0 +1,540 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/istio-operator/v2/internal/util/acme"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+const (
+	certificateIssuerCertManager = "cert-manager"
+	certificateIssuerACME        = "acme"
+
+	certificateStatePending = "Pending"
+	certificateStateIssued  = "Issued"
+	certificateStateFailed  = "Failed"
+
+	defaultCertificateRenewBefore     = time.Hour * 24 * 30
+	certificatePendingRequeueDuration = time.Second * 10
+	certificateFailedRequeueDuration  = time.Minute * 5
+
+	acmeAccountKeySecretKey = "account.key"
+	acmeChallengePort       = 80
+)
+
+var certManagerCertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+// reconcileGatewayCertificates issues and renews the certificates of the servers of the generated Gateway
+// and returns the duration after which the certificates need to be checked again
+func (r *IstioMeshGatewayReconciler) reconcileGatewayCertificates(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (time.Duration, error) {
+	if !imgw.DeletionTimestamp.IsZero() {
+		return 0, nil
+	}
+
+	previous := make(map[string]*servicemeshv1alpha1.GatewayCertificateStatus)
+	for _, certificateStatus := range imgw.GetStatus().GetCertificates() {
+		previous[certificateStatus.GetSecretName()] = certificateStatus
+	}
+
+	certificates := make([]*servicemeshv1alpha1.GatewayCertificateStatus, 0)
+	desired := make([]client.Object, 0)
+	var requeueAfter time.Duration
+
+	for _, server := range imgw.GetSpec().GetServers() {
+		certificate := server.GetTls().GetCertificate()
+		if certificate == nil {
+			continue
+		}
+
+		certificateStatus := &servicemeshv1alpha1.GatewayCertificateStatus{
+			SecretName: server.GetTls().GetCredentialName(),
+			Hosts:      getCertificateHosts(server.GetHosts()),
+		}
+
+		var objects []client.Object
+		var after time.Duration
+		var err error
+		switch {
+		case certificateStatus.SecretName == "":
+			err = errors.NewPlain("credentialName must be set for the certificate to be issued")
+		case certificate.GetIssuerRef() != nil && certificate.GetAcme() != nil:
+			err = errors.NewPlain("only one of issuerRef and acme can be set")
+		case certificate.GetIssuerRef() != nil:
+			certificateStatus.Issuer = certificateIssuerCertManager
+			objects, after, err = r.reconcileCertManagerCertificate(ctx, imgw, certificate, certificateStatus)
+		case certificate.GetAcme() != nil:
+			certificateStatus.Issuer = certificateIssuerACME
+			objects, after, err = r.reconcileACMECertificate(ctx, imgw, icp, certificate, certificateStatus, previous[certificateStatus.GetSecretName()])
+		default:
+			err = errors.NewPlain("either issuerRef or acme must be set")
+		}
+		if err != nil {
+			logger.Error(err, "could not issue gateway certificate", "secret", certificateStatus.GetSecretName())
+			certificateStatus.State = certificateStateFailed
+			certificateStatus.Message = err.Error()
+			certificateStatus.OrderURL = ""
+			after = certificateFailedRequeueDuration
+		}
+
+		desired = append(desired, objects...)
+		if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+			requeueAfter = after
+		}
+		certificates = append(certificates, certificateStatus)
+	}
+
+	imgw.GetStatus().Certificates = certificates
+
+	return requeueAfter, r.removeStaleCertificateResources(ctx, imgw, desired)
+}
+
+// reconcileCertManagerCertificate creates a cert-manager Certificate for the credential Secret of a server
+func (r *IstioMeshGatewayReconciler) reconcileCertManagerCertificate(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, certificate *servicemeshv1alpha1.GatewayCertificate, certificateStatus *servicemeshv1alpha1.GatewayCertificateStatus) ([]client.Object, time.Duration, error) {
+	issuerRef := certificate.GetIssuerRef()
+
+	kind := issuerRef.GetKind()
+	if kind == "" {
+		kind = "Issuer"
+	}
+	group := issuerRef.GetGroup()
+	if group == "" {
+		group = certManagerCertificateGVK.Group
+	}
+
+	dnsNames := make([]interface{}, 0, len(certificateStatus.GetHosts()))
+	for _, host := range certificateStatus.GetHosts() {
+		dnsNames = append(dnsNames, host)
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(certManagerCertificateGVK)
+	object.SetName(certificateStatus.GetSecretName())
+	object.SetNamespace(imgw.GetNamespace())
+	object.SetLabels(map[string]string{
+		servicemeshv1alpha1.GatewayCertificateLabel: imgw.GetName(),
+	})
+	object.Object["spec"] = map[string]interface{}{
+		"secretName":  certificateStatus.GetSecretName(),
+		"dnsNames":    dnsNames,
+		"renewBefore": getCertificateRenewBefore(certificate).String(),
+		"issuerRef": map[string]interface{}{
+			"name":  issuerRef.GetName(),
+			"kind":  kind,
+			"group": group,
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(imgw, object, r.GetScheme()); err != nil {
+		return nil, 0, errors.WrapIf(err, "could not set owner reference on certificate")
+	}
+
+	_, err := r.ResourceReconciler.ReconcileResource(object, reconciler.StatePresent)
+	if meta.IsNoMatchError(errors.Cause(err)) {
+		return nil, 0, errors.NewPlain("cert-manager is not installed")
+	}
+	if err != nil {
+		return nil, 0, errors.WrapIf(err, "could not reconcile cert-manager certificate")
+	}
+
+	x509Certificate, err := r.getCredentialCertificate(ctx, imgw, certificateStatus.GetSecretName())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if x509Certificate == nil || !certificateCoversHosts(x509Certificate, certificateStatus.GetHosts()) {
+		certificateStatus.State = certificateStatePending
+		certificateStatus.Message = "waiting for cert-manager to issue the certificate"
+
+		return []client.Object{object}, certificatePendingRequeueDuration, nil
+	}
+
+	certificateStatus.State = certificateStateIssued
+	certificateStatus.NotAfter = x509Certificate.NotAfter.UTC().Format(time.RFC3339)
+
+	// cert-manager renews the certificate, its expiry is refreshed once the renewal is due
+	return []client.Object{object}, time.Until(x509Certificate.NotAfter.Add(-getCertificateRenewBefore(certificate))), nil
+}
+
+// reconcileACMECertificate requests the certificate of a server from an ACME server if the credential Secret holds
+// no valid certificate for the hosts of the server or if it is due for renewal
+func (r *IstioMeshGatewayReconciler) reconcileACMECertificate(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, certificate *servicemeshv1alpha1.GatewayCertificate, certificateStatus *servicemeshv1alpha1.GatewayCertificateStatus, previous *servicemeshv1alpha1.GatewayCertificateStatus) ([]client.Object, time.Duration, error) {
+	renewBefore := getCertificateRenewBefore(certificate)
+
+	for _, host := range certificateStatus.GetHosts() {
+		if strings.HasPrefix(host, "*") {
+			return nil, 0, errors.NewWithDetails("wildcard hosts can not be validated with HTTP-01 challenges", "host", host)
+		}
+	}
+
+	if icp.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		return nil, 0, errors.NewPlain("ACME challenges can only be answered by gateways of ACTIVE control planes")
+	}
+
+	for _, host := range certificateStatus.GetHosts() {
+		if !hasACMEChallengeServer(imgw, host) {
+			return nil, 0, errors.NewWithDetails("a plain HTTP server without httpsRedirect is required on port 80 to answer HTTP-01 challenges", "host", host)
+		}
+	}
+
+	x509Certificate, err := r.getCredentialCertificate(ctx, imgw, certificateStatus.GetSecretName())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if x509Certificate != nil && certificateCoversHosts(x509Certificate, certificateStatus.GetHosts()) {
+		certificateStatus.NotAfter = x509Certificate.NotAfter.UTC().Format(time.RFC3339)
+
+		renewAt := x509Certificate.NotAfter.Add(-renewBefore)
+		if time.Now().Before(renewAt) {
+			certificateStatus.State = certificateStateIssued
+
+			return nil, time.Until(renewAt), nil
+		}
+	}
+
+	accountKey, err := r.getACMEAccountKey(ctx, imgw, certificate.GetAcme())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	acmeClient := acme.NewClient(certificate.GetAcme().GetDirectoryURL(), accountKey, certificate.GetAcme().GetInsecureSkipTLSVerify())
+	if err := acmeClient.Register(ctx, certificate.GetAcme().GetEmail()); err != nil {
+		return nil, 0, err
+	}
+
+	// challenges of an order created by a previous reconciliation are already served by the gateway
+	orderURL := previous.GetOrderURL()
+	order, err := acmeClient.ProcessOrder(ctx, orderURL, certificateStatus.GetHosts(), orderURL != "")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if order.CertificateChain != nil {
+		x509Certificate, err := r.setCredentialSecret(imgw, certificateStatus.GetSecretName(), order.CertificateChain, order.PrivateKey)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		certificateStatus.State = certificateStateIssued
+		certificateStatus.NotAfter = x509Certificate.NotAfter.UTC().Format(time.RFC3339)
+
+		return nil, time.Until(x509Certificate.NotAfter.Add(-renewBefore)), nil
+	}
+
+	certificateStatus.State = certificateStatePending
+	certificateStatus.Message = "waiting for the ACME order to be validated"
+	certificateStatus.OrderURL = order.URL
+
+	if len(order.Challenges) == 0 {
+		return nil, certificatePendingRequeueDuration, nil
+	}
+
+	vs := getACMEChallengeVirtualService(imgw, certificateStatus.GetSecretName(), order.Challenges)
+	if err := controllerutil.SetControllerReference(imgw, vs, r.GetScheme()); err != nil {
+		return nil, 0, errors.WrapIf(err, "could not set owner reference on ACME challenge virtual service")
+	}
+
+	_, err = r.ResourceReconciler.ReconcileResource(vs, reconciler.StatePresent)
+	if err != nil {
+		return nil, 0, errors.WrapIf(err, "could not reconcile ACME challenge virtual service")
+	}
+
+	return []client.Object{vs}, certificatePendingRequeueDuration, nil
+}
+
+// getACMEAccountKey returns the private key of the ACME account, which is generated if its Secret does not exist
+func (r *IstioMeshGatewayReconciler) getACMEAccountKey(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, issuer *servicemeshv1alpha1.ACMEIssuer) (crypto.Signer, error) {
+	name := issuer.GetAccountKeySecretName()
+	if name == "" {
+		name = imgw.GetName() + "-acme-account"
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: imgw.GetNamespace()}, secret)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.WrapIfWithDetails(err, "could not get ACME account key secret", "name", name)
+	}
+
+	if err == nil {
+		key, err := acme.ParseKey(secret.Data[acmeAccountKeySecretKey])
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid ACME account key secret", "name", name)
+		}
+
+		return key, nil
+	}
+
+	key, keyPEM, err := acme.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// the account key is not owned by the gateway, so that the account is kept when the gateway is recreated
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: imgw.GetNamespace(),
+		},
+		Data: map[string][]byte{
+			acmeAccountKeySecretKey: keyPEM,
+		},
+	}
+	if err := r.Create(ctx, secret); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not create ACME account key secret", "name", name)
+	}
+
+	return key, nil
+}
+
+// getCredentialCertificate returns the leaf certificate stored in the credential Secret, or nil if there is none
+func (r *IstioMeshGatewayReconciler) getCredentialCertificate(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, name string) (*x509.Certificate, error) {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: imgw.GetNamespace()}, secret)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get credential secret", "name", name)
+	}
+
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return nil, nil
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil //nolint:nilerr
+	}
+
+	return certificate, nil
+}
+
+func (r *IstioMeshGatewayReconciler) setCredentialSecret(imgw *servicemeshv1alpha1.IstioMeshGateway, name string, certificateChain []byte, privateKey []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certificateChain)
+	if block == nil {
+		return nil, errors.NewPlain("issued certificate chain is empty")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not parse issued certificate")
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: imgw.GetNamespace(),
+			Labels: map[string]string{
+				servicemeshv1alpha1.GatewayCertificateLabel: imgw.GetName(),
+			},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certificateChain,
+			corev1.TLSPrivateKeyKey: privateKey,
+		},
+	}
+	if err := controllerutil.SetControllerReference(imgw, secret, r.GetScheme()); err != nil {
+		return nil, errors.WrapIf(err, "could not set owner reference on credential secret")
+	}
+
+	_, err = r.ResourceReconciler.ReconcileResource(secret, reconciler.StatePresent)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not reconcile credential secret", "name", name)
+	}
+
+	return certificate, nil
+}
+
+// removeStaleCertificateResources removes the cert-manager Certificates and ACME challenge VirtualServices of the gateway
+// which are not needed anymore
+func (r *IstioMeshGatewayReconciler) removeStaleCertificateResources(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, desired []client.Object) error {
+	keep := make(map[string]struct{}, len(desired))
+	for _, object := range desired {
+		keep[resourceKindAndName(object)] = struct{}{}
+	}
+
+	current := make([]client.Object, 0)
+
+	certificates := &unstructured.UnstructuredList{}
+	certificates.SetGroupVersionKind(certManagerCertificateGVK.GroupVersion().WithKind("CertificateList"))
+	err := r.List(ctx, certificates, client.InNamespace(imgw.GetNamespace()), client.MatchingLabels{
+		servicemeshv1alpha1.GatewayCertificateLabel: imgw.GetName(),
+	})
+	if err != nil && !meta.IsNoMatchError(err) {
+		return errors.WrapIf(err, "could not list cert-manager certificates")
+	}
+	for i := range certificates.Items {
+		certificates.Items[i].SetGroupVersionKind(certManagerCertificateGVK)
+		current = append(current, &certificates.Items[i])
+	}
+
+	virtualServices := &istionetworkingv1alpha3.VirtualServiceList{}
+	err = r.List(ctx, virtualServices, client.InNamespace(imgw.GetNamespace()), client.MatchingLabels{
+		servicemeshv1alpha1.ACMEChallengeLabel: imgw.GetName(),
+	})
+	if err != nil && !meta.IsNoMatchError(err) {
+		return errors.WrapIf(err, "could not list ACME challenge virtual services")
+	}
+	for _, object := range virtualServices.Items {
+		current = append(current, withTypeMeta(object, "VirtualService"))
+	}
+
+	for _, object := range current {
+		if _, ok := keep[resourceKindAndName(object)]; ok {
+			continue
+		}
+
+		_, err := r.ResourceReconciler.ReconcileResource(object, reconciler.StateAbsent)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not remove certificate resource", "kind", object.GetObjectKind().GroupVersionKind().Kind, "name", object.GetName())
+		}
+	}
+
+	return nil
+}
+
+// getACMEChallengeVirtualService returns a VirtualService which answers the HTTP-01 challenges on the generated Gateway
+func getACMEChallengeVirtualService(imgw *servicemeshv1alpha1.IstioMeshGateway, secretName string, challenges []acme.HTTP01Challenge) *istionetworkingv1alpha3.VirtualService {
+	vs := &istionetworkingv1alpha3.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-acme-%s", imgw.GetName(), secretName),
+			Namespace: imgw.GetNamespace(),
+			Labels: map[string]string{
+				servicemeshv1alpha1.ACMEChallengeLabel: imgw.GetName(),
+			},
+		},
+	}
+	vs.Spec.Gateways = []string{imgw.GetName()}
+
+	for _, challenge := range challenges {
+		vs.Spec.Hosts = append(vs.Spec.Hosts, challenge.Host)
+		vs.Spec.Http = append(vs.Spec.Http, &networkingv1alpha3.HTTPRoute{
+			Match: []*networkingv1alpha3.HTTPMatchRequest{
+				{
+					Uri:       &networkingv1alpha3.StringMatch{MatchType: &networkingv1alpha3.StringMatch_Exact{Exact: challenge.Path}},
+					Authority: &networkingv1alpha3.StringMatch{MatchType: &networkingv1alpha3.StringMatch_Exact{Exact: challenge.Host}},
+				},
+			},
+			DirectResponse: &networkingv1alpha3.HTTPDirectResponse{
+				Status: 200,
+				Body: &networkingv1alpha3.HTTPBody{
+					Specifier: &networkingv1alpha3.HTTPBody_String_{String_: challenge.Response},
+				},
+			},
+		})
+	}
+
+	return withTypeMeta(vs, "VirtualService").(*istionetworkingv1alpha3.VirtualService)
+}
+
+// getCertificateHosts returns the host names of the Gateway hosts, without their namespace prefix
+func getCertificateHosts(hosts []string) []string {
+	result := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if i := strings.Index(host, "/"); i >= 0 {
+			host = host[i+1:]
+		}
+		result = append(result, host)
+	}
+
+	return uniqueSortedStrings(result)
+}
+
+// hasACMEChallengeServer checks whether the generated Gateway has a plain HTTP server on port 80 for the host, which
+// serves the HTTP-01 challenges instead of redirecting them to HTTPS
+func hasACMEChallengeServer(imgw *servicemeshv1alpha1.IstioMeshGateway, host string) bool {
+	for _, server := range imgw.GetSpec().GetServers() {
+		if server.GetPort() != acmeChallengePort || server.GetTls().GetHttpsRedirect() {
+			continue
+		}
+		if protocol := server.GetProtocol(); protocol != "" && !strings.EqualFold(protocol, "HTTP") {
+			continue
+		}
+
+		for _, serverHost := range server.GetHosts() {
+			if gatewayHostsOverlap(serverHost, host) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func getCertificateRenewBefore(certificate *servicemeshv1alpha1.GatewayCertificate) time.Duration {
+	if d := certificate.GetRenewBefore(); d != nil {
+		return d.AsDuration()
+	}
+
+	return defaultCertificateRenewBefore
+}
+
+func certificateCoversHosts(certificate *x509.Certificate, hosts []string) bool {
+	if time.Now().After(certificate.NotAfter) {
+		return false
+	}
+
+	for _, host := range hosts {
+		if strings.HasPrefix(host, "*.") {
+			if !util.ContainsString(certificate.DNSNames, host) {
+				return false
+			}
+
+			continue
+		}
+
+		if certificate.VerifyHostname(host) != nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestHasACMEChallengeServer(t *testing.T) {
+	t.Parallel()
+
+	gateway := func(servers ...*servicemeshv1alpha1.GatewayServer) *servicemeshv1alpha1.IstioMeshGateway {
+		return &servicemeshv1alpha1.IstioMeshGateway{
+			Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+				Servers: servers,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		imgw     *servicemeshv1alpha1.IstioMeshGateway
+		host     string
+		expected bool
+	}{
+		{
+			name:     "no servers",
+			imgw:     gateway(),
+			host:     "example.com",
+			expected: false,
+		},
+		{
+			name: "plain HTTP server",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"example.com"},
+				Port:  80,
+			}),
+			host:     "example.com",
+			expected: true,
+		},
+		{
+			name: "plain HTTP server with namespaced wildcard host",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts:    []string{"default/*.example.com"},
+				Port:     80,
+				Protocol: "HTTP",
+			}),
+			host:     "www.example.com",
+			expected: true,
+		},
+		{
+			name: "HTTPS redirect",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"example.com"},
+				Port:  80,
+				Tls: &servicemeshv1alpha1.GatewayServerTLS{
+					HttpsRedirect: true,
+				},
+			}),
+			host:     "example.com",
+			expected: false,
+		},
+		{
+			name: "HTTPS redirect next to a plain HTTP server",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"*"},
+				Port:  80,
+				Tls: &servicemeshv1alpha1.GatewayServerTLS{
+					HttpsRedirect: true,
+				},
+			}, &servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"example.com"},
+				Port:  80,
+			}),
+			host:     "example.com",
+			expected: true,
+		},
+		{
+			name: "other port",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"example.com"},
+				Port:  8080,
+			}),
+			host:     "example.com",
+			expected: false,
+		},
+		{
+			name: "other protocol",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts:    []string{"example.com"},
+				Port:     80,
+				Protocol: "TCP",
+			}),
+			host:     "example.com",
+			expected: false,
+		},
+		{
+			name: "other host",
+			imgw: gateway(&servicemeshv1alpha1.GatewayServer{
+				Hosts: []string{"example.org"},
+				Port:  80,
+			}),
+			host:     "example.com",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, hasACMEChallengeServer(tc.imgw, tc.host), tc.expected)
+		})
+	}
+}
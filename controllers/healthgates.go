@@ -0,0 +1,365 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/golang/protobuf/jsonpb"
+	"istio.io/api/mesh/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	healthGatesRequeueDuration = time.Second * 10
+	defaultHealthGatesTimeout  = time.Minute * 5
+	defaultSpecHistoryLimit    = 5
+
+	specHistoryKey    = "history"
+	lastKnownGoodSpec = "last-known-good"
+
+	reconciledGate       = "Reconciled"
+	istiodAvailableGate  = "IstiodAvailable"
+	sidecarInjectionGate = "SidecarInjection"
+	meshConfigGate       = "MeshConfig"
+)
+
+// reconcileHealthGates checks the health gates of the applied spec and records the spec of the resource in the history
+// once they pass. The spec is reverted to a known-good one when the gates do not pass within the timeout or when a
+// revert is requested.
+func (r *IstioControlPlaneReconciler) reconcileHealthGates(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, spec *servicemeshv1alpha1.IstioControlPlaneSpec, resourceSpec *servicemeshv1alpha1.IstioControlPlaneSpec, reconcileErr error) (ctrl.Result, error) {
+	config := icp.GetSpec().GetHealthGates()
+	if !config.GetEnabled().GetValue() || !icp.DeletionTimestamp.IsZero() || adoptionReportOnly(icp) {
+		icp.GetStatus().HealthGates = nil
+
+		return ctrl.Result{}, nil
+	}
+
+	hash, err := pkgUtil.SpecHash(spec)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status := icp.GetStatus().GetHealthGates()
+	if status.GetSpecHash() != hash {
+		status = &servicemeshv1alpha1.HealthGatesStatus{
+			SpecHash:             hash,
+			State:                servicemeshv1alpha1.HealthGatesStatus_checking,
+			Since:                time.Now().UTC().Format(time.RFC3339),
+			LastRevertedSpecHash: status.GetLastRevertedSpecHash(),
+		}
+		icp.GetStatus().HealthGates = status
+	}
+
+	if target, ok := icp.GetAnnotations()[servicemeshv1alpha1.RevertSpecAnnotation]; ok {
+		return ctrl.Result{}, r.revertSpec(ctx, icp, status, target)
+	}
+
+	// the gates are only checked until they pass or time out for a spec
+	if status.GetState() != servicemeshv1alpha1.HealthGatesStatus_checking {
+		return ctrl.Result{}, nil
+	}
+
+	status.Gates = r.checkHealthGates(ctx, icp, reconcileErr)
+	if len(failedHealthGates(status.GetGates())) == 0 {
+		status.State = servicemeshv1alpha1.HealthGatesStatus_passed
+
+		return ctrl.Result{}, r.addSpecToHistory(ctx, icp, resourceSpec, hash)
+	}
+
+	timeout := defaultHealthGatesTimeout
+	if config.GetTimeout() != nil {
+		timeout = config.GetTimeout().AsDuration()
+	}
+
+	since, err := time.Parse(time.RFC3339, status.GetSince())
+	if err == nil && time.Since(since) < timeout {
+		return ctrl.Result{
+			RequeueAfter: healthGatesRequeueDuration,
+		}, nil
+	}
+
+	status.State = servicemeshv1alpha1.HealthGatesStatus_failed
+	if r.Recorder != nil {
+		r.Recorder.Eventf(icp, corev1.EventTypeWarning, "HealthGatesFailed", "health gates of spec %s failed: %s", hash, strings.Join(failedHealthGates(status.GetGates()), ", "))
+	}
+
+	if !config.GetAutoRevert().GetValue() {
+		return ctrl.Result{}, nil
+	}
+
+	history, err := r.getSpecHistory(ctx, icp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// a spec which passed the gates before is kept, the failure is not caused by the spec
+	if _, ok := pkgUtil.FindSpecHistoryEntry(history, hash, ""); ok {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, r.revertSpec(ctx, icp, status, lastKnownGoodSpec)
+}
+
+// revertSpec replaces the spec of the control plane with the known-good spec of the history identified by the target,
+// which is either the hash of the spec or last-known-good
+func (r *IstioControlPlaneReconciler) revertSpec(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, status *servicemeshv1alpha1.HealthGatesStatus, target string) error {
+	history, err := r.getSpecHistory(ctx, icp)
+	if err != nil {
+		return err
+	}
+
+	hash := target
+	if target == lastKnownGoodSpec {
+		hash = ""
+	}
+	entry, found := pkgUtil.FindSpecHistoryEntry(history, hash, status.GetSpecHash())
+
+	current := &servicemeshv1alpha1.IstioControlPlane{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(icp), current); err != nil {
+		return errors.WrapIf(err, "could not get istio control plane")
+	}
+
+	delete(current.GetAnnotations(), servicemeshv1alpha1.RevertSpecAnnotation)
+	if found {
+		current.Spec = entry.Spec.DeepCopy()
+	}
+
+	if err := r.Client.Update(ctx, current); err != nil {
+		return errors.WrapIf(err, "could not revert spec of istio control plane")
+	}
+
+	if !found {
+		if r.Recorder != nil {
+			r.Recorder.Eventf(icp, corev1.EventTypeWarning, "SpecRevertFailed", "no known-good spec %q found in the history", target)
+		}
+
+		return nil
+	}
+
+	status.State = servicemeshv1alpha1.HealthGatesStatus_reverted
+	status.LastRevertedSpecHash = status.GetSpecHash()
+	if r.Recorder != nil {
+		r.Recorder.Eventf(icp, corev1.EventTypeWarning, "SpecReverted", "spec %s was reverted to the known-good spec %s", status.GetSpecHash(), entry.Hash)
+	}
+
+	return nil
+}
+
+func (r *IstioControlPlaneReconciler) checkHealthGates(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, reconcileErr error) []*servicemeshv1alpha1.HealthGate {
+	if reconcileErr != nil {
+		return []*servicemeshv1alpha1.HealthGate{
+			healthGate(reconciledGate, reconcileErr),
+		}
+	}
+
+	gates := []*servicemeshv1alpha1.HealthGate{}
+	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+		gates = append(gates, healthGate(istiodAvailableGate, r.checkIstiodAvailable(ctx, icp)))
+	}
+
+	gates = append(gates,
+		healthGate(sidecarInjectionGate, r.checkSidecarInjection(ctx, icp, icp.GetNamespace())),
+		healthGate(meshConfigGate, r.checkMeshConfig(ctx, icp)),
+	)
+
+	return gates
+}
+
+func (r *IstioControlPlaneReconciler) checkIstiodAvailable(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	deployment := &appsv1.Deployment{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: icp.GetNamespace(), Name: icp.WithRevision("istiod")}, deployment); err != nil {
+		return errors.WrapIf(err, "could not get istiod deployment")
+	}
+
+	return deploymentRolledOut(deployment)
+}
+
+func (r *IstioControlPlaneReconciler) checkMeshConfig(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	configmaps := &corev1.ConfigMapList{}
+	err := r.Client.List(ctx, configmaps, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(utils.MergeLabels(icp.RevisionLabels(), map[string]string{"istio": "meshconfig"})))
+	if err != nil {
+		return errors.WrapIf(err, "could not list mesh config configmaps")
+	}
+
+	if len(configmaps.Items) != 1 {
+		return errors.NewWithDetails("mesh config configmap not found", "count", len(configmaps.Items))
+	}
+
+	_, err = parseMeshConfig(configmaps.Items[0].Data["mesh"])
+
+	return err
+}
+
+func (r *IstioControlPlaneReconciler) specHistoryConfigMapKey(icp *servicemeshv1alpha1.IstioControlPlane) client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: icp.GetNamespace(),
+		Name:      fmt.Sprintf("%s-spec-history", icp.GetName()),
+	}
+}
+
+func (r *IstioControlPlaneReconciler) getSpecHistory(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]pkgUtil.SpecHistoryEntry, error) {
+	cm := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, r.specHistoryConfigMapKey(icp), cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, errors.WrapIf(err, "could not get spec history")
+	}
+
+	return pkgUtil.DecodeSpecHistory(cm.Data[specHistoryKey])
+}
+
+func (r *IstioControlPlaneReconciler) addSpecToHistory(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, spec *servicemeshv1alpha1.IstioControlPlaneSpec, hash string) error {
+	history, err := r.getSpecHistory(ctx, icp)
+	if err != nil {
+		return err
+	}
+
+	limit := defaultSpecHistoryLimit
+	if l := icp.GetSpec().GetHealthGates().GetHistoryLimit(); l != nil {
+		limit = int(l.GetValue())
+	}
+
+	history = pkgUtil.AddSpecHistoryEntry(history, pkgUtil.SpecHistoryEntry{
+		Hash: hash,
+		Time: time.Now().UTC().Format(time.RFC3339),
+		Spec: spec,
+	}, limit)
+
+	data, err := pkgUtil.EncodeSpecHistory(history)
+	if err != nil {
+		return err
+	}
+
+	key := r.specHistoryConfigMapKey(icp)
+	cm := &corev1.ConfigMap{}
+	err = r.Client.Get(ctx, key, cm)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.WrapIf(err, "could not get spec history")
+	}
+
+	found := err == nil
+	cm.Name = key.Name
+	cm.Namespace = key.Namespace
+	k8sutil.SetICPMetadataOnObject(cm, icp)
+	cm.Data = map[string]string{
+		specHistoryKey: data,
+	}
+
+	if found {
+		return errors.WrapIf(r.Client.Update(ctx, cm), "could not update spec history")
+	}
+
+	return errors.WrapIf(r.Client.Create(ctx, cm), "could not create spec history")
+}
+
+// controlPlaneState returns the state and message of a successfully reconciled control plane
+func controlPlaneState(icp *servicemeshv1alpha1.IstioControlPlane) (servicemeshv1alpha1.ConfigState, string) {
+	if adoptionReportOnly(icp) {
+		return servicemeshv1alpha1.ConfigState_Unmanaged, "existing Istio installation is only reported, see status.adoptedResources"
+	}
+
+	gates := icp.GetStatus().GetHealthGates()
+	switch gates.GetState() {
+	case servicemeshv1alpha1.HealthGatesStatus_checking:
+		return servicemeshv1alpha1.ConfigState_Reconciling, "waiting for health gates: " + strings.Join(failedHealthGates(gates.GetGates()), ", ")
+	case servicemeshv1alpha1.HealthGatesStatus_failed:
+		return servicemeshv1alpha1.ConfigState_ReconcileFailed, "health gates failed: " + strings.Join(failedHealthGates(gates.GetGates()), ", ")
+	case servicemeshv1alpha1.HealthGatesStatus_reverted:
+		return servicemeshv1alpha1.ConfigState_ReconcileFailed, "health gates failed, the spec was reverted to the last known-good one"
+	default:
+		return servicemeshv1alpha1.ConfigState_Available, ""
+	}
+}
+
+// deploymentRolledOut checks whether the latest generation of the deployment is rolled out and available
+func deploymentRolledOut(deployment *appsv1.Deployment) error {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return errors.New("rollout is not observed yet")
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	if deployment.Status.UpdatedReplicas < replicas {
+		return errors.Errorf("%d of %d replicas are updated", deployment.Status.UpdatedReplicas, replicas)
+	}
+	if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		return errors.Errorf("%d old replicas are pending termination", deployment.Status.Replicas-deployment.Status.UpdatedReplicas)
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		return errors.Errorf("%d of %d updated replicas are available", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas)
+	}
+
+	return nil
+}
+
+// parseMeshConfig strictly parses the mesh config stored in the istio configmap
+func parseMeshConfig(meshConfigYAML string) (*v1alpha1.MeshConfig, error) {
+	mcJSON, err := yaml.YAMLToJSON([]byte(meshConfigYAML))
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not parse mesh config")
+	}
+
+	mc := &v1alpha1.MeshConfig{}
+	if err := jsonpb.UnmarshalString(string(mcJSON), mc); err != nil {
+		return nil, errors.WrapIf(err, "could not parse mesh config")
+	}
+
+	return mc, nil
+}
+
+func healthGate(name string, err error) *servicemeshv1alpha1.HealthGate {
+	gate := &servicemeshv1alpha1.HealthGate{
+		Name:   name,
+		Passed: err == nil,
+	}
+	if err != nil {
+		gate.Message = err.Error()
+	}
+
+	return gate
+}
+
+func failedHealthGates(gates []*servicemeshv1alpha1.HealthGate) []string {
+	failed := []string{}
+	for _, gate := range gates {
+		if !gate.GetPassed() {
+			failed = append(failed, fmt.Sprintf("%s (%s)", gate.GetName(), gate.GetMessage()))
+		}
+	}
+
+	return failed
+}
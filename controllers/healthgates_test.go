@@ -0,0 +1,343 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+// injectingClient mimics the sidecar injector webhook of the revision for the created pods
+type injectingClient struct {
+	client.Client
+	revision string
+}
+
+func (c injectingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if pod, ok := obj.(*corev1.Pod); ok && c.revision != "" {
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{Name: "istio-init"})
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: "istio-proxy"})
+		pod.SetAnnotations(utils.MergeLabels(pod.GetAnnotations(), map[string]string{
+			sidecarStatusAnnotation: `{"revision":"` + c.revision + `"}`,
+		}))
+	}
+
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestDeploymentRolledOut(t *testing.T) {
+	t.Parallel()
+
+	deployment := func(generation, observedGeneration int64, replicas *int32, status appsv1.DeploymentStatus) *appsv1.Deployment {
+		status.ObservedGeneration = observedGeneration
+
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: generation,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: replicas,
+			},
+			Status: status,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		err        string
+	}{
+		{
+			name:       "rolled out",
+			deployment: deployment(2, 2, utils.IntPointer(2), appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+		},
+		{
+			name:       "single replica by default",
+			deployment: deployment(1, 1, nil, appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+		},
+		{
+			name:       "generation not observed",
+			deployment: deployment(3, 2, utils.IntPointer(2), appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			err:        "rollout is not observed yet",
+		},
+		{
+			name:       "replicas not updated",
+			deployment: deployment(2, 2, utils.IntPointer(2), appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2}),
+			err:        "1 of 2 replicas are updated",
+		},
+		{
+			name:       "old replicas pending termination",
+			deployment: deployment(2, 2, utils.IntPointer(2), appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			err:        "1 old replicas are pending termination",
+		},
+		{
+			name:       "updated replicas not available",
+			deployment: deployment(2, 2, utils.IntPointer(2), appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1}),
+			err:        "1 of 2 updated replicas are available",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := deploymentRolledOut(tc.deployment)
+			if tc.err == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestReconcileHealthGates(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, servicemeshv1alpha1.AddToScheme(scheme))
+
+	newControlPlane := func() *servicemeshv1alpha1.IstioControlPlane {
+		return &servicemeshv1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cp-v117x",
+				Namespace: "istio-system",
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				Version: "1.17.1",
+				Mode:    servicemeshv1alpha1.ModeType_ACTIVE,
+				Profile: "default",
+				HealthGates: &servicemeshv1alpha1.HealthGatesConfiguration{
+					Enabled:    wrapperspb.Bool(true),
+					Timeout:    durationpb.New(time.Minute),
+					AutoRevert: wrapperspb.Bool(true),
+				},
+			},
+		}
+	}
+
+	healthyObjects := func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+		return []client.Object{
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      icp.WithRevision("istiod"),
+					Namespace: icp.GetNamespace(),
+				},
+				Status: appsv1.DeploymentStatus{
+					Replicas:          1,
+					UpdatedReplicas:   1,
+					AvailableReplicas: 1,
+				},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      icp.WithRevision("istio"),
+					Namespace: icp.GetNamespace(),
+					Labels:    utils.MergeLabels(icp.RevisionLabels(), map[string]string{"istio": "meshconfig"}),
+				},
+				Data: map[string]string{
+					"mesh": "defaultConfig:\n  discoveryAddress: istiod-cp-v117x.istio-system.svc:15012\n",
+				},
+			},
+		}
+	}
+
+	historyConfigMap := func(icp *servicemeshv1alpha1.IstioControlPlane, entries ...pkgUtil.SpecHistoryEntry) client.Object {
+		data, err := pkgUtil.EncodeSpecHistory(entries)
+		assert.NilError(t, err)
+
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      icp.GetName() + "-spec-history",
+				Namespace: icp.GetNamespace(),
+			},
+			Data: map[string]string{
+				specHistoryKey: data,
+			},
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		icp.Spec.HealthGates.Enabled = wrapperspb.Bool(false)
+		icp.GetStatus().HealthGates = &servicemeshv1alpha1.HealthGatesStatus{SpecHash: "stale"}
+
+		r := &IstioControlPlaneReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp).Build(),
+		}
+
+		result, err := r.reconcileHealthGates(context.Background(), icp, icp.GetSpec(), icp.GetSpec(), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, result.RequeueAfter, time.Duration(0))
+		assert.Assert(t, icp.GetStatus().GetHealthGates() == nil)
+	})
+
+	t.Run("passed gates record the spec of the resource", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		resolved := icp.GetSpec().DeepCopy()
+		resolved.Profile = ""
+		resolved.Logging = &servicemeshv1alpha1.LoggingConfiguration{Level: "default:info"}
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(healthyObjects(icp), icp)...).Build()
+		recorder := record.NewFakeRecorder(10)
+		r := &IstioControlPlaneReconciler{
+			Client:   injectingClient{Client: c, revision: icp.NamespacedRevision()},
+			Recorder: recorder,
+		}
+
+		result, err := r.reconcileHealthGates(context.Background(), icp, resolved, icp.GetSpec(), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, result.RequeueAfter, time.Duration(0))
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetState(), servicemeshv1alpha1.HealthGatesStatus_passed)
+		assert.Equal(t, len(failedHealthGates(icp.GetStatus().GetHealthGates().GetGates())), 0)
+
+		hash, err := pkgUtil.SpecHash(resolved)
+		assert.NilError(t, err)
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetSpecHash(), hash)
+
+		history, err := r.getSpecHistory(context.Background(), icp)
+		assert.NilError(t, err)
+		assert.Equal(t, len(history), 1)
+		assert.Equal(t, history[0].Hash, hash)
+		assert.DeepEqual(t, history[0].Spec, icp.GetSpec(), protocmp.Transform())
+		assert.Equal(t, len(recorder.Events), 0)
+	})
+
+	t.Run("failing gates are checked until the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		r := &IstioControlPlaneReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp).Build(),
+		}
+
+		result, err := r.reconcileHealthGates(context.Background(), icp, icp.GetSpec(), icp.GetSpec(), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, result.RequeueAfter, healthGatesRequeueDuration)
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetState(), servicemeshv1alpha1.HealthGatesStatus_checking)
+		assert.Equal(t, len(failedHealthGates(icp.GetStatus().GetHealthGates().GetGates())), 3)
+	})
+
+	t.Run("failed reconciliation after the timeout reverts to the recorded spec", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		hash, err := pkgUtil.SpecHash(icp.GetSpec())
+		assert.NilError(t, err)
+		icp.GetStatus().HealthGates = &servicemeshv1alpha1.HealthGatesStatus{
+			SpecHash: hash,
+			State:    servicemeshv1alpha1.HealthGatesStatus_checking,
+			Since:    time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		}
+
+		knownGood := newControlPlane().GetSpec()
+		knownGood.Version = "1.17.0"
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp, historyConfigMap(icp, pkgUtil.SpecHistoryEntry{
+			Hash: "known-good",
+			Spec: knownGood,
+		})).Build()
+		recorder := record.NewFakeRecorder(10)
+		r := &IstioControlPlaneReconciler{
+			Client:   c,
+			Recorder: recorder,
+		}
+
+		result, err := r.reconcileHealthGates(context.Background(), icp, icp.GetSpec(), icp.GetSpec(), errors.New("could not reconcile"))
+		assert.NilError(t, err)
+		assert.Equal(t, result.RequeueAfter, time.Duration(0))
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetState(), servicemeshv1alpha1.HealthGatesStatus_reverted)
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetLastRevertedSpecHash(), hash)
+		assert.Equal(t, len(recorder.Events), 2)
+
+		current := &servicemeshv1alpha1.IstioControlPlane{}
+		assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(icp), current))
+		assert.DeepEqual(t, current.GetSpec(), knownGood, protocmp.Transform())
+		assert.Equal(t, current.GetSpec().GetProfile(), "default")
+	})
+
+	t.Run("failed gates without auto revert", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		icp.Spec.HealthGates.AutoRevert = wrapperspb.Bool(false)
+		hash, err := pkgUtil.SpecHash(icp.GetSpec())
+		assert.NilError(t, err)
+		icp.GetStatus().HealthGates = &servicemeshv1alpha1.HealthGatesStatus{
+			SpecHash: hash,
+			State:    servicemeshv1alpha1.HealthGatesStatus_checking,
+			Since:    time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		}
+
+		r := &IstioControlPlaneReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp).Build(),
+		}
+
+		_, err = r.reconcileHealthGates(context.Background(), icp, icp.GetSpec(), icp.GetSpec(), errors.New("could not reconcile"))
+		assert.NilError(t, err)
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetState(), servicemeshv1alpha1.HealthGatesStatus_failed)
+	})
+
+	t.Run("requested revert to an unknown spec", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newControlPlane()
+		icp.Annotations = map[string]string{
+			servicemeshv1alpha1.RevertSpecAnnotation: "unknown",
+		}
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp).Build()
+		r := &IstioControlPlaneReconciler{
+			Client: c,
+		}
+
+		_, err := r.reconcileHealthGates(context.Background(), icp, icp.GetSpec(), icp.GetSpec(), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, icp.GetStatus().GetHealthGates().GetState(), servicemeshv1alpha1.HealthGatesStatus_checking)
+
+		current := &servicemeshv1alpha1.IstioControlPlane{}
+		assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(icp), current))
+		_, ok := current.GetAnnotations()[servicemeshv1alpha1.RevertSpecAnnotation]
+		assert.Assert(t, !ok)
+		assert.DeepEqual(t, current.GetSpec(), icp.GetSpec(), protocmp.Transform())
+	})
+}
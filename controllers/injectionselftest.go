@@ -0,0 +1,272 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	sidecarInjectionReadyCondition   = "SidecarInjectionReady"
+	defaultInjectionSelfTestInterval = time.Minute * 5
+	injectionTestPodGenerateName     = "istio-operator-injection-check-"
+	cniInterceptionModeAnnotation    = "sidecar.istio.io/interceptionMode"
+)
+
+var injectionSelfTestSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "istio_operator_sidecar_injection_self_test_success",
+	Help: "Whether the last sidecar injection self-test of the control plane passed in the namespace (1) or not (0)",
+}, []string{"controlplane_namespace", "controlplane", "namespace"})
+
+func init() {
+	metrics.Registry.MustRegister(injectionSelfTestSuccess)
+}
+
+// reconcileInjectionSelfTest tests the sidecar injection of the control plane in the configured namespaces
+// whose last test is older than the interval or ran with a different injector configuration.
+// It returns the time after the tests are due again.
+func (r *IstioControlPlaneReconciler) reconcileInjectionSelfTest(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) time.Duration {
+	config := icp.GetSpec().GetInjectionSelfTest()
+	if !config.GetEnabled().GetValue() || !icp.DeletionTimestamp.IsZero() {
+		icp.GetStatus().InjectionSelfTests = nil
+		removeCondition(icp, sidecarInjectionReadyCondition)
+		deleteInjectionSelfTestMetrics(icp)
+
+		return 0
+	}
+
+	interval := defaultInjectionSelfTestInterval
+	if config.GetInterval() != nil {
+		interval = config.GetInterval().AsDuration()
+	}
+
+	namespaces := icp.GetStatus().GetInjectionNamespaces()
+	if config.GetCanaryNamespace() != "" {
+		namespaces = []string{config.GetCanaryNamespace()}
+	}
+
+	previous := make(map[string]*servicemeshv1alpha1.InjectionSelfTestResult)
+	for _, result := range icp.GetStatus().GetInjectionSelfTests() {
+		previous[result.GetNamespace()] = result
+	}
+
+	checksum := icp.GetStatus().GetChecksums().GetSidecarInjector()
+	results := make([]*servicemeshv1alpha1.InjectionSelfTestResult, 0, len(namespaces))
+	for _, namespace := range uniqueSortedStrings(namespaces) {
+		result := previous[namespace]
+		if testedAt, err := time.Parse(time.RFC3339, result.GetTime()); err != nil || time.Since(testedAt) >= interval || result.GetInjectorChecksum() != checksum {
+			result = &servicemeshv1alpha1.InjectionSelfTestResult{
+				Namespace:        namespace,
+				Passed:           true,
+				Time:             time.Now().UTC().Format(time.RFC3339),
+				InjectorChecksum: checksum,
+			}
+			if err := r.checkSidecarInjection(ctx, icp, namespace); err != nil {
+				result.Passed = false
+				result.Message = err.Error()
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	icp.GetStatus().InjectionSelfTests = results
+
+	deleteInjectionSelfTestMetrics(icp)
+	failed := []string{}
+	for _, result := range results {
+		value := float64(1)
+		if !result.GetPassed() {
+			value = 0
+			failed = append(failed, fmt.Sprintf("%s: %s", result.GetNamespace(), result.GetMessage()))
+		}
+		injectionSelfTestSuccess.WithLabelValues(icp.GetNamespace(), icp.GetName(), result.GetNamespace()).Set(value)
+	}
+
+	switch {
+	case len(results) == 0:
+		setCondition(icp, sidecarInjectionReadyCondition, metav1.ConditionUnknown, "NoNamespaces", "no namespace is set for injection for the control plane")
+	case len(failed) > 0:
+		setCondition(icp, sidecarInjectionReadyCondition, metav1.ConditionFalse, "InjectionFailed", strings.Join(failed, "; "))
+	default:
+		setCondition(icp, sidecarInjectionReadyCondition, metav1.ConditionTrue, "InjectionSucceeded", "")
+	}
+
+	return interval
+}
+
+// checkSidecarInjection creates a pod selected by the sidecar injector webhook of the control plane in the namespace
+// in dry-run mode and verifies the injection into the admitted pod
+func (r *IstioControlPlaneReconciler) checkSidecarInjection(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, namespace string) error {
+	pod := injectionTestPod(icp, namespace)
+	if err := r.Client.Create(ctx, pod, client.DryRunAll); err != nil {
+		return errors.WrapIf(err, "dry-run pod admission failed")
+	}
+
+	return verifySidecarInjection(icp, pod)
+}
+
+// injectionTestPod returns the pod admitted for the injection test. It complies with the restricted pod security
+// standard and sets its resources, so it is not rejected by the policies of the namespace.
+func injectionTestPod(icp *servicemeshv1alpha1.IstioControlPlane, namespace string) *corev1.Pod {
+	resources := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("16Mi"),
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: injectionTestPodGenerateName,
+			Namespace:    namespace,
+			Labels: map[string]string{
+				servicemeshv1alpha1.RevisionedAutoInjectionLabel: icp.NamespacedRevision(),
+			},
+		},
+		Spec: corev1.PodSpec{
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: utils.BoolPointer(true),
+				RunAsUser:    utils.IntPointer64(65534),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Image: "busybox",
+					Resources: corev1.ResourceRequirements{
+						Requests: resources,
+						Limits:   resources,
+					},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: utils.BoolPointer(false),
+						ReadOnlyRootFilesystem:   utils.BoolPointer(true),
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{"ALL"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// verifySidecarInjection checks that the pod was injected by the control plane
+func verifySidecarInjection(icp *servicemeshv1alpha1.IstioControlPlane, pod *corev1.Pod) error {
+	if !hasContainer(pod.Spec.Containers, "istio-proxy") && !hasContainer(pod.Spec.InitContainers, "istio-proxy") {
+		return errors.New("istio-proxy container was not injected")
+	}
+
+	if icp.GetSpec().GetProxyInit().GetCni().GetEnabled().GetValue() {
+		if _, ok := pod.GetAnnotations()[cniInterceptionModeAnnotation]; !ok {
+			return errors.New("annotations of the CNI plugin were not injected")
+		}
+	} else if !hasContainer(pod.Spec.InitContainers, "istio-init") {
+		return errors.New("istio-init container was not injected")
+	}
+
+	status := struct {
+		Revision string `json:"revision"`
+	}{}
+	if err := json.Unmarshal([]byte(pod.GetAnnotations()[sidecarStatusAnnotation]), &status); err != nil {
+		return errors.WrapIf(err, "could not parse sidecar status annotation")
+	}
+
+	if status.Revision != icp.NamespacedRevision() {
+		return errors.Errorf("pod was injected by revision %q instead of %q", status.Revision, icp.NamespacedRevision())
+	}
+
+	return nil
+}
+
+func hasContainer(containers []corev1.Container, name string) bool {
+	for _, container := range containers {
+		if container.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deleteInjectionSelfTestMetrics(icp *servicemeshv1alpha1.IstioControlPlane) {
+	injectionSelfTestSuccess.DeletePartialMatch(prometheus.Labels{
+		"controlplane_namespace": icp.GetNamespace(),
+		"controlplane":           icp.GetName(),
+	})
+}
+
+// setCondition sets the condition of the control plane, the transition time is only changed with the status
+func setCondition(icp *servicemeshv1alpha1.IstioControlPlane, conditionType string, status metav1.ConditionStatus, reason string, message string) {
+	condition := &servicemeshv1alpha1.Condition{
+		Type:               conditionType,
+		Status:             string(status),
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	conditions := icp.GetStatus().GetConditions()
+	for i, c := range conditions {
+		if c.GetType() != conditionType {
+			continue
+		}
+
+		if c.GetStatus() == condition.GetStatus() {
+			condition.LastTransitionTime = c.GetLastTransitionTime()
+		}
+		conditions[i] = condition
+
+		return
+	}
+
+	conditions = append(conditions, condition)
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].GetType() < conditions[j].GetType()
+	})
+	icp.GetStatus().Conditions = conditions
+}
+
+func removeCondition(icp *servicemeshv1alpha1.IstioControlPlane, conditionType string) {
+	conditions := make([]*servicemeshv1alpha1.Condition, 0, len(icp.GetStatus().GetConditions()))
+	for _, c := range icp.GetStatus().GetConditions() {
+		if c.GetType() != conditionType {
+			conditions = append(conditions, c)
+		}
+	}
+
+	if len(conditions) == 0 {
+		conditions = nil
+	}
+	icp.GetStatus().Conditions = conditions
+}
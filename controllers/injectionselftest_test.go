@@ -0,0 +1,303 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func newInjectionSelfTestControlPlane(name string) *servicemeshv1alpha1.IstioControlPlane {
+	return &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			InjectionSelfTest: &servicemeshv1alpha1.InjectionSelfTestConfiguration{
+				Enabled:  wrapperspb.Bool(true),
+				Interval: durationpb.New(time.Minute),
+			},
+		},
+		Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+			InjectionNamespaces: []string{"reviews", "bookinfo", "reviews"},
+			Checksums: &servicemeshv1alpha1.StatusChecksums{
+				SidecarInjector: "checksum",
+			},
+		},
+	}
+}
+
+func TestInjectionTestPod(t *testing.T) {
+	t.Parallel()
+
+	icp := newInjectionSelfTestControlPlane("cp-v117x")
+	pod := injectionTestPod(icp, "bookinfo")
+
+	assert.Equal(t, pod.GetNamespace(), "bookinfo")
+	assert.Equal(t, pod.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel], icp.NamespacedRevision())
+	assert.Assert(t, *pod.Spec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, pod.Spec.SecurityContext.SeccompProfile.Type, corev1.SeccompProfileTypeRuntimeDefault)
+
+	for _, container := range pod.Spec.Containers {
+		assert.Assert(t, !*container.SecurityContext.AllowPrivilegeEscalation)
+		assert.DeepEqual(t, container.SecurityContext.Capabilities.Drop, []corev1.Capability{"ALL"})
+		assert.Equal(t, container.Resources.Requests.Cpu().String(), "10m")
+		assert.Equal(t, container.Resources.Requests.Memory().String(), "16Mi")
+		assert.DeepEqual(t, container.Resources.Limits, container.Resources.Requests)
+	}
+}
+
+func TestVerifySidecarInjection(t *testing.T) {
+	t.Parallel()
+
+	icp := newInjectionSelfTestControlPlane("cp-v117x")
+	cniICP := newInjectionSelfTestControlPlane("cp-v117x")
+	cniICP.Spec.ProxyInit = &servicemeshv1alpha1.ProxyInitConfiguration{
+		Cni: &servicemeshv1alpha1.CNIConfiguration{
+			Enabled: wrapperspb.Bool(true),
+		},
+	}
+
+	pod := func(annotations map[string]string, initContainers []string, containers ...string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: annotations,
+			},
+		}
+		for _, name := range initContainers {
+			pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{Name: name})
+		}
+		for _, name := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: name})
+		}
+
+		return pod
+	}
+	status := func(revision string) map[string]string {
+		return map[string]string{
+			sidecarStatusAnnotation: `{"revision":"` + revision + `"}`,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		icp  *servicemeshv1alpha1.IstioControlPlane
+		pod  *corev1.Pod
+		err  string
+	}{
+		{
+			name: "injected",
+			icp:  icp,
+			pod:  pod(status(icp.NamespacedRevision()), []string{"istio-init"}, "app", "istio-proxy"),
+		},
+		{
+			name: "native sidecar",
+			icp:  icp,
+			pod:  pod(status(icp.NamespacedRevision()), []string{"istio-init", "istio-proxy"}, "app"),
+		},
+		{
+			name: "not injected",
+			icp:  icp,
+			pod:  pod(nil, nil, "app"),
+			err:  "istio-proxy container was not injected",
+		},
+		{
+			name: "missing init container",
+			icp:  icp,
+			pod:  pod(status(icp.NamespacedRevision()), nil, "app", "istio-proxy"),
+			err:  "istio-init container was not injected",
+		},
+		{
+			name: "CNI plugin",
+			icp:  cniICP,
+			pod: pod(map[string]string{
+				sidecarStatusAnnotation:       `{"revision":"` + icp.NamespacedRevision() + `"}`,
+				cniInterceptionModeAnnotation: "REDIRECT",
+			}, nil, "app", "istio-proxy"),
+		},
+		{
+			name: "missing CNI annotations",
+			icp:  cniICP,
+			pod:  pod(status(icp.NamespacedRevision()), nil, "app", "istio-proxy"),
+			err:  "annotations of the CNI plugin were not injected",
+		},
+		{
+			name: "invalid status annotation",
+			icp:  icp,
+			pod:  pod(map[string]string{sidecarStatusAnnotation: "{"}, []string{"istio-init"}, "app", "istio-proxy"),
+			err:  "could not parse sidecar status annotation: unexpected end of JSON input",
+		},
+		{
+			name: "injected by another revision",
+			icp:  icp,
+			pod:  pod(status("cp-v116x.istio-system"), []string{"istio-init"}, "app", "istio-proxy"),
+			err:  `pod was injected by revision "cp-v116x.istio-system" instead of "cp-v117x.istio-system"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifySidecarInjection(tc.icp, tc.pod)
+			if tc.err == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestReconcileInjectionSelfTest(t *testing.T) {
+	t.Parallel()
+
+	condition := func(icp *servicemeshv1alpha1.IstioControlPlane) *servicemeshv1alpha1.Condition {
+		for _, c := range icp.GetStatus().GetConditions() {
+			if c.GetType() == sidecarInjectionReadyCondition {
+				return c
+			}
+		}
+
+		return nil
+	}
+
+	newReconciler := func(icp *servicemeshv1alpha1.IstioControlPlane, injecting bool) *IstioControlPlaneReconciler {
+		c := injectingClient{
+			Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		}
+		if injecting {
+			c.revision = icp.NamespacedRevision()
+		}
+
+		return &IstioControlPlaneReconciler{
+			Client: c,
+		}
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-passed")
+		after := newReconciler(icp, true).reconcileInjectionSelfTest(context.Background(), icp)
+
+		assert.Equal(t, after, time.Minute)
+		results := icp.GetStatus().GetInjectionSelfTests()
+		assert.Equal(t, len(results), 2)
+		for i, namespace := range []string{"bookinfo", "reviews"} {
+			assert.Equal(t, results[i].GetNamespace(), namespace)
+			assert.Assert(t, results[i].GetPassed())
+			assert.Equal(t, results[i].GetInjectorChecksum(), "checksum")
+			assert.Equal(t, testutil.ToFloat64(injectionSelfTestSuccess.WithLabelValues(icp.GetNamespace(), icp.GetName(), namespace)), float64(1))
+		}
+		assert.Equal(t, condition(icp).GetStatus(), string(metav1.ConditionTrue))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-failed")
+		newReconciler(icp, false).reconcileInjectionSelfTest(context.Background(), icp)
+
+		for _, result := range icp.GetStatus().GetInjectionSelfTests() {
+			assert.Assert(t, !result.GetPassed())
+			assert.Equal(t, result.GetMessage(), "istio-proxy container was not injected")
+			assert.Equal(t, testutil.ToFloat64(injectionSelfTestSuccess.WithLabelValues(icp.GetNamespace(), icp.GetName(), result.GetNamespace())), float64(0))
+		}
+		assert.Equal(t, condition(icp).GetStatus(), string(metav1.ConditionFalse))
+		assert.Equal(t, condition(icp).GetReason(), "InjectionFailed")
+	})
+
+	t.Run("canary namespace", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-canary")
+		icp.Spec.InjectionSelfTest.CanaryNamespace = "canary"
+		newReconciler(icp, true).reconcileInjectionSelfTest(context.Background(), icp)
+
+		results := icp.GetStatus().GetInjectionSelfTests()
+		assert.Equal(t, len(results), 1)
+		assert.Equal(t, results[0].GetNamespace(), "canary")
+	})
+
+	t.Run("recent results are kept until the injector changes", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-recent")
+		icp.GetStatus().InjectionSelfTests = []*servicemeshv1alpha1.InjectionSelfTestResult{
+			{
+				Namespace:        "bookinfo",
+				Passed:           false,
+				Message:          "previous failure",
+				Time:             time.Now().UTC().Format(time.RFC3339),
+				InjectorChecksum: "checksum",
+			},
+		}
+		r := newReconciler(icp, true)
+
+		r.reconcileInjectionSelfTest(context.Background(), icp)
+		assert.Equal(t, icp.GetStatus().GetInjectionSelfTests()[0].GetMessage(), "previous failure")
+		assert.Assert(t, icp.GetStatus().GetInjectionSelfTests()[1].GetPassed())
+		assert.Equal(t, condition(icp).GetStatus(), string(metav1.ConditionFalse))
+
+		icp.GetStatus().GetChecksums().SidecarInjector = "changed"
+		r.reconcileInjectionSelfTest(context.Background(), icp)
+		assert.Assert(t, icp.GetStatus().GetInjectionSelfTests()[0].GetPassed())
+		assert.Equal(t, condition(icp).GetStatus(), string(metav1.ConditionTrue))
+	})
+
+	t.Run("no namespaces", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-empty")
+		icp.GetStatus().InjectionNamespaces = nil
+		newReconciler(icp, true).reconcileInjectionSelfTest(context.Background(), icp)
+
+		assert.Equal(t, len(icp.GetStatus().GetInjectionSelfTests()), 0)
+		assert.Equal(t, condition(icp).GetStatus(), string(metav1.ConditionUnknown))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		icp := newInjectionSelfTestControlPlane("cp-disabled")
+		r := newReconciler(icp, true)
+		r.reconcileInjectionSelfTest(context.Background(), icp)
+		assert.Assert(t, condition(icp) != nil)
+
+		icp.Spec.InjectionSelfTest.Enabled = wrapperspb.Bool(false)
+		after := r.reconcileInjectionSelfTest(context.Background(), icp)
+
+		assert.Equal(t, after, time.Duration(0))
+		assert.Assert(t, icp.GetStatus().GetInjectionSelfTests() == nil)
+		assert.Assert(t, condition(icp) == nil)
+	})
+}
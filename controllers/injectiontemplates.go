@@ -0,0 +1,142 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/pkg/injectiontemplate"
+)
+
+// validateInjectionTemplates renders the custom sidecar injection templates of the spec for a sample pod the way the
+// sidecar injector does, with the injector configmap rendered by the discovery component. The control plane is not
+// reconciled while any of the templates is invalid, the running injector keeps its last valid templates.
+func (r *IstioControlPlaneReconciler) validateInjectionTemplates(icp *servicemeshv1alpha1.IstioControlPlane, discoveryReconciler components.ComponentReconciler) error {
+	icp.GetStatus().InvalidInjectionTemplates = nil
+
+	names := injectionTemplateNames(icp)
+	if len(names) == 0 || !icp.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	objects, err := components.RenderedObjects(discoveryReconciler, icp)
+	if err != nil {
+		return err
+	}
+
+	var injector *corev1.ConfigMap
+	var meshConfig *meshv1alpha1.MeshConfig
+	for _, object := range objects {
+		if object.GetObjectKind().GroupVersionKind().Kind != "ConfigMap" {
+			continue
+		}
+
+		cm := &corev1.ConfigMap{}
+		if err := convertObject(object, cm); err != nil {
+			return err
+		}
+
+		switch cm.GetLabels()["istio"] {
+		case "sidecar-injector":
+			injector = cm
+		case "meshconfig":
+			if meshConfig, err = parseMeshConfig(cm.Data["mesh"]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if injector == nil {
+		// the injector configmap is omitted
+		return nil
+	}
+
+	keys := make([]string, 0, len(names))
+	for key := range names {
+		keys = append(keys, key)
+	}
+
+	invalid, err := injectiontemplate.ValidateInjectorConfig(injector.Data["config"], injector.Data["values"], meshConfig, keys)
+	if err != nil {
+		return err
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(invalid))
+	statuses := make([]*servicemeshv1alpha1.InjectionTemplateError, 0, len(invalid))
+	for _, e := range invalid {
+		e.Template = names[e.Template]
+		messages = append(messages, e.Error())
+		statuses = append(statuses, &servicemeshv1alpha1.InjectionTemplateError{
+			Template: e.Template,
+			Line:     int32(e.Line),
+			Error:    e.Message,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].GetTemplate() < statuses[j].GetTemplate()
+	})
+	icp.GetStatus().InvalidInjectionTemplates = statuses
+
+	return errors.Errorf("invalid sidecar injection templates: %s", strings.Join(messages, "; "))
+}
+
+// injectionTemplateNames returns the names of the custom injection templates of the spec in the injector configmap
+// mapped to the names they are defined with in the spec
+func injectionTemplateNames(icp *servicemeshv1alpha1.IstioControlPlane) map[string]string {
+	templates := icp.GetSpec().GetSidecarInjector().GetTemplates()
+
+	names := make(map[string]string)
+	if templates.GetSidecar() != "" {
+		names["sidecarOverrides"] = "sidecar"
+	}
+	if templates.GetGateway() != "" {
+		names["gatewayOverrides"] = "gateway"
+	}
+	for _, custom := range templates.GetCustomTemplates() {
+		if custom.GetTemplate() != "" {
+			names[custom.GetName()] = custom.GetName()
+		}
+	}
+
+	return names
+}
+
+func convertObject(object runtime.Object, out interface{}) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return errors.WrapIf(err, "could not convert object to unstructured")
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, out); err != nil {
+		return errors.WrapIff(err, "could not convert object to %T", out)
+	}
+
+	return nil
+}
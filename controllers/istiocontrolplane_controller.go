@@ -27,7 +27,7 @@ import (
 	"time"
 
 	"emperror.dev/errors"
-	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/api/mesh/v1alpha1"
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
@@ -54,7 +54,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
-	"sigs.k8s.io/yaml"
 
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	"github.com/banzaicloud/istio-operator/v2/internal/components"
@@ -68,6 +67,7 @@ import (
 	"github.com/banzaicloud/istio-operator/v2/internal/util"
 	"github.com/banzaicloud/istio-operator/v2/internal/util/openshift"
 	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
 	"github.com/banzaicloud/operator-tools/pkg/logger"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
@@ -78,6 +78,7 @@ import (
 const (
 	istioControlPlaneFinalizerID               = "istio-controlplane.servicemesh.cisco.com"
 	meshExpansionGatewayRemovalRequeueDuration = time.Second * 30
+	meshExpansionGatewayName                   = "istio-meshexpansion"
 	readerServiceAccountName                   = "istio-reader"
 	//nolint:gosec
 	readerSecretType = "k8s.cisco.com/istio-reader-secret"
@@ -94,14 +95,21 @@ type IstioControlPlaneReconciler struct {
 	SupportedIstioVersion    string
 	Version                  string
 	Recorder                 record.EventRecorder
-
-	watchersInitOnce sync.Once
-	builder          *ctrlBuilder.Builder
-	ctrl             controller.Controller
+	// ServerSideApply makes the resources of the components applied server-side with a dedicated field manager
+	ServerSideApply bool
+
+	watchersInitOnce       sync.Once
+	builder                *ctrlBuilder.Builder
+	ctrl                   controller.Controller
+	componentCacheInitOnce sync.Once
+	componentCache         *components.Cache
+	driftDetectorInitOnce  sync.Once
+	driftDetector          *components.DriftDetector
+	namespaceDependencies  namespaceDependenciesCache
 }
 
 // +kubebuilder:rbac:groups="",resources=nodes;replicationcontrollers,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;deletecollection;delete;patch;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;deletecollection;delete;patch;update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=configmaps;endpoints;secrets;services;serviceaccounts;resourcequotas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
@@ -129,6 +137,7 @@ type IstioControlPlaneReconciler struct {
 // +kubebuilder:rbac:groups=networking.istio.io;security.istio.io;telemetry.istio.io;authentication.istio.io;config.istio.io;rbac.istio.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="extensions.istio.io",resources=*,verbs=get;list;watch
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiocontrolplanes;peeristiocontrolplanes;istiomeshes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiocontrolplaneprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiocontrolplanes/status;peeristiocontrolplanes/status;istiomeshes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=clusterregistry.k8s.cisco.com,resources=clusters,verbs=list;watch
 // +kubebuilder:rbac:groups=clusterregistry.k8s.cisco.com,resources=resourcesyncrules;clusterfeatures,verbs=get;list;watch;create;update;patch;delete
@@ -153,7 +162,17 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return reconcile.Result{}, err
 	}
 
-	if icp.Spec.Version == "" {
+	spec, err := resolveIstioControlPlaneSpec(ctx, r.GetClient(), icp)
+	if err != nil {
+		updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	if spec.GetVersion() == "" {
 		err = errors.New("please set spec.version in your istiocontrolplane CR to be reconciled by this operator")
 		logger.Error(err, "", "name", icp.Name, "namespace", icp.Namespace)
 
@@ -162,9 +181,9 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}, nil
 	}
 
-	if !IsIstioVersionSupported(icp.Spec.Version) {
+	if !IsIstioVersionSupported(spec.GetVersion()) {
 		err = errors.New("intended Istio version is unsupported by this version of the operator")
-		logger.Error(err, "", "version", icp.Spec.Version)
+		logger.Error(err, "", "version", spec.GetVersion())
 
 		return reconcile.Result{
 			Requeue: false,
@@ -181,7 +200,26 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	result, err := r.reconcile(ctx, icp, logger)
+	// the spec is defaulted during the reconciliation, the health gates are tracked for the spec as applied
+	appliedSpec := spec.DeepCopy()
+	resourceSpec := icp.GetSpec().DeepCopy()
+
+	result, err := r.reconcile(ctx, icp, spec, logger)
+
+	gatesResult, gatesErr := r.reconcileHealthGates(ctx, icp, appliedSpec, resourceSpec, err)
+	if gatesErr != nil {
+		logger.Error(gatesErr, "failed to reconcile health gates")
+		if err == nil {
+			err = gatesErr
+		}
+	}
+	if gatesResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || gatesResult.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = gatesResult.RequeueAfter
+	}
+
+	// the resolved and defaulted spec is only used in memory, the stored spec is restored before the resource is updated
+	icp.Spec = resourceSpec
+
 	if err != nil {
 		updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
 		if updateErr != nil {
@@ -197,7 +235,8 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return result, err
 	}
 
-	updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Available), "")
+	state, message := controlPlaneState(icp)
+	updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(state), message)
 	if updateErr != nil && !k8serrors.IsNotFound(updateErr) {
 		logger.Error(updateErr, "failed to update state")
 
@@ -212,7 +251,7 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	return result, nil
 }
 
-func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (ctrl.Result, error) {
+func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, spec *servicemeshv1alpha1.IstioControlPlaneSpec, logger logger.Logger) (ctrl.Result, error) {
 	logger.Info("reconciling")
 
 	// Get a config to talk to the apiserver
@@ -226,20 +265,30 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return ctrl.Result{}, err
 	}
 
+	// the resolved spec is only used in memory, the stored spec keeps referencing the profile
+	if icp.GetSpec().GetProfile() != "" {
+		icp.GetStatus().ResolvedSpec = spec.DeepCopy()
+	} else {
+		icp.GetStatus().ResolvedSpec = nil
+	}
+	icp.Spec = spec
+
+	previousDrifts := icp.GetStatus().GetDrifts()
+	if err := r.getDriftDetector().Begin(icp, icp.GetSpec().GetDriftPolicies()); err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		icp.GetStatus().Drifts = r.getDriftDetector().End(icp)
+		recordResourceDrifts(r.Recorder, icp, previousDrifts, icp.GetStatus().Drifts)
+	}()
+
 	istioMesh, err := r.getRelatedIstioMesh(ctx, r.Client, icp, logger)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
-		baseComponent, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-			return base.NewComponentReconciler(helmReconciler, r.Log.WithName("base"), r.SupportedIstioVersion)
-		}, r.Log.WithName("base"))
-		if err != nil {
-			return ctrl.Result{}, err
-		}
-
-		result, err := baseComponent.Reconcile(icp)
+	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE && icp.DeletionTimestamp.IsZero() && !adoptionReportOnly(icp) {
+		result, err := r.reconcileBaseComponent(icp)
 		if err != nil {
 			return result, err
 		}
@@ -262,6 +311,11 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 	// set cluster ID to status as it is not always in the stored spec
 	icp.GetStatus().ClusterID = icp.Spec.ClusterID
 
+	err = r.setRegionToStatus(ctx, icp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	meshNetworks, err := r.getMeshNetworks(ctx, icp)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -272,8 +326,8 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return ctrl.Result{}, err
 	}
 
-	discoveryReconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return discovery_component.NewChartReconciler(helmReconciler, servicemeshv1alpha1.IstioControlPlaneProperties{
+	discoveryReconciler, err := NewComponentReconciler(r, "discovery", func(helmReconciler *components.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
+		return discovery_component.NewChartReconciler(helmReconciler, cache, servicemeshv1alpha1.IstioControlPlaneProperties{
 			Mesh:                         istioMesh,
 			MeshNetworks:                 meshNetworks,
 			TrustedRootCACertificatePEMs: trustedCACertificates,
@@ -282,40 +336,64 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+
+	err = r.validateInjectionTemplates(icp, discoveryReconciler)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 	componentReconcilers = append(componentReconcilers, discoveryReconciler)
 
-	cniReconciler, err := NewComponentReconciler(r, cni.NewChartReconciler, r.Log.WithName("cni"))
+	cniReconciler, err := NewComponentReconciler(r, "cni", cni.NewChartReconciler, r.Log.WithName("cni"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	componentReconcilers = append(componentReconcilers, cniReconciler)
 
-	meshExpansionReconciler, err := NewComponentReconciler(r, meshexpansion.NewChartReconciler, r.Log.WithName("meshexpansion"))
+	meshExpansionReconciler, err := NewComponentReconciler(r, "meshexpansion", meshexpansion.NewChartReconciler, r.Log.WithName("meshexpansion"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	componentReconcilers = append(componentReconcilers, meshExpansionReconciler)
 
-	sidecarInjectorReconciler, err := NewComponentReconciler(r, sidecarinjector.NewChartReconciler, r.Log.WithName("sidecarInjector"))
+	sidecarInjectorReconciler, err := NewComponentReconciler(r, "sidecarInjector", sidecarinjector.NewChartReconciler, r.Log.WithName("sidecarInjector"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	componentReconcilers = append(componentReconcilers, sidecarInjectorReconciler)
 
-	resourceSyncRuleReconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return resourcesyncrule.NewChartReconciler(helmReconciler, r.ClusterRegistry.ResourceSyncRules.Enabled)
+	resourceSyncRuleReconciler, err := NewComponentReconciler(r, "resourcesyncrule", func(helmReconciler *components.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
+		return resourcesyncrule.NewChartReconciler(helmReconciler, cache, r.ClusterRegistry.ResourceSyncRules.Enabled)
 	}, r.Log.WithName("resourcesyncrule"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	componentReconcilers = append(componentReconcilers, resourceSyncRuleReconciler)
 
-	var result ctrl.Result
-	for _, r := range componentReconcilers {
-		result, err = r.Reconcile(icp)
-		if err != nil {
-			return result, err
-		}
+	addonReconcilers, err := r.getAddonComponentReconcilers(ctx, icp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	componentReconcilers = append(componentReconcilers, addonReconcilers...)
+
+	err = r.reconcileAdoption(ctx, icp, componentReconcilers)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// nothing is changed until the existing installation is adopted
+	if adoptionReportOnly(icp) {
+		return ctrl.Result{
+			RequeueAfter: adoptionReportRequeueDuration,
+		}, nil
+	}
+
+	result, err := components.ReconcileAll(icp, componentReconcilers)
+	if !icp.DeletionTimestamp.IsZero() {
+		r.GetComponentCache().Forget(icp.GetUID())
+	}
+	icp.GetStatus().ComponentValuesHashes = r.GetComponentCache().Hashes(icp.GetUID())
+	if err != nil {
+		return result, err
 	}
 
 	err = r.deleteIstioRootCAConfigmapsOnPassive(ctx, icp, logger)
@@ -333,6 +411,26 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return result, err
 	}
 
+	err = r.reconcileDefaultSidecars(ctx, icp)
+	if err != nil {
+		return result, err
+	}
+
+	if icp.GetSpec().GetDefaultSidecar().GetLearningMode().GetEnabled().GetValue() && result.RequeueAfter == 0 {
+		// periodically learn the dependencies of the namespaces
+		result.RequeueAfter = sidecarLearningModeRequeueDuration
+	}
+
+	err = r.reconcileMTLS(ctx, icp)
+	if err != nil {
+		return result, err
+	}
+
+	if hasPendingStrictMTLSNamespace(icp) && result.RequeueAfter == 0 {
+		// periodically verify whether the pending namespaces can be migrated
+		result.RequeueAfter = mtlsMigrationRequeueDuration
+	}
+
 	err = r.reconcileIstiodEndpoint(ctx, icp)
 	if err != nil {
 		return result, err
@@ -343,6 +441,16 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return result, err
 	}
 
+	err = r.reconcileLocalityFailoverDestinationRule(ctx, icp)
+	if err != nil {
+		return result, err
+	}
+
+	err = r.reconcileEgress(ctx, icp)
+	if err != nil {
+		return result, err
+	}
+
 	// icp is marked for deletion
 	if !icp.DeletionTimestamp.IsZero() {
 		err = r.waitForMeshExpansionGatewayRemoval(ctx, icp)
@@ -357,6 +465,13 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 			return result, err
 		}
 
+		deleteInjectionSelfTestMetrics(icp)
+
+		// the base resources (CRDs) are removed last, after every component depending on them is gone
+		if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+			return r.reconcileBaseComponent(icp)
+		}
+
 		return result, nil
 	}
 
@@ -365,6 +480,11 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return result, err
 	}
 
+	if selfTestRequeueAfter := r.reconcileInjectionSelfTest(ctx, icp); selfTestRequeueAfter > 0 && result.RequeueAfter == 0 {
+		// periodically test the sidecar injection
+		result.RequeueAfter = selfTestRequeueAfter
+	}
+
 	r.setControlPlaneNameToStatus(icp)
 
 	err = r.setMeshConfigToStatus(ctx, icp)
@@ -399,10 +519,34 @@ func (r *IstioControlPlaneReconciler) GetClient() client.Client {
 	return r.Client
 }
 
+func (r *IstioControlPlaneReconciler) GetResourceClient() client.Client {
+	if r.ServerSideApply {
+		return components.AdoptionClient(r.getDriftDetector().Client(pkgUtil.NewServerSideApplyClient(r.Client, pkgUtil.FieldManager, pkgUtil.WebhookFailurePolicyField)))
+	}
+
+	return components.AdoptionClient(r.getDriftDetector().Client(r.Client))
+}
+
+func (r *IstioControlPlaneReconciler) getDriftDetector() *components.DriftDetector {
+	r.driftDetectorInitOnce.Do(func() {
+		r.driftDetector = components.NewDriftDetector()
+	})
+
+	return r.driftDetector
+}
+
 func (r *IstioControlPlaneReconciler) GetScheme() *runtime.Scheme {
 	return r.Scheme
 }
 
+func (r *IstioControlPlaneReconciler) GetComponentCache() *components.Cache {
+	r.componentCacheInitOnce.Do(func() {
+		r.componentCache = components.NewCache()
+	})
+
+	return r.componentCache
+}
+
 func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.builder = ctrl.NewControllerManagedBy(mgr)
 
@@ -667,7 +811,45 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 			resources := make([]reconcile.Request, 0)
 			for _, icp := range icps.Items {
-				if imesh.GetName() == icp.GetSpec().GetMeshID() {
+				if imesh.GetName() == icp.GetResolvedSpec().GetMeshID() {
+					resources = append(resources, reconcile.Request{
+						NamespacedName: client.ObjectKey{
+							Name:      icp.GetName(),
+							Namespace: icp.GetNamespace(),
+						},
+					})
+				}
+			}
+
+			return resources
+		}),
+		objectChangePredicate,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = r.ctrl.Watch(
+		&source.Kind{
+			Type: &servicemeshv1alpha1.IstioControlPlaneProfile{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "IstioControlPlaneProfile",
+					APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+				},
+			},
+		},
+		handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			icps := &servicemeshv1alpha1.IstioControlPlaneList{}
+			err := r.Client.List(context.Background(), icps)
+			if err != nil {
+				r.Log.Error(err, "could not list Istio control plane resources")
+
+				return nil
+			}
+
+			resources := make([]reconcile.Request, 0)
+			for _, icp := range icps.Items {
+				if obj.GetName() == icp.GetSpec().GetProfile() {
 					resources = append(resources, reconcile.Request{
 						NamespacedName: client.ObjectKey{
 							Name:      icp.GetName(),
@@ -721,7 +903,10 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 			return nil
 		}),
-		util.NamespaceRevisionLabelChange{},
+		predicate.Or(
+			util.NamespaceRevisionLabelChange{},
+			util.NamespaceSidecarEgressAnnotationChange{},
+		),
 	)
 	if err != nil {
 		return err
@@ -772,10 +957,18 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
+// isActive checks whether the control plane is in ACTIVE mode. The Istio resources are only managed for those,
+// as the Istio CRDs are only installed by the base component of ACTIVE control planes.
+func isActive(icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	return icp.GetResolvedSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE
+}
+
 type ControlPlane interface {
 	GetName() string
 	GetStatus() *servicemeshv1alpha1.IstioControlPlaneStatus
 	GetSpec() *servicemeshv1alpha1.IstioControlPlaneSpec
+	GetResolvedSpec() *servicemeshv1alpha1.IstioControlPlaneSpec
+	GetNetworkName() string
 }
 
 type SortableControlPlanes []ControlPlane
@@ -805,7 +998,7 @@ func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Con
 
 	for _, picp := range picpList.Items {
 		picp := picp
-		if picp.GetStatus().IstioControlPlaneName == icp.GetName() && picp.Spec.Mode == servicemeshv1alpha1.ModeType_ACTIVE {
+		if picp.GetStatus().IstioControlPlaneName == icp.GetName() && picp.GetResolvedSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
 			cps = append(cps, &picp)
 		}
 	}
@@ -821,9 +1014,7 @@ func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Con
 	return certData, nil
 }
 
-func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (*v1alpha1.MeshNetworks, error) {
-	networks := make(map[string]*v1alpha1.Network)
-
+func (r *IstioControlPlaneReconciler) getMeshNetworkControlPlanes(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (SortableControlPlanes, error) {
 	cps := make(SortableControlPlanes, 0)
 	cps = append(cps, icp)
 
@@ -842,19 +1033,57 @@ func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *
 
 	sort.Sort(cps)
 
+	return cps, nil
+}
+
+func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (*v1alpha1.MeshNetworks, error) {
+	networks := make(map[string]*v1alpha1.Network)
+
+	cps, err := r.getMeshNetworkControlPlanes(ctx, icp)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, cp := range cps {
+		networkConfig := cp.GetResolvedSpec().GetNetwork()
+
+		port := uint32(defaultMeshNetworkGatewayPort)
+		if networkConfig.GetGatewayPort() != nil {
+			port = networkConfig.GetGatewayPort().GetValue()
+		}
+
 		gateways := make([]*v1alpha1.Network_IstioNetworkGateway, 0)
-		sort.Strings(cp.GetStatus().GatewayAddress)
-		for _, address := range cp.GetStatus().GatewayAddress {
+		if serviceName := networkConfig.GetGatewayRegistryServiceName(); serviceName != "" {
 			gateways = append(gateways, &v1alpha1.Network_IstioNetworkGateway{
-				Gw: &v1alpha1.Network_IstioNetworkGateway_Address{
-					Address: address,
+				Gw: &v1alpha1.Network_IstioNetworkGateway_RegistryServiceName{
+					RegistryServiceName: serviceName,
 				},
-				Port: 15443, //nolint:gomnd
+				Port:     port,
+				Locality: cp.GetStatus().GetRegion(),
 			})
+		} else {
+			// only the gateways exposing the cluster services are reachable from the other networks,
+			// hostnames are preferred over the resolved addresses of a gateway as the latter could change any time
+			addresses := make([]string, 0)
+			for _, gw := range cp.GetStatus().ClusterServicesGateways() {
+				if len(gw.GetGatewayHostnames()) > 0 {
+					addresses = append(addresses, gw.GetGatewayHostnames()...)
+				} else {
+					addresses = append(addresses, gw.GetGatewayAddress()...)
+				}
+			}
+			for _, address := range uniqueSortedStrings(addresses) {
+				gateways = append(gateways, &v1alpha1.Network_IstioNetworkGateway{
+					Gw: &v1alpha1.Network_IstioNetworkGateway_Address{
+						Address: address,
+					},
+					Port:     port,
+					Locality: cp.GetStatus().GetRegion(),
+				})
+			}
 		}
 
-		networkName := cp.GetSpec().GetNetworkName()
+		networkName := cp.GetNetworkName()
 		if networks[networkName] == nil {
 			networks[networkName] = &v1alpha1.Network{}
 		}
@@ -907,7 +1136,7 @@ func (r *IstioControlPlaneReconciler) reconcileIstiodEndpoint(ctx context.Contex
 	serviceName := icp.WithRevision("istiod")
 	serviceNamespace := icp.GetNamespace()
 
-	istiodEndpointAddresses, err := k8sutil.GetIstiodEndpointAddresses(ctx, r.Client, icp.GetName(), icp.GetSpec().GetNetworkName(), serviceNamespace)
+	istiodEndpointAddresses, err := k8sutil.GetIstiodEndpointAddresses(ctx, r.Client, icp.GetName(), icp.GetNetworkName(), serviceNamespace)
 	if err != nil {
 		return errors.WithStackIf(err)
 	}
@@ -975,6 +1204,17 @@ func (r *IstioControlPlaneReconciler) reconcileClusterReaderSecret(ctx context.C
 	return errors.WithStackIf(err)
 }
 
+func (r *IstioControlPlaneReconciler) reconcileBaseComponent(icp *servicemeshv1alpha1.IstioControlPlane) (ctrl.Result, error) {
+	baseComponent, err := NewComponentReconciler(r, "base", func(helmReconciler *components.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
+		return base.NewComponentReconciler(helmReconciler, cache, r.Log.WithName("base"), r.SupportedIstioVersion)
+	}, r.Log.WithName("base"))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return baseComponent.Reconcile(icp)
+}
+
 func (r *IstioControlPlaneReconciler) removeFinalizerFromRelatedMeshGateways(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
 	r.Log.Info("remove finalizers from related meshgateways")
 	var imgws servicemeshv1alpha1.IstioMeshGatewayList
@@ -1037,6 +1277,8 @@ func (r *IstioControlPlaneReconciler) setMeshExpansionGWAddressToStatus(ctx cont
 	getMeshExpansionEnabled := icp.GetSpec().GetMeshExpansion().GetEnabled().GetValue()
 	if icp.DeletionTimestamp.IsZero() && !utils.PointerToBool(&getMeshExpansionEnabled) {
 		icp.GetStatus().GatewayAddress = nil
+		icp.GetStatus().GatewayHostnames = nil
+		icp.GetStatus().MeshExpansionGateways = nil
 
 		return nil
 	}
@@ -1055,20 +1297,100 @@ func (r *IstioControlPlaneReconciler) setMeshExpansionGWAddressToStatus(ctx cont
 		return errors.New("could not find mesh expansion gateway")
 	}
 
-	if len(l.Items) > 1 {
-		return errors.New("multiple mesh expansion gateways were found")
+	sort.Slice(l.Items, func(i, j int) bool {
+		return l.Items[i].GetName() < l.Items[j].GetName()
+	})
+
+	// the addresses of every available gateway are aggregated, each gateway reports its own readiness
+	// and what it exposes, so that the consumers could pick the gateways by their role
+	exposures := getMeshExpansionGatewayExposures(icp)
+	addresses := make([]string, 0)
+	hostnames := make([]string, 0)
+	statuses := make([]*servicemeshv1alpha1.MeshExpansionGatewayStatus, 0, len(l.Items))
+	var notAvailableErr error
+	for _, imgw := range l.Items {
+		exposure, ok := exposures[imgw.GetName()]
+		if !ok {
+			// gateways not rendered from the mesh expansion configuration are expected to expose everything
+			exposure = meshExpansionGatewayExposure{istiod: true, webhook: true, clusterServices: true}
+		}
+
+		statuses = append(statuses, &servicemeshv1alpha1.MeshExpansionGatewayStatus{
+			Name:                  imgw.GetName(),
+			Status:                imgw.GetStatus().Status,
+			ErrorMessage:          imgw.GetStatus().ErrorMessage,
+			GatewayAddress:        imgw.GetStatus().GatewayAddress,
+			GatewayHostnames:      imgw.GetStatus().GatewayHostnames,
+			ExposeIstiod:          exposure.istiod,
+			ExposeWebhook:         exposure.webhook,
+			ExposeClusterServices: exposure.clusterServices,
+		})
+
+		if imgw.GetStatus().Status != servicemeshv1alpha1.ConfigState_Available {
+			message := imgw.GetStatus().ErrorMessage
+			if message == "" {
+				message = "mesh expansion gateway is not available"
+			}
+			notAvailableErr = errors.Append(notAvailableErr, errors.NewWithDetails(message, "gateway", imgw.GetName()))
+
+			continue
+		}
+
+		addresses = append(addresses, imgw.GetStatus().GatewayAddress...)
+		hostnames = append(hostnames, imgw.GetStatus().GatewayHostnames...)
 	}
 
-	imgw := l.Items[0]
-	if imgw.GetStatus().Status != servicemeshv1alpha1.ConfigState_Available {
-		return errors.New(imgw.GetStatus().ErrorMessage)
+	icp.GetStatus().MeshExpansionGateways = statuses
+
+	if len(addresses) == 0 && len(hostnames) == 0 && notAvailableErr != nil {
+		return notAvailableErr
 	}
 
-	icp.GetStatus().GatewayAddress = imgw.GetStatus().GatewayAddress
+	icp.GetStatus().GatewayAddress = uniqueSortedStrings(addresses)
+	icp.GetStatus().GatewayHostnames = uniqueSortedStrings(hostnames)
 
 	return nil
 }
 
+type meshExpansionGatewayExposure struct {
+	istiod          bool
+	webhook         bool
+	clusterServices bool
+}
+
+// getMeshExpansionGatewayExposures returns what the mesh expansion gateways expose keyed by the gateway names,
+// the same way as the gateways are rendered by the mesh expansion component
+func getMeshExpansionGatewayExposures(icp *servicemeshv1alpha1.IstioControlPlane) map[string]meshExpansionGatewayExposure {
+	meshExpansion := icp.GetSpec().GetMeshExpansion()
+	expose := func(value, fallback *wrapperspb.BoolValue) bool {
+		if value != nil {
+			return value.GetValue()
+		}
+		if fallback != nil {
+			return fallback.GetValue()
+		}
+
+		return true
+	}
+
+	exposures := map[string]meshExpansionGatewayExposure{
+		icp.WithRevision(meshExpansionGatewayName): {
+			istiod:          expose(meshExpansion.GetIstiod().GetExpose(), nil),
+			webhook:         expose(meshExpansion.GetWebhook().GetExpose(), nil),
+			clusterServices: expose(meshExpansion.GetClusterServices().GetExpose(), nil),
+		},
+	}
+	for _, gw := range meshExpansion.GetAdditionalGateways() {
+		exposures[icp.WithRevision(fmt.Sprintf("%s-%s", meshExpansionGatewayName, gw.GetName()))] = meshExpansionGatewayExposure{
+			istiod:          expose(gw.GetIstiod().GetExpose(), meshExpansion.GetIstiod().GetExpose()),
+			webhook:         expose(gw.GetWebhook().GetExpose(), meshExpansion.GetWebhook().GetExpose()),
+			clusterServices: expose(gw.GetClusterServices().GetExpose(), meshExpansion.GetClusterServices().GetExpose()),
+		}
+	}
+
+	return exposures
+}
+
 func (r *IstioControlPlaneReconciler) setControlPlaneNameToStatus(icp *servicemeshv1alpha1.IstioControlPlane) {
 	icp.GetStatus().IstioControlPlaneName = icp.GetName()
 }
@@ -1084,20 +1406,13 @@ func (r *IstioControlPlaneReconciler) setMeshConfigToStatus(ctx context.Context,
 		return nil
 	}
 
-	var mc v1alpha1.MeshConfig
-
 	mcYAML := configmaps.Items[0].Data["mesh"]
-	mcJSON, err := yaml.YAMLToJSON([]byte(mcYAML))
-	if err != nil {
-		return errors.WithStackIf(err)
-	}
-
-	err = jsonpb.UnmarshalString(string(mcJSON), &mc)
+	mc, err := parseMeshConfig(mcYAML)
 	if err != nil {
-		return errors.WithStackIf(err)
+		return err
 	}
 
-	icp.GetStatus().MeshConfig = &mc
+	icp.GetStatus().MeshConfig = mc
 
 	cs := icp.GetStatus().GetChecksums()
 	if cs == nil {
@@ -1141,14 +1456,28 @@ func (r *IstioControlPlaneReconciler) setInjectionNamespacesToStatus(ctx context
 		return errors.WrapIf(err, "could not list namespaces")
 	}
 
+	selectors, err := discoverySelectors(icp)
+	if err != nil {
+		return err
+	}
+
 	names := []string{}
+	undiscovered := []string{}
 	for _, ns := range namespaces.Items {
+		ns := ns
+		if !namespaceDiscovered(selectors, &ns) {
+			undiscovered = append(undiscovered, ns.GetName())
+
+			continue
+		}
 		names = append(names, ns.GetName())
 	}
 
 	sort.Strings(names)
+	sort.Strings(undiscovered)
 
 	icp.GetStatus().InjectionNamespaces = names
+	setDiscoveryCondition(icp, selectors, undiscovered)
 
 	return nil
 }
@@ -1294,6 +1623,11 @@ func (r *IstioControlPlaneReconciler) reconcileNamespaceInjectionLabels(ctx cont
 		return nil
 	}
 
+	selectors, err := discoverySelectors(icp)
+	if err != nil {
+		return err
+	}
+
 	r.Log.Info("sync namespace injection labels")
 
 	namespaces := make(map[string]struct{})
@@ -1359,6 +1693,11 @@ func (r *IstioControlPlaneReconciler) reconcileNamespaceInjectionLabels(ctx cont
 		if err != nil {
 			return errors.WrapIfWithDetails(err, "could not get namespace", "namespace", name)
 		}
+		if !namespaceDiscovered(selectors, ns) {
+			r.Log.Info("namespace is outside the discovery selectors, injection label is not added", "namespace", name)
+
+			continue
+		}
 		labels := utils.MergeLabels(ns.GetLabels(), icp.RevisionLabels())
 		delete(labels, servicemeshv1alpha1.DeprecatedAutoInjectionLabel)
 		ns.SetLabels(labels)
@@ -1422,6 +1761,30 @@ func (r *IstioControlPlaneReconciler) watchIstioCRs() error {
 				APIVersion: istiosecurityv1beta1.SchemeGroupVersion.String(),
 			},
 		},
+		&istionetworkingv1alpha3.DestinationRule{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DestinationRule",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.ServiceEntry{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ServiceEntry",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Gateway",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.VirtualService{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualService",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
 	}
 
 	for _, t := range types {
@@ -1431,12 +1794,22 @@ func (r *IstioControlPlaneReconciler) watchIstioCRs() error {
 		}
 	}
 
-	return nil
+	// the default sidecar of a namespace is replaced by the namespace wide sidecar of the user
+	return r.ctrl.Watch(&source.Kind{
+		Type: &istionetworkingv1alpha3.Sidecar{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Sidecar",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+	}, handler.EnqueueRequestsFromMapFunc(r.getDefaultSidecarControlPlanes), util.ObjectChangePredicate{Logger: r.Log})
 }
 
+// RemoveFinalizers removes the operator's finalizers from every control plane and mesh gateway. It must only be
+// used as the last step of an uninstall, otherwise the cleanup of the resources would be skipped on deletion.
 func RemoveFinalizers(ctx context.Context, c client.Client) error {
 	var icps servicemeshv1alpha1.IstioControlPlaneList
-	err := c.List(context.Background(), &icps)
+	err := c.List(ctx, &icps)
 	if err != nil {
 		return errors.WrapIf(err, "could not list Istio control plane resources")
 	}
@@ -1450,7 +1823,7 @@ func RemoveFinalizers(ctx context.Context, c client.Client) error {
 	}
 
 	var imgws servicemeshv1alpha1.IstioMeshGatewayList
-	err = c.List(context.Background(), &imgws)
+	err = c.List(ctx, &imgws)
 	if err != nil {
 		return errors.WrapIf(err, "could not list istio mesh gateway resources")
 	}
@@ -0,0 +1,328 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	"istio.io/api/mesh/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func newMeshExpansionTestControlPlane() *servicemeshv1alpha1.IstioControlPlane {
+	return &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x",
+			Namespace: "istio-system",
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			ClusterID: "cluster1",
+			MeshExpansion: &servicemeshv1alpha1.MeshExpansionConfiguration{
+				Enabled: wrapperspb.Bool(true),
+				ClusterServices: &servicemeshv1alpha1.MeshExpansionConfiguration_ClusterServices{
+					Expose: wrapperspb.Bool(false),
+				},
+				AdditionalGateways: []*servicemeshv1alpha1.MeshExpansionConfiguration_AdditionalIstioMeshGatewayConfiguration{
+					{
+						Name: "east",
+						Istiod: &servicemeshv1alpha1.MeshExpansionConfiguration_Istiod{
+							Expose: wrapperspb.Bool(false),
+						},
+						Webhook: &servicemeshv1alpha1.MeshExpansionConfiguration_Webhook{
+							Expose: wrapperspb.Bool(false),
+						},
+						ClusterServices: &servicemeshv1alpha1.MeshExpansionConfiguration_ClusterServices{
+							Expose: wrapperspb.Bool(true),
+						},
+					},
+					{
+						Name: "west",
+						ClusterServices: &servicemeshv1alpha1.MeshExpansionConfiguration_ClusterServices{
+							Expose: wrapperspb.Bool(true),
+						},
+					},
+				},
+			},
+		},
+		Status: &servicemeshv1alpha1.IstioControlPlaneStatus{},
+	}
+}
+
+func newMeshExpansionTestGateway(icp *servicemeshv1alpha1.IstioControlPlane, name string, status servicemeshv1alpha1.ConfigState, addresses, hostnames []string) *servicemeshv1alpha1.IstioMeshGateway {
+	return &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: icp.GetNamespace(),
+			Labels:    icp.MeshExpansionGatewayLabels(),
+		},
+		Status: &servicemeshv1alpha1.IstioMeshGatewayStatus{
+			Status:           status,
+			GatewayAddress:   addresses,
+			GatewayHostnames: hostnames,
+		},
+	}
+}
+
+func TestGetMeshExpansionGatewayExposures(t *testing.T) {
+	t.Parallel()
+
+	exposures := getMeshExpansionGatewayExposures(newMeshExpansionTestControlPlane())
+
+	assert.DeepEqual(t, exposures, map[string]meshExpansionGatewayExposure{
+		"istio-meshexpansion-cp-v117x":      {istiod: true, webhook: true, clusterServices: false},
+		"istio-meshexpansion-east-cp-v117x": {istiod: false, webhook: false, clusterServices: true},
+		"istio-meshexpansion-west-cp-v117x": {istiod: true, webhook: true, clusterServices: true},
+	}, cmp.AllowUnexported(meshExpansionGatewayExposure{}))
+}
+
+func TestSetMeshExpansionGWAddressToStatus(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, servicemeshv1alpha1.AddToScheme(scheme))
+
+	testCases := []struct {
+		name      string
+		gateways  func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object
+		statuses  []*servicemeshv1alpha1.MeshExpansionGatewayStatus
+		addresses []string
+		hostnames []string
+		err       string
+	}{
+		{
+			name: "no gateways",
+			gateways: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				return nil
+			},
+			err: "could not find mesh expansion gateway",
+		},
+		{
+			name: "available gateways are aggregated with their exposures",
+			gateways: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				return []client.Object{
+					newMeshExpansionTestGateway(icp, "istio-meshexpansion-cp-v117x", servicemeshv1alpha1.ConfigState_Available, []string{"10.0.0.2", "10.0.0.1"}, nil),
+					newMeshExpansionTestGateway(icp, "istio-meshexpansion-east-cp-v117x", servicemeshv1alpha1.ConfigState_Available, []string{"10.0.0.1"}, []string{"east.example.com"}),
+				}
+			},
+			statuses: []*servicemeshv1alpha1.MeshExpansionGatewayStatus{
+				{
+					Name:           "istio-meshexpansion-cp-v117x",
+					Status:         servicemeshv1alpha1.ConfigState_Available,
+					GatewayAddress: []string{"10.0.0.2", "10.0.0.1"},
+					ExposeIstiod:   true,
+					ExposeWebhook:  true,
+				},
+				{
+					Name:                  "istio-meshexpansion-east-cp-v117x",
+					Status:                servicemeshv1alpha1.ConfigState_Available,
+					GatewayAddress:        []string{"10.0.0.1"},
+					GatewayHostnames:      []string{"east.example.com"},
+					ExposeClusterServices: true,
+				},
+			},
+			addresses: []string{"10.0.0.1", "10.0.0.2"},
+			hostnames: []string{"east.example.com"},
+		},
+		{
+			name: "unavailable gateways report their own readiness",
+			gateways: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				unavailable := newMeshExpansionTestGateway(icp, "istio-meshexpansion-west-cp-v117x", servicemeshv1alpha1.ConfigState_ReconcileFailed, []string{"10.0.0.3"}, nil)
+				unavailable.Status.ErrorMessage = "some error"
+
+				return []client.Object{
+					newMeshExpansionTestGateway(icp, "istio-meshexpansion-east-cp-v117x", servicemeshv1alpha1.ConfigState_Available, []string{"10.0.0.1"}, nil),
+					unavailable,
+				}
+			},
+			statuses: []*servicemeshv1alpha1.MeshExpansionGatewayStatus{
+				{
+					Name:                  "istio-meshexpansion-east-cp-v117x",
+					Status:                servicemeshv1alpha1.ConfigState_Available,
+					GatewayAddress:        []string{"10.0.0.1"},
+					ExposeClusterServices: true,
+				},
+				{
+					Name:                  "istio-meshexpansion-west-cp-v117x",
+					Status:                servicemeshv1alpha1.ConfigState_ReconcileFailed,
+					ErrorMessage:          "some error",
+					GatewayAddress:        []string{"10.0.0.3"},
+					ExposeIstiod:          true,
+					ExposeWebhook:         true,
+					ExposeClusterServices: true,
+				},
+			},
+			addresses: []string{"10.0.0.1"},
+			hostnames: []string{},
+		},
+		{
+			name: "no available gateways",
+			gateways: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				return []client.Object{
+					newMeshExpansionTestGateway(icp, "istio-meshexpansion-cp-v117x", servicemeshv1alpha1.ConfigState_Reconciling, nil, nil),
+				}
+			},
+			statuses: []*servicemeshv1alpha1.MeshExpansionGatewayStatus{
+				{
+					Name:          "istio-meshexpansion-cp-v117x",
+					Status:        servicemeshv1alpha1.ConfigState_Reconciling,
+					ExposeIstiod:  true,
+					ExposeWebhook: true,
+				},
+			},
+			err: "mesh expansion gateway is not available",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			icp := newMeshExpansionTestControlPlane()
+			r := &IstioControlPlaneReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.gateways(icp)...).Build(),
+			}
+
+			err := r.setMeshExpansionGWAddressToStatus(context.Background(), icp)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+			} else {
+				assert.NilError(t, err)
+				assert.DeepEqual(t, icp.GetStatus().GatewayAddress, tc.addresses)
+				assert.DeepEqual(t, icp.GetStatus().GatewayHostnames, tc.hostnames)
+			}
+			assert.DeepEqual(t, icp.GetStatus().MeshExpansionGateways, tc.statuses, protocmp.Transform())
+		})
+	}
+}
+
+func TestGetMeshNetworks(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, servicemeshv1alpha1.AddToScheme(scheme))
+
+	icp := newMeshExpansionTestControlPlane()
+	icp.Status = &servicemeshv1alpha1.IstioControlPlaneStatus{
+		ClusterID:      "cluster1",
+		GatewayAddress: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		MeshExpansionGateways: []*servicemeshv1alpha1.MeshExpansionGatewayStatus{
+			{
+				Name:           "istio-meshexpansion-cp-v117x",
+				Status:         servicemeshv1alpha1.ConfigState_Available,
+				GatewayAddress: []string{"10.0.0.1"},
+				ExposeIstiod:   true,
+				ExposeWebhook:  true,
+			},
+			{
+				Name:                  "istio-meshexpansion-east-cp-v117x",
+				Status:                servicemeshv1alpha1.ConfigState_Available,
+				GatewayAddress:        []string{"10.0.0.2"},
+				GatewayHostnames:      []string{"east.example.com"},
+				ExposeClusterServices: true,
+			},
+			{
+				Name:                  "istio-meshexpansion-west-cp-v117x",
+				Status:                servicemeshv1alpha1.ConfigState_Available,
+				GatewayAddress:        []string{"10.0.0.3"},
+				ExposeClusterServices: true,
+			},
+		},
+	}
+
+	// peers reported by an operator without per gateway statuses use the aggregated addresses,
+	// and their network configuration is resolved from their profiles
+	peer := &servicemeshv1alpha1.PeerIstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-v117x-cluster2",
+			Namespace: icp.GetNamespace(),
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			ClusterID: "cluster2",
+			Profile:   "multi-network",
+		},
+		Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+			IstioControlPlaneName: icp.GetName(),
+			ClusterID:             "cluster2",
+			GatewayAddress:        []string{"10.1.0.1"},
+			ResolvedSpec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				ClusterID:   "cluster2",
+				Profile:     "multi-network",
+				NetworkName: "network2",
+				Network: &servicemeshv1alpha1.NetworkConfiguration{
+					GatewayPort: wrapperspb.UInt32(15444),
+				},
+			},
+		},
+	}
+
+	r := &IstioControlPlaneReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(peer).Build(),
+	}
+
+	meshNetworks, err := r.getMeshNetworks(context.Background(), icp)
+	assert.NilError(t, err)
+
+	gateway := func(address string, port uint32) *v1alpha1.Network_IstioNetworkGateway {
+		return &v1alpha1.Network_IstioNetworkGateway{
+			Gw: &v1alpha1.Network_IstioNetworkGateway_Address{
+				Address: address,
+			},
+			Port: port,
+		}
+	}
+	endpoints := func(clusterID string) []*v1alpha1.Network_NetworkEndpoints {
+		return []*v1alpha1.Network_NetworkEndpoints{
+			{
+				Ne: &v1alpha1.Network_NetworkEndpoints_FromRegistry{
+					FromRegistry: clusterID,
+				},
+			},
+		}
+	}
+
+	assert.DeepEqual(t, meshNetworks, &v1alpha1.MeshNetworks{
+		Networks: map[string]*v1alpha1.Network{
+			servicemeshv1alpha1.DefaultNetworkName: {
+				Endpoints: endpoints("cluster1"),
+				Gateways: []*v1alpha1.Network_IstioNetworkGateway{
+					gateway("10.0.0.3", defaultMeshNetworkGatewayPort),
+					gateway("east.example.com", defaultMeshNetworkGatewayPort),
+				},
+			},
+			"network2": {
+				Endpoints: endpoints("cluster2"),
+				Gateways: []*v1alpha1.Network_IstioNetworkGateway{
+					gateway("10.1.0.1", 15444),
+				},
+			},
+		},
+	}, protocmp.Transform())
+}
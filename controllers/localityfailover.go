@@ -0,0 +1,189 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+const (
+	defaultMeshNetworkGatewayPort       = 15443
+	defaultClusterDomain                = "cluster.local"
+	localityFailoverDestinationRuleName = "locality-failover"
+
+	// outlier detection is required for locality failover to take effect
+	localityFailoverConsecutive5xxErrors = 5
+	localityFailoverInterval             = time.Second * 10
+	localityFailoverBaseEjectionTime     = time.Second * 30
+)
+
+func (r *IstioControlPlaneReconciler) setRegionToStatus(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if region := icp.GetSpec().GetNetwork().GetRegion(); region != "" {
+		icp.GetStatus().Region = region
+
+		return nil
+	}
+
+	region, err := k8sutil.GetRegionOfNodes(ctx, r.Client)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	icp.GetStatus().Region = region
+
+	return nil
+}
+
+// reconcileLocalityFailoverDestinationRule creates a mesh wide DestinationRule with locality failover
+// settings derived from the regions and networks of the control plane and its peers
+func (r *IstioControlPlaneReconciler) reconcileLocalityFailoverDestinationRule(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if !isActive(icp) {
+		return nil
+	}
+
+	state := reconciler.StateAbsent
+	dr := &istionetworkingv1alpha3.DestinationRule{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DestinationRule",
+			APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      icp.WithRevision(localityFailoverDestinationRuleName),
+			Namespace: icp.GetNamespace(),
+		},
+	}
+
+	if icp.DeletionTimestamp.IsZero() && icp.GetSpec().GetNetwork().GetLocalityFailover().GetEnabled().GetValue() {
+		state = reconciler.StatePresent
+
+		cps, err := r.getMeshNetworkControlPlanes(ctx, icp)
+		if err != nil {
+			return err
+		}
+
+		host := icp.GetSpec().GetNetwork().GetLocalityFailover().GetHost()
+		if host == "" {
+			host = "*." + getClusterDomain(icp)
+		}
+
+		dr.Spec.Host = host
+		dr.Spec.TrafficPolicy = &networkingv1alpha3.TrafficPolicy{
+			LoadBalancer: &networkingv1alpha3.LoadBalancerSettings{
+				LocalityLbSetting: &networkingv1alpha3.LocalityLoadBalancerSetting{
+					Enabled:  wrapperspb.Bool(true),
+					Failover: getLocalityFailovers(cps),
+				},
+			},
+			OutlierDetection: &networkingv1alpha3.OutlierDetection{
+				Consecutive_5XxErrors: wrapperspb.UInt32(localityFailoverConsecutive5xxErrors),
+				Interval:              durationpb.New(localityFailoverInterval),
+				BaseEjectionTime:      durationpb.New(localityFailoverBaseEjectionTime),
+			},
+		}
+		k8sutil.SetICPMetadataOnObject(dr, icp)
+	}
+
+	_, err := r.ResourceReconciler.ReconcileResource(dr, state)
+	if err != nil {
+		return errors.WrapIf(err, "could not reconcile locality failover destination rule")
+	}
+
+	return nil
+}
+
+func getClusterDomain(icp *servicemeshv1alpha1.IstioControlPlane) string {
+	if clusterDomain := icp.GetSpec().GetProxy().GetClusterDomain(); clusterDomain != "" {
+		return clusterDomain
+	}
+
+	return defaultClusterDomain
+}
+
+// getLocalityFailovers calculates a failover target region for every known region.
+// Regions of networks with higher weight are preferred, then regions within the same network.
+func getLocalityFailovers(cps SortableControlPlanes) []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover {
+	type regionInfo struct {
+		network string
+		weight  uint32
+	}
+
+	regions := make(map[string]regionInfo)
+	for _, cp := range cps {
+		region := cp.GetStatus().GetRegion()
+		if region == "" {
+			continue
+		}
+
+		info := regionInfo{
+			network: cp.GetNetworkName(),
+			weight:  cp.GetResolvedSpec().GetNetwork().GetWeight().GetValue(),
+		}
+		if current, ok := regions[region]; ok && current.weight >= info.weight {
+			continue
+		}
+		regions[region] = info
+	}
+
+	names := make([]string, 0, len(regions))
+	for name := range regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	failovers := make([]*networkingv1alpha3.LocalityLoadBalancerSetting_Failover, 0)
+	for _, from := range names {
+		candidates := make([]string, 0, len(names)-1)
+		for _, to := range names {
+			if to != from {
+				candidates = append(candidates, to)
+			}
+		}
+
+		if len(candidates) == 0 {
+			continue
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			ci, cj := regions[candidates[i]], regions[candidates[j]]
+			if ci.weight != cj.weight {
+				return ci.weight > cj.weight
+			}
+
+			return ci.network == regions[from].network && cj.network != regions[from].network
+		})
+
+		failovers = append(failovers, &networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+			From: from,
+			To:   candidates[0],
+		})
+	}
+
+	return failovers
+}
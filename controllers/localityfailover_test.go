@@ -0,0 +1,182 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestGetLocalityFailovers(t *testing.T) {
+	t.Parallel()
+
+	controlPlane := func(name, network, region string, weight uint32) ControlPlane {
+		icp := &servicemeshv1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				NetworkName: network,
+				Network:     &servicemeshv1alpha1.NetworkConfiguration{},
+			},
+			Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+				Region: region,
+			},
+		}
+		if weight > 0 {
+			icp.Spec.Network.Weight = wrapperspb.UInt32(weight)
+		}
+
+		return icp
+	}
+
+	// the network configuration of the peer is resolved from its profile
+	peerWithProfile := func(name, network, region string, weight uint32) ControlPlane {
+		return &servicemeshv1alpha1.PeerIstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				Profile: "multi-region",
+			},
+			Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+				Region: region,
+				ResolvedSpec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+					Profile:     "multi-region",
+					NetworkName: network,
+					Network: &servicemeshv1alpha1.NetworkConfiguration{
+						Weight: wrapperspb.UInt32(weight),
+					},
+				},
+			},
+		}
+	}
+
+	failover := func(from, to string) *networkingv1alpha3.LocalityLoadBalancerSetting_Failover {
+		return &networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+			From: from,
+			To:   to,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		cps       SortableControlPlanes
+		failovers []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover
+	}{
+		{
+			name:      "no control planes",
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{},
+		},
+		{
+			name: "single region",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network1", "us-east-1", 0),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{},
+		},
+		{
+			name: "control planes without region are ignored",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network1", "", 0),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{},
+		},
+		{
+			name: "two regions fail over to each other",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network2", "eu-west-1", 0),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+				failover("eu-west-1", "us-east-1"),
+				failover("us-east-1", "eu-west-1"),
+			},
+		},
+		{
+			name: "regions of the same network are preferred",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network2", "eu-west-1", 0),
+				controlPlane("cp3", "network1", "us-west-2", 0),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+				failover("eu-west-1", "us-east-1"),
+				failover("us-east-1", "us-west-2"),
+				failover("us-west-2", "us-east-1"),
+			},
+		},
+		{
+			name: "regions of networks with higher weight are preferred",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network2", "eu-west-1", 10),
+				controlPlane("cp3", "network1", "us-west-2", 0),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+				failover("eu-west-1", "us-east-1"),
+				failover("us-east-1", "eu-west-1"),
+				failover("us-west-2", "eu-west-1"),
+			},
+		},
+		{
+			name: "the highest weight of a region is used",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network1", "us-west-2", 0),
+				controlPlane("cp3", "network2", "eu-west-1", 0),
+				controlPlane("cp4", "network3", "eu-west-1", 5),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+				failover("eu-west-1", "us-east-1"),
+				failover("us-east-1", "eu-west-1"),
+				failover("us-west-2", "eu-west-1"),
+			},
+		},
+		{
+			name: "weights of peers are resolved from their profiles",
+			cps: SortableControlPlanes{
+				controlPlane("cp1", "network1", "us-east-1", 0),
+				controlPlane("cp2", "network1", "us-west-2", 0),
+				peerWithProfile("cp3", "network2", "eu-west-1", 10),
+			},
+			failovers: []*networkingv1alpha3.LocalityLoadBalancerSetting_Failover{
+				failover("eu-west-1", "us-east-1"),
+				failover("us-east-1", "eu-west-1"),
+				failover("us-west-2", "eu-west-1"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, getLocalityFailovers(tc.cps), tc.failovers, protocmp.Transform())
+		})
+	}
+}
@@ -20,9 +20,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"emperror.dev/errors"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -30,11 +34,15 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -44,8 +52,10 @@ import (
 	"github.com/banzaicloud/istio-operator/v2/internal/util"
 	"github.com/banzaicloud/istio-operator/v2/internal/util/openshift"
 	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
 	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 	"github.com/banzaicloud/operator-tools/pkg/utils"
 )
 
@@ -61,12 +71,27 @@ const (
 // IstioMeshGatewayReconciler reconciles a IstioMeshGateway object
 type IstioMeshGatewayReconciler struct {
 	client.Client
-	Log    logger.Logger
-	Scheme *runtime.Scheme
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// ResourceReconciler reconciles the resources which are not rendered from the gateway chart
+	ResourceReconciler reconciler.ResourceReconciler
+	// HostnameResolutionInterval determines how often the hostnames of the gateways are re-resolved
+	HostnameResolutionInterval time.Duration
+	// ServerSideApply makes the resources of the components applied server-side with a dedicated field manager
+	ServerSideApply bool
+
+	watchersInitOnce       sync.Once
+	ctrl                   controller.Controller
+	componentCacheInitOnce sync.Once
+	componentCache         *components.Cache
+	driftDetectorInitOnce  sync.Once
+	driftDetector          *components.DriftDetector
 }
 
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiomeshgateways,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiomeshgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 
 func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := r.Log.WithValues("istiomeshgateway", req.NamespacedName)
@@ -108,6 +133,15 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
+	if isActive(icp) {
+		r.watchersInitOnce.Do(func() {
+			err = r.watchGateways()
+			if err != nil {
+				logger.Error(err, "unable to watch Gateways")
+			}
+		})
+	}
+
 	err = util.AddFinalizer(ctx, r.Client, imgw, istioMeshGatewayFinalizerID)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -128,22 +162,51 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		injectionTemplate = "gateway, gatewayOverrides"
 	}
 
-	reconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return istiomeshgateway.NewChartReconciler(helmReconciler, servicemeshv1alpha1.IstioMeshGatewayProperties{
-			Revision:                fmt.Sprintf("%s.%s", icp.GetName(), icp.GetNamespace()),
-			EnablePrometheusMerge:   utils.BoolPointer(enablePrometheusMerge),
-			InjectionTemplate:       injectionTemplate,
-			InjectionChecksum:       icp.GetStatus().GetChecksums().GetSidecarInjector(),
-			MeshConfigChecksum:      icp.GetStatus().GetChecksums().GetMeshConfig(),
-			IstioControlPlane:       icp,
-			GenerateExternalService: generateExternalService,
-		}, r.Log)
+	properties := servicemeshv1alpha1.IstioMeshGatewayProperties{
+		Revision:                fmt.Sprintf("%s.%s", icp.GetName(), icp.GetNamespace()),
+		EnablePrometheusMerge:   utils.BoolPointer(enablePrometheusMerge),
+		InjectionTemplate:       injectionTemplate,
+		InjectionChecksum:       icp.GetStatus().GetChecksums().GetSidecarInjector(),
+		MeshConfigChecksum:      icp.GetStatus().GetChecksums().GetMeshConfig(),
+		IstioControlPlane:       icp,
+		GenerateExternalService: generateExternalService,
+	}
+
+	if imgw.DeletionTimestamp.IsZero() {
+		allowed, err := r.isAttachmentAllowed(ctx, imgw, icp)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !allowed {
+			// the already existing resources of the gateway are kept, the rejection is only reported in the status
+			return ctrl.Result{}, r.setAttachmentRejectedStatus(ctx, imgw, icp, logger)
+		}
+	}
+
+	var rolloutRequeueAfter time.Duration
+	properties.BlueGreen, rolloutRequeueAfter, err = r.reconcileBlueGreenRollout(ctx, imgw, properties, logger)
+	if err != nil {
+		return ctrl.Result{}, errors.WrapIf(err, "could not reconcile blue/green rollout")
+	}
+
+	reconciler, err := NewComponentReconciler(r, "istiomeshgateway", func(helmReconciler *components.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
+		return istiomeshgateway.NewChartReconciler(helmReconciler, cache, properties, r.Log)
 	}, r.Log.WithName("istiomeshgateway"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	previousDrifts := imgw.GetStatus().GetDrifts()
+	if err := r.getDriftDetector().Begin(imgw, imgw.GetSpec().GetDriftPolicies()); err != nil {
+		return ctrl.Result{}, err
+	}
 	result, err := reconciler.Reconcile(imgw)
+	imgw.GetStatus().Drifts = r.getDriftDetector().End(imgw)
+	recordResourceDrifts(r.Recorder, imgw, previousDrifts, imgw.GetStatus().Drifts)
+	if !imgw.DeletionTimestamp.IsZero() {
+		r.GetComponentCache().Forget(imgw.GetUID())
+	}
+	imgw.GetStatus().ComponentValuesHashes = r.GetComponentCache().Hashes(imgw.GetUID())
 	if err != nil {
 		return result, errors.WrapIf(err, "could not reconcile istio mesh gateway")
 	}
@@ -154,11 +217,27 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return result, nil
 	}
 
+	certificatesRequeueAfter, err := r.reconcileGatewayCertificates(ctx, imgw, icp, logger)
+	if err != nil {
+		return result, errors.WrapIf(err, "could not reconcile gateway certificates")
+	}
+
+	err = r.setGatewayDetailsToStatus(ctx, imgw, icp)
+	if err != nil {
+		return result, errors.WrapIf(err, "could not set gateway details")
+	}
+
 	result, err = r.setGatewayAddress(ctx, r.GetClient(), imgw, logger, result)
 	if err != nil {
 		return result, errors.WrapIf(err, "could not set gateway address")
 	}
 
+	for _, requeueAfter := range []time.Duration{rolloutRequeueAfter, certificatesRequeueAfter} {
+		if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = requeueAfter
+		}
+	}
+
 	err = util.RemoveFinalizer(ctx, r.Client, imgw, istioMeshGatewayFinalizerID, true)
 	if err != nil {
 		return result, errors.WithStack(err)
@@ -171,10 +250,34 @@ func (r *IstioMeshGatewayReconciler) GetClient() client.Client {
 	return r.Client
 }
 
+func (r *IstioMeshGatewayReconciler) GetResourceClient() client.Client {
+	if r.ServerSideApply {
+		return r.getDriftDetector().Client(pkgUtil.NewServerSideApplyClient(r.Client, pkgUtil.FieldManager))
+	}
+
+	return r.getDriftDetector().Client(r.Client)
+}
+
+func (r *IstioMeshGatewayReconciler) getDriftDetector() *components.DriftDetector {
+	r.driftDetectorInitOnce.Do(func() {
+		r.driftDetector = components.NewDriftDetector()
+	})
+
+	return r.driftDetector
+}
+
 func (r *IstioMeshGatewayReconciler) GetScheme() *runtime.Scheme {
 	return r.Scheme
 }
 
+func (r *IstioMeshGatewayReconciler) GetComponentCache() *components.Cache {
+	r.componentCacheInitOnce.Do(func() {
+		r.componentCache = components.NewCache()
+	})
+
+	return r.componentCache
+}
+
 func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder := ctrl.NewControllerManagedBy(mgr)
 
@@ -187,7 +290,7 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				Kind:       "Deployment",
 				APIVersion: appsv1.SchemeGroupVersion.String(),
 			},
-		}, ctrlBuilder.WithPredicates(objectChangePredicate)).
+		}, ctrlBuilder.WithPredicates(predicate.Or(objectChangePredicate, util.DeploymentReplicasChangePredicate{}))).
 		Owns(&corev1.Service{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "Service",
@@ -240,6 +343,8 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	r.ctrl = ctrl
+
 	err = ctrl.Watch(&source.Kind{
 		Type: &servicemeshv1alpha1.IstioControlPlane{
 			TypeMeta: metav1.TypeMeta{
@@ -275,7 +380,40 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		}
 
 		return resources
-	}), util.ICPInjectorChangePredicate{})
+	}), predicate.Or(util.ICPInjectorChangePredicate{}, util.ICPMeshGatewayAttachmentChangePredicate{}))
+	if err != nil {
+		return err
+	}
+
+	// the attachment of the gateways is re-evaluated when the labels of their namespace change
+	err = ctrl.Watch(&source.Kind{
+		Type: &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Namespace",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+		},
+	}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		imgws := &servicemeshv1alpha1.IstioMeshGatewayList{}
+		err := r.Client.List(context.Background(), imgws, client.InNamespace(obj.GetName()))
+		if err != nil {
+			r.Log.Error(err, "could not list istiomeshgateway resources")
+
+			return nil
+		}
+
+		resources := make([]reconcile.Request, 0, len(imgws.Items))
+		for _, imgw := range imgws.Items {
+			resources = append(resources, reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      imgw.GetName(),
+					Namespace: imgw.GetNamespace(),
+				},
+			})
+		}
+
+		return resources
+	}), util.NamespaceLabelChange{})
 	if err != nil {
 		return err
 	}
@@ -283,6 +421,58 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
+// watchGateways watches the Gateway resources, which are only present once an ACTIVE control plane is installed, and
+// enqueues the gateways whose pods are selected by them
+func (r *IstioMeshGatewayReconciler) watchGateways() error {
+	if r.ctrl == nil {
+		return errors.New("ctrl is not set")
+	}
+
+	return r.ctrl.Watch(&source.Kind{
+		Type: &istionetworkingv1alpha3.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Gateway",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+	}, handler.EnqueueRequestsFromMapFunc(r.getMeshGatewaysSelectedBy))
+}
+
+// getMeshGatewaysSelectedBy returns the requests of the gateways whose pods are selected by the Gateway
+func (r *IstioMeshGatewayReconciler) getMeshGatewaysSelectedBy(obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*istionetworkingv1alpha3.Gateway)
+	if !ok || len(gateway.Spec.GetSelector()) == 0 {
+		return nil
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	err := r.Client.List(context.Background(), deployments)
+	if err != nil {
+		r.Log.Error(err, "could not list deployments")
+
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(gateway.Spec.GetSelector())
+	resources := make([]reconcile.Request, 0)
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		owner := metav1.GetControllerOf(deployment)
+		if owner == nil || owner.Kind != "IstioMeshGateway" || !selector.Matches(labels.Set(deployment.Spec.Template.GetLabels())) {
+			continue
+		}
+
+		resources = append(resources, reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      owner.Name,
+				Namespace: deployment.GetNamespace(),
+			},
+		})
+	}
+
+	return resources
+}
+
 func (r *IstioMeshGatewayReconciler) getRelatedIstioControlPlane(ctx context.Context, c client.Client, imgw *servicemeshv1alpha1.IstioMeshGateway, logger logger.Logger) (*servicemeshv1alpha1.IstioControlPlane, error) {
 	icp := &servicemeshv1alpha1.IstioControlPlane{}
 
@@ -301,32 +491,267 @@ func (r *IstioMeshGatewayReconciler) getRelatedIstioControlPlane(ctx context.Con
 		return nil, errors.WrapIf(err, "could not get related Istio control plane")
 	}
 
+	// the gateway follows the spec resolved from the profile of the control plane
+	icp.Spec = icp.GetResolvedSpec()
+
 	return icp, nil
 }
 
-func (r *IstioMeshGatewayReconciler) getGatewayAddress(imgw *servicemeshv1alpha1.IstioMeshGateway) ([]string, bool, error) {
+// isAttachmentAllowed checks whether the gateway is allowed to attach to the control plane according to its attachment policy
+func (r *IstioMeshGatewayReconciler) isAttachmentAllowed(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) (bool, error) {
+	policy := icp.GetSpec().GetMeshGatewayAttachment()
+	if policy == nil || imgw.GetNamespace() == icp.GetNamespace() {
+		return true, nil
+	}
+
+	var namespace *corev1.Namespace
+	for _, selector := range policy.GetAllowedGateways() {
+		if len(selector.GetNamespaces()) > 0 && !util.ContainsString(selector.GetNamespaces(), imgw.GetNamespace()) {
+			continue
+		}
+
+		if !labels.SelectorFromSet(selector.GetGatewayLabels()).Matches(labels.Set(imgw.GetLabels())) {
+			continue
+		}
+
+		if len(selector.GetNamespaceLabels()) > 0 {
+			if namespace == nil {
+				namespace = &corev1.Namespace{}
+				if err := r.Get(ctx, client.ObjectKey{Name: imgw.GetNamespace()}, namespace); err != nil {
+					return false, errors.WrapIfWithDetails(err, "could not get namespace", "namespace", imgw.GetNamespace())
+				}
+			}
+
+			if !labels.SelectorFromSet(selector.GetNamespaceLabels()).Matches(labels.Set(namespace.GetLabels())) {
+				continue
+			}
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (r *IstioMeshGatewayReconciler) setAttachmentRejectedStatus(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) error {
+	message := fmt.Sprintf("gateway is not allowed to attach to control plane %s/%s", icp.GetNamespace(), icp.GetName())
+	logger.Info(message)
+
+	if r.Recorder != nil {
+		r.Recorder.Event(imgw, corev1.EventTypeWarning, "AttachmentRejected", message)
+	}
+
+	err := components.UpdateStatus(ctx, r.GetClient(), imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), message)
+	if err != nil {
+		return errors.WrapIf(err, "could not update istio mesh gateway status")
+	}
+
+	return nil
+}
+
+func (r *IstioMeshGatewayReconciler) getGatewayAddress(imgw *servicemeshv1alpha1.IstioMeshGateway) ([]string, []string, error) {
 	var service corev1.Service
-	var ips []string
-	var hasHostname bool
 
 	err := r.Get(context.Background(), client.ObjectKey{
 		Name:      imgw.GetName(),
 		Namespace: imgw.GetNamespace(),
 	}, &service)
 	if err != nil {
-		return nil, hasHostname, err
+		return nil, nil, err
+	}
+
+	return k8sutil.GetServiceEndpointAddresses(service)
+}
+
+// setGatewayDetailsToStatus sets the replicas, the service and the bound Gateways of the gateway to its status,
+// which is going to be persisted together with the gateway address
+func (r *IstioMeshGatewayReconciler) setGatewayDetailsToStatus(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if !imgw.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	status := imgw.GetStatus()
+
+	key := client.ObjectKey{
+		Name:      imgw.GetName(),
+		Namespace: imgw.GetNamespace(),
+	}
+
+	var deployment appsv1.Deployment
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      servicemeshv1alpha1.GetGatewayDeploymentName(imgw.GetName(), imgw.GetStatus().GetBlueGreenRollout().GetActiveColor()),
+		Namespace: imgw.GetNamespace(),
+	}, &deployment)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.WrapIf(err, "could not get gateway deployment")
+	}
+	status.DesiredReplicas = 0
+	if deployment.Spec.Replicas != nil {
+		status.DesiredReplicas = *deployment.Spec.Replicas
+	}
+	status.ReadyReplicas = deployment.Status.ReadyReplicas
+	status.UpdatedReplicas = deployment.Status.UpdatedReplicas
+
+	status.Revision, err = r.getRunningRevision(ctx, &deployment)
+	if err != nil {
+		return err
+	}
+
+	var service corev1.Service
+	err = r.Get(ctx, key, &service)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.WrapIf(err, "could not get gateway service")
+	}
+	status.ServiceType = string(service.Spec.Type)
+	status.ServicePorts = make([]*servicemeshv1alpha1.ServicePort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		servicePort := &servicemeshv1alpha1.ServicePort{}
+		servicePort.Name = port.Name
+		servicePort.Protocol = string(port.Protocol)
+		servicePort.Port = port.Port
+		servicePort.TargetPort = &servicemeshv1alpha1.IntOrString{IntOrString: port.TargetPort}
+		servicePort.NodePort = port.NodePort
+		status.ServicePorts = append(status.ServicePorts, servicePort)
+	}
+
+	status.BoundGateways, err = r.getBoundGateways(ctx, icp, deployment.Spec.Template.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	status.HostConflicts, err = r.getHostConflicts(ctx, imgw, icp, deployment.Spec.Template.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getRunningRevision returns the revisions of the control plane the running pods of the deployment are injected with
+func (r *IstioMeshGatewayReconciler) getRunningRevision(ctx context.Context, deployment *appsv1.Deployment) (string, error) {
+	if deployment.Spec.Selector == nil {
+		return "", nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return "", errors.WrapIf(err, "invalid gateway deployment selector")
+	}
+
+	pods := &corev1.PodList{}
+	err = r.List(ctx, pods, client.InNamespace(deployment.GetNamespace()), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return "", errors.WrapIf(err, "could not list gateway pods")
+	}
+
+	revisions := make([]string, 0)
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if revision := pod.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; revision != "" {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	return strings.Join(uniqueSortedStrings(revisions), ","), nil
+}
+
+// getBoundGateways returns the Gateway resources which select the pods with the given labels
+func (r *IstioMeshGatewayReconciler) getBoundGateways(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, podLabels map[string]string) ([]string, error) {
+	if !isActive(icp) || len(podLabels) == 0 {
+		return nil, nil
+	}
+
+	gateways := &istionetworkingv1alpha3.GatewayList{}
+	err := r.List(ctx, gateways)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not list gateways")
+	}
+
+	bound := make([]string, 0)
+	for _, gateway := range gateways.Items {
+		selector := gateway.Spec.GetSelector()
+		if len(selector) == 0 {
+			continue
+		}
+
+		if labels.SelectorFromSet(selector).Matches(labels.Set(podLabels)) {
+			bound = append(bound, gateway.GetNamespace()+"/"+gateway.GetName())
+		}
 	}
+	sort.Strings(bound)
 
-	ips, hasHostname, err = k8sutil.GetServiceEndpointIPs(service)
+	return bound, nil
+}
+
+// getHostConflicts returns the hosts of the generated Gateway which are also claimed on the same port by other
+// Gateways selecting the pods with the given labels
+func (r *IstioMeshGatewayReconciler) getHostConflicts(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, podLabels map[string]string) ([]string, error) {
+	if !isActive(icp) || len(imgw.GetSpec().GetServers()) == 0 || len(podLabels) == 0 {
+		return nil, nil
+	}
+
+	gateways := &istionetworkingv1alpha3.GatewayList{}
+	err := r.List(ctx, gateways)
 	if err != nil {
-		return nil, hasHostname, err
+		return nil, errors.WrapIf(err, "could not list gateways")
+	}
+
+	conflicts := make([]string, 0)
+	for _, server := range imgw.GetSpec().GetServers() {
+		for _, gateway := range gateways.Items {
+			if gateway.GetNamespace() == imgw.GetNamespace() && gateway.GetName() == imgw.GetName() {
+				continue
+			}
+
+			selector := gateway.Spec.GetSelector()
+			if len(selector) == 0 || !labels.SelectorFromSet(selector).Matches(labels.Set(podLabels)) {
+				continue
+			}
+
+			for _, gatewayServer := range gateway.Spec.GetServers() {
+				if gatewayServer.GetPort().GetNumber() != server.GetPort() {
+					continue
+				}
+
+				for _, host := range server.GetHosts() {
+					for _, gatewayHost := range gatewayServer.GetHosts() {
+						if gatewayHostsOverlap(host, gatewayHost) {
+							conflicts = append(conflicts, fmt.Sprintf("%s:%d claimed by %s/%s", host, server.GetPort(), gateway.GetNamespace(), gateway.GetName()))
+						}
+					}
+				}
+			}
+		}
 	}
 
-	return ips, hasHostname, nil
+	return uniqueSortedStrings(conflicts), nil
+}
+
+// gatewayHostsOverlap checks whether two Gateway hosts, optionally in namespace/host format, match the same host name
+func gatewayHostsOverlap(a, b string) bool {
+	if i := strings.Index(a, "/"); i >= 0 {
+		a = a[i+1:]
+	}
+	if i := strings.Index(b, "/"); i >= 0 {
+		b = b[i+1:]
+	}
+
+	switch {
+	case a == b, a == "*", b == "*":
+		return true
+	case strings.HasPrefix(a, "*."):
+		return strings.HasSuffix(b, a[1:])
+	case strings.HasPrefix(b, "*."):
+		return strings.HasSuffix(a, b[1:])
+	}
+
+	return false
 }
 
 func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c client.Client, imgw *servicemeshv1alpha1.IstioMeshGateway, logger logger.Logger, result ctrl.Result) (ctrl.Result, error) {
-	var gatewayHasHostname bool
 	var err error
 
 	if !imgw.DeletionTimestamp.IsZero() {
@@ -334,7 +759,7 @@ func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c cl
 	}
 
 	currentGatewayAddress := imgw.GetStatus().GatewayAddress
-	imgw.GetStatus().GatewayAddress, gatewayHasHostname, err = r.getGatewayAddress(imgw)
+	imgw.GetStatus().GatewayAddress, imgw.GetStatus().GatewayHostnames, err = r.getGatewayAddress(imgw)
 	if err != nil {
 		logger.Info(fmt.Sprintf("gateway address pending: %s", err.Error()))
 		updateErr := components.UpdateStatus(ctx, c, imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), errors.Cause(err).Error())
@@ -356,14 +781,32 @@ func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c cl
 		return result, errors.WithStack(err)
 	}
 
+	gatewayHasHostname := len(imgw.GetStatus().GatewayHostnames) > 0
+
 	if !reflect.DeepEqual(currentGatewayAddress, imgw.GetStatus().GatewayAddress) {
 		logger.Info("gateway address has changed, trigger reconciler by requeuing")
 		result.Requeue = true
+
+		if gatewayHasHostname && len(currentGatewayAddress) > 0 && r.Recorder != nil {
+			r.Recorder.Eventf(
+				imgw,
+				corev1.EventTypeNormal,
+				"GatewayAddressChanged",
+				"resolved addresses of gateway hostnames %s have changed from %s to %s",
+				strings.Join(imgw.GetStatus().GatewayHostnames, ","),
+				strings.Join(currentGatewayAddress, ","),
+				strings.Join(imgw.GetStatus().GatewayAddress, ","),
+			)
+		}
 	}
 
 	if gatewayHasHostname {
-		logger.Info(fmt.Sprintf("gateway uses hostname, trigger reconciliation after %s", hostnameSyncWaitDuration.String()))
-		result.RequeueAfter = hostnameSyncWaitDuration
+		interval := r.HostnameResolutionInterval
+		if interval <= 0 {
+			interval = hostnameSyncWaitDuration
+		}
+		logger.Info(fmt.Sprintf("gateway uses hostname, trigger reconciliation after %s", interval.String()))
+		result.RequeueAfter = interval
 	}
 
 	return result, nil
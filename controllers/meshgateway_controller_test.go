@@ -0,0 +1,459 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+func TestIsAttachmentAllowed(t *testing.T) {
+	t.Parallel()
+
+	r := &IstioMeshGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gateways",
+				Labels: map[string]string{
+					"team": "edge",
+				},
+			},
+		}).Build(),
+	}
+
+	gateway := func(namespace string, labels map[string]string) *servicemeshv1alpha1.IstioMeshGateway {
+		return &servicemeshv1alpha1.IstioMeshGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "imgw",
+				Namespace: namespace,
+				Labels:    labels,
+			},
+		}
+	}
+
+	controlPlane := func(policy *servicemeshv1alpha1.MeshGatewayAttachmentPolicy) *servicemeshv1alpha1.IstioControlPlane {
+		return &servicemeshv1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "icp",
+				Namespace: "istio-system",
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				MeshGatewayAttachment: policy,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		imgw    *servicemeshv1alpha1.IstioMeshGateway
+		policy  *servicemeshv1alpha1.MeshGatewayAttachmentPolicy
+		allowed bool
+		err     bool
+	}{
+		{
+			name:    "no policy",
+			imgw:    gateway("gateways", nil),
+			allowed: true,
+		},
+		{
+			name:    "gateway in the namespace of the control plane",
+			imgw:    gateway("istio-system", nil),
+			policy:  &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{},
+			allowed: true,
+		},
+		{
+			name:    "empty policy",
+			imgw:    gateway("gateways", nil),
+			policy:  &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{},
+			allowed: false,
+		},
+		{
+			name: "empty selector",
+			imgw: gateway("gateways", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{{}},
+			},
+			allowed: true,
+		},
+		{
+			name: "namespace name matches",
+			imgw: gateway("gateways", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{Namespaces: []string{"other", "gateways"}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "namespace name does not match",
+			imgw: gateway("gateways", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{Namespaces: []string{"other"}},
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "gateway labels match",
+			imgw: gateway("gateways", map[string]string{"app": "ingress", "tier": "public"}),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{GatewayLabels: map[string]string{"app": "ingress"}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "gateway labels do not match",
+			imgw: gateway("gateways", map[string]string{"app": "egress"}),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{GatewayLabels: map[string]string{"app": "ingress"}},
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "namespace labels match",
+			imgw: gateway("gateways", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{NamespaceLabels: map[string]string{"team": "edge"}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "namespace labels do not match",
+			imgw: gateway("gateways", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{NamespaceLabels: map[string]string{"team": "core"}},
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "every condition of a selector must match",
+			imgw: gateway("gateways", map[string]string{"app": "ingress"}),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{
+						Namespaces:      []string{"gateways"},
+						GatewayLabels:   map[string]string{"app": "ingress"},
+						NamespaceLabels: map[string]string{"team": "core"},
+					},
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "any selector may match",
+			imgw: gateway("gateways", map[string]string{"app": "ingress"}),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{Namespaces: []string{"other"}},
+					{GatewayLabels: map[string]string{"app": "ingress"}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "missing namespace",
+			imgw: gateway("missing", nil),
+			policy: &servicemeshv1alpha1.MeshGatewayAttachmentPolicy{
+				AllowedGateways: []*servicemeshv1alpha1.MeshGatewaySelector{
+					{NamespaceLabels: map[string]string{"team": "edge"}},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, err := r.isAttachmentAllowed(context.Background(), tc.imgw, controlPlane(tc.policy))
+			if tc.err {
+				assert.Assert(t, err != nil)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func TestGatewayHostsOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "example.com", b: "example.com", expected: true},
+		{a: "example.com", b: "example.org", expected: false},
+		{a: "*", b: "example.com", expected: true},
+		{a: "example.com", b: "*", expected: true},
+		{a: "*.example.com", b: "www.example.com", expected: true},
+		{a: "www.example.com", b: "*.example.com", expected: true},
+		{a: "*.example.com", b: "example.com", expected: false},
+		{a: "*.example.com", b: "www.example.org", expected: false},
+		{a: "*.example.com", b: "*.www.example.com", expected: true},
+		{a: "default/example.com", b: "gateways/example.com", expected: true},
+		{a: "./*.example.com", b: "www.example.com", expected: true},
+		{a: "*/example.com", b: "example.org", expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, gatewayHostsOverlap(tc.a, tc.b), tc.expected)
+		})
+	}
+}
+
+func TestGetHostConflicts(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, istionetworkingv1alpha3.AddToScheme(scheme))
+
+	gateway := func(name string, selector map[string]string, port uint32, hosts ...string) client.Object {
+		return &istionetworkingv1alpha3.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "gateways",
+			},
+			Spec: networkingv1alpha3.Gateway{
+				Selector: selector,
+				Servers: []*networkingv1alpha3.Server{
+					{
+						Hosts: hosts,
+						Port: &networkingv1alpha3.Port{
+							Number:   port,
+							Name:     "http",
+							Protocol: "HTTP",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	r := &IstioMeshGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			gateway("ingress", map[string]string{"gateway-name": "ingress"}, 80, "example.com"),
+			gateway("same-workload", map[string]string{"gateway-name": "ingress"}, 80, "*.example.com", "example.com"),
+			gateway("other-workload", map[string]string{"gateway-name": "other"}, 80, "example.com"),
+			gateway("other-port", map[string]string{"gateway-name": "ingress"}, 8080, "example.com"),
+			gateway("no-selector", nil, 80, "example.com"),
+		).Build(),
+	}
+
+	imgw := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress",
+			Namespace: "gateways",
+		},
+		Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+			Servers: []*servicemeshv1alpha1.GatewayServer{
+				{
+					Hosts: []string{"example.com", "www.example.com"},
+					Port:  80,
+				},
+			},
+		},
+	}
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Mode: servicemeshv1alpha1.ModeType_ACTIVE,
+		},
+	}
+	podLabels := map[string]string{"gateway-name": "ingress", "gateway-type": "ingress"}
+
+	conflicts, err := r.getHostConflicts(context.Background(), imgw, icp, podLabels)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, conflicts, []string{
+		"example.com:80 claimed by gateways/same-workload",
+		"www.example.com:80 claimed by gateways/same-workload",
+	})
+
+	conflicts, err = r.getHostConflicts(context.Background(), imgw, icp, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(conflicts), 0)
+
+	passive := &servicemeshv1alpha1.IstioControlPlane{
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Mode: servicemeshv1alpha1.ModeType_PASSIVE,
+		},
+	}
+	conflicts, err = r.getHostConflicts(context.Background(), imgw, passive, podLabels)
+	assert.NilError(t, err)
+	assert.Equal(t, len(conflicts), 0)
+}
+
+func TestGetRunningRevision(t *testing.T) {
+	t.Parallel()
+
+	pod := func(name string, phase corev1.PodPhase, revision string) client.Object {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "gateways",
+				Labels: map[string]string{
+					"gateway-name": "ingress",
+					servicemeshv1alpha1.RevisionedAutoInjectionLabel: revision,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	r := &IstioMeshGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			pod("ingress-1", corev1.PodRunning, "cp-v117x.istio-system"),
+			pod("ingress-2", corev1.PodRunning, "cp-v116x.istio-system"),
+			pod("ingress-3", corev1.PodRunning, "cp-v117x.istio-system"),
+			pod("ingress-4", corev1.PodPending, "cp-v118x.istio-system"),
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other",
+					Namespace: "gateways",
+					Labels: map[string]string{
+						"gateway-name": "other",
+						servicemeshv1alpha1.RevisionedAutoInjectionLabel: "cp-v115x.istio-system",
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			},
+		).Build(),
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress",
+			Namespace: "gateways",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"gateway-name": "ingress",
+				},
+			},
+		},
+	}
+
+	revision, err := r.getRunningRevision(context.Background(), deployment)
+	assert.NilError(t, err)
+	assert.Equal(t, revision, "cp-v116x.istio-system,cp-v117x.istio-system")
+
+	revision, err = r.getRunningRevision(context.Background(), &appsv1.Deployment{})
+	assert.NilError(t, err)
+	assert.Equal(t, revision, "")
+}
+
+func TestGetMeshGatewaysSelectedBy(t *testing.T) {
+	t.Parallel()
+
+	deployment := func(name string, owner string, podLabels map[string]string) client.Object {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "gateways",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: podLabels,
+					},
+				},
+			},
+		}
+		if owner != "" {
+			deployment.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+					Kind:       "IstioMeshGateway",
+					Name:       owner,
+					Controller: utils.BoolPointer(true),
+				},
+			}
+		}
+
+		return deployment
+	}
+
+	r := &IstioMeshGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			deployment("ingress-blue", "ingress", map[string]string{"gateway-name": "ingress", "gateway-color": "blue"}),
+			deployment("ingress-green", "ingress", map[string]string{"gateway-name": "ingress", "gateway-color": "green"}),
+			deployment("egress", "egress", map[string]string{"gateway-name": "egress"}),
+			deployment("unowned", "", map[string]string{"gateway-name": "ingress"}),
+		).Build(),
+	}
+
+	gateway := func(selector map[string]string) client.Object {
+		return &istionetworkingv1alpha3.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bookinfo",
+				Namespace: "bookinfo",
+			},
+			Spec: networkingv1alpha3.Gateway{
+				Selector: selector,
+			},
+		}
+	}
+
+	assert.DeepEqual(t, r.getMeshGatewaysSelectedBy(gateway(map[string]string{"gateway-name": "ingress", "gateway-color": "green"})), []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: "ingress", Namespace: "gateways"}},
+	})
+	assert.DeepEqual(t, r.getMeshGatewaysSelectedBy(gateway(map[string]string{"gateway-name": "egress"})), []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: "egress", Namespace: "gateways"}},
+	})
+	assert.Equal(t, len(r.getMeshGatewaysSelectedBy(gateway(map[string]string{"gateway-name": "missing"}))), 0)
+	assert.Equal(t, len(r.getMeshGatewaysSelectedBy(gateway(nil))), 0)
+}
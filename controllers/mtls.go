@@ -0,0 +1,305 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	securityv1beta1 "istio.io/api/security/v1beta1"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/istio-operator/v2/internal/util/prometheus"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	meshPeerAuthenticationName      = "mesh-mtls"
+	namespacePeerAuthenticationName = "strict-mtls"
+	sidecarStatusAnnotation         = "sidecar.istio.io/status"
+	outsideMeshNamespace            = "unknown"
+	defaultMTLSLookback             = time.Hour
+	mtlsMigrationRequeueDuration    = time.Minute
+
+	meshPeerAuthenticationCondition = "MeshPeerAuthenticationReconciled"
+)
+
+// reconcileMTLS manages the mesh wide PeerAuthentication in the root namespace and migrates
+// the requested namespaces to STRICT mTLS mode once they are verified to be ready for it
+func (r *IstioControlPlaneReconciler) reconcileMTLS(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	if !isActive(icp) {
+		return nil
+	}
+
+	err := r.reconcileMeshPeerAuthentication(ctx, icp)
+	if err != nil {
+		return err
+	}
+
+	return r.reconcileStrictMTLSNamespaces(ctx, icp)
+}
+
+// reconcileMeshPeerAuthentication manages the mesh wide PeerAuthentication, which is shared by the revisions
+// of the root namespace, so it is left alone when it is managed by another control plane
+func (r *IstioControlPlaneReconciler) reconcileMeshPeerAuthentication(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	state := reconciler.StateAbsent
+	pa := &istiosecurityv1beta1.PeerAuthentication{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PeerAuthentication",
+			APIVersion: istiosecurityv1beta1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: meshPeerAuthenticationName,
+			// the root namespace of the mesh is the namespace of the control plane
+			Namespace: icp.GetNamespace(),
+		},
+	}
+
+	mode := icp.GetSpec().GetMtls().GetMode()
+
+	current := &istiosecurityv1beta1.PeerAuthentication{}
+	err := r.GetClient().Get(ctx, client.ObjectKeyFromObject(pa), current)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.WrapIf(err, "could not get mesh peer authentication")
+	}
+	if err == nil {
+		if owner := metav1.GetControllerOf(current); owner == nil || owner.UID != icp.GetUID() {
+			if mode == "" || !icp.DeletionTimestamp.IsZero() {
+				removeCondition(icp, meshPeerAuthenticationCondition)
+			} else {
+				message := fmt.Sprintf("peer authentication %s/%s is not managed by the control plane", pa.GetNamespace(), pa.GetName())
+				if owner != nil {
+					message = fmt.Sprintf("peer authentication %s/%s is managed by %s %s", pa.GetNamespace(), pa.GetName(), owner.Kind, owner.Name)
+				}
+				setCondition(icp, meshPeerAuthenticationCondition, metav1.ConditionFalse, "Conflict", message)
+			}
+
+			return nil
+		}
+	}
+
+	if icp.DeletionTimestamp.IsZero() && mode != "" {
+		state = reconciler.StatePresent
+		pa.Spec.Mtls = &securityv1beta1.PeerAuthentication_MutualTLS{
+			Mode: securityv1beta1.PeerAuthentication_MutualTLS_Mode(securityv1beta1.PeerAuthentication_MutualTLS_Mode_value[mode]),
+		}
+		k8sutil.SetICPMetadataOnObject(pa, icp)
+	}
+
+	_, err = r.ResourceReconciler.ReconcileResource(pa, state)
+	if err != nil {
+		return errors.WrapIf(err, "could not reconcile mesh peer authentication")
+	}
+
+	if state == reconciler.StatePresent {
+		setCondition(icp, meshPeerAuthenticationCondition, metav1.ConditionTrue, "Reconciled", "")
+	} else {
+		removeCondition(icp, meshPeerAuthenticationCondition)
+	}
+
+	return nil
+}
+
+func (r *IstioControlPlaneReconciler) reconcileStrictMTLSNamespaces(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	labels := utils.MergeLabels(icp.RevisionLabels(), map[string]string{
+		servicemeshv1alpha1.StrictMTLSLabel: "true",
+	})
+
+	current := &istiosecurityv1beta1.PeerAuthenticationList{}
+	err := r.GetClient().List(ctx, current, client.MatchingLabels(labels))
+	if err != nil {
+		return errors.WrapIf(err, "could not list peer authentications")
+	}
+
+	migrated := make(map[string]struct{}, len(current.Items))
+	for _, pa := range current.Items {
+		migrated[pa.GetNamespace()] = struct{}{}
+	}
+
+	desired := make(map[client.ObjectKey]struct{})
+	statuses := make([]*servicemeshv1alpha1.MTLSNamespaceStatus, 0)
+
+	config := icp.GetSpec().GetMtls()
+	if icp.DeletionTimestamp.IsZero() && len(config.GetStrictNamespaces()) > 0 {
+		var plaintextSources map[string][]string
+		var plaintextErr error
+		if config.GetPrometheusAddress() != "" {
+			lookback := defaultMTLSLookback
+			if config.GetLookback() != nil {
+				lookback = config.GetLookback().AsDuration()
+			}
+
+			plaintextSources, plaintextErr = prometheus.GetPlaintextSources(ctx, config.GetPrometheusAddress(), lookback)
+			if plaintextErr != nil {
+				r.Log.Error(plaintextErr, "could not get plaintext traffic sources")
+			}
+		}
+
+		for _, name := range uniqueSortedStrings(config.GetStrictNamespaces()) {
+			blockers, err := r.getStrictMTLSBlockers(ctx, icp, name)
+			if err != nil {
+				return err
+			}
+
+			if plaintextErr != nil {
+				blockers = append(blockers, "plaintext traffic could not be checked")
+			}
+			for _, source := range plaintextSources[name] {
+				if source == outsideMeshNamespace {
+					blockers = append(blockers, "plaintext traffic from workloads outside the mesh")
+
+					continue
+				}
+				blockers = append(blockers, fmt.Sprintf("plaintext traffic from namespace %s", source))
+			}
+
+			_, strict := migrated[name]
+			if !strict && len(blockers) == 0 {
+				strict = true
+				r.Log.Info("migrate namespace to strict mtls mode", "namespace", name)
+			}
+
+			statuses = append(statuses, &servicemeshv1alpha1.MTLSNamespaceStatus{
+				Namespace: name,
+				Strict:    strict,
+				Blockers:  blockers,
+			})
+
+			if !strict {
+				continue
+			}
+
+			pa := &istiosecurityv1beta1.PeerAuthentication{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "PeerAuthentication",
+					APIVersion: istiosecurityv1beta1.SchemeGroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      icp.WithRevision(namespacePeerAuthenticationName),
+					Namespace: name,
+					Labels:    utils.MergeLabels(labels),
+				},
+			}
+			pa.Spec.Mtls = &securityv1beta1.PeerAuthentication_MutualTLS{
+				Mode: securityv1beta1.PeerAuthentication_MutualTLS_STRICT,
+			}
+
+			_, err = r.ResourceReconciler.ReconcileResource(pa, reconciler.StatePresent)
+			if err != nil {
+				return errors.WrapIfWithDetails(err, "could not reconcile strict peer authentication", "namespace", name)
+			}
+
+			desired[client.ObjectKeyFromObject(pa)] = struct{}{}
+		}
+	}
+
+	icp.GetStatus().MtlsNamespaces = statuses
+
+	for _, pa := range current.Items {
+		if _, ok := desired[client.ObjectKeyFromObject(pa)]; ok {
+			continue
+		}
+
+		pa.SetGroupVersionKind(istiosecurityv1beta1.SchemeGroupVersion.WithKind("PeerAuthentication"))
+		_, err = r.ResourceReconciler.ReconcileResource(pa, reconciler.StateAbsent)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not remove strict peer authentication", "namespace", pa.GetNamespace())
+		}
+	}
+
+	return nil
+}
+
+// getStrictMTLSBlockers verifies that the namespace is enabled for injection for the control plane
+// and all of its pods are injected by the control plane
+func (r *IstioControlPlaneReconciler) getStrictMTLSBlockers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, namespace string) ([]string, error) {
+	blockers := make([]string, 0)
+
+	err := r.GetClient().Get(ctx, client.ObjectKey{
+		Name: namespace,
+	}, &corev1.Namespace{})
+	if k8serrors.IsNotFound(err) {
+		return append(blockers, "namespace does not exist"), nil
+	}
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get namespace", "namespace", namespace)
+	}
+
+	if !util.ContainsString(icp.GetStatus().GetInjectionNamespaces(), namespace) {
+		blockers = append(blockers, "namespace is not enabled for injection for the control plane")
+	}
+
+	pods := &corev1.PodList{}
+	err = r.GetClient().List(ctx, pods, client.InNamespace(namespace))
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list pods", "namespace", namespace)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		if _, ok := pod.GetAnnotations()[sidecarStatusAnnotation]; !ok {
+			blockers = append(blockers, fmt.Sprintf("pod %s is not injected", pod.GetName()))
+
+			continue
+		}
+
+		if revision := pod.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; revision != icp.NamespacedRevision() {
+			blockers = append(blockers, fmt.Sprintf("pod %s is injected by revision %s", pod.GetName(), revision))
+		}
+	}
+
+	return blockers, nil
+}
+
+func hasPendingStrictMTLSNamespace(icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	for _, status := range icp.GetStatus().GetMtlsNamespaces() {
+		if !status.GetStrict() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func uniqueSortedStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+func newMTLSTestControlPlane(name string, uid k8stypes.UID) *servicemeshv1alpha1.IstioControlPlane {
+	return &servicemeshv1alpha1.IstioControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "IstioControlPlane",
+			APIVersion: servicemeshv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+			UID:       uid,
+		},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Mtls: &servicemeshv1alpha1.MTLSConfiguration{
+				Mode: "STRICT",
+			},
+		},
+		Status: &servicemeshv1alpha1.IstioControlPlaneStatus{
+			InjectionNamespaces: []string{"app"},
+		},
+	}
+}
+
+func TestGetStrictMTLSBlockers(t *testing.T) {
+	t.Parallel()
+
+	icp := newMTLSTestControlPlane("cp-v117x", "")
+
+	pod := func(name string, phase corev1.PodPhase, revision string, injected bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "app",
+				Labels: map[string]string{
+					servicemeshv1alpha1.RevisionedAutoInjectionLabel: revision,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+		if injected {
+			pod.Annotations = map[string]string{
+				sidecarStatusAnnotation: "{}",
+			}
+		}
+
+		return pod
+	}
+
+	namespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		namespace string
+		objects   []client.Object
+		blockers  []string
+	}{
+		{
+			name:      "namespace does not exist",
+			namespace: "app",
+			blockers:  []string{"namespace does not exist"},
+		},
+		{
+			name:      "namespace is not enabled for injection",
+			namespace: "other",
+			objects:   []client.Object{namespace("other")},
+			blockers:  []string{"namespace is not enabled for injection for the control plane"},
+		},
+		{
+			name:      "every pod is injected by the control plane",
+			namespace: "app",
+			objects: []client.Object{
+				namespace("app"),
+				pod("injected", corev1.PodRunning, icp.NamespacedRevision(), true),
+			},
+			blockers: []string{},
+		},
+		{
+			name:      "pod is not injected",
+			namespace: "app",
+			objects: []client.Object{
+				namespace("app"),
+				pod("plain", corev1.PodRunning, "", false),
+			},
+			blockers: []string{"pod plain is not injected"},
+		},
+		{
+			name:      "pod is injected by another revision",
+			namespace: "app",
+			objects: []client.Object{
+				namespace("app"),
+				pod("other", corev1.PodPending, "cp-v116x.istio-system", true),
+			},
+			blockers: []string{"pod other is injected by revision cp-v116x.istio-system"},
+		},
+		{
+			name:      "completed pods are ignored",
+			namespace: "app",
+			objects: []client.Object{
+				namespace("app"),
+				pod("succeeded", corev1.PodSucceeded, "", false),
+				pod("failed", corev1.PodFailed, "", false),
+			},
+			blockers: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &IstioControlPlaneReconciler{
+				Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(tc.objects...).Build(),
+			}
+
+			blockers, err := r.getStrictMTLSBlockers(context.Background(), icp, tc.namespace)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, blockers, tc.blockers)
+		})
+	}
+}
+
+func TestReconcileMeshPeerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, istiosecurityv1beta1.AddToScheme(scheme))
+
+	owner := newMTLSTestControlPlane("cp-v116x", "uid-v116x")
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &IstioControlPlaneReconciler{
+		Client:             c,
+		ResourceReconciler: reconciler.NewReconcilerWith(c),
+	}
+
+	assert.NilError(t, r.reconcileMeshPeerAuthentication(context.Background(), owner))
+	assert.Equal(t, owner.GetStatus().GetConditions()[0].GetStatus(), string(metav1.ConditionTrue))
+
+	pa := &istiosecurityv1beta1.PeerAuthentication{}
+	assert.NilError(t, c.Get(context.Background(), client.ObjectKey{Name: meshPeerAuthenticationName, Namespace: "istio-system"}, pa))
+	assert.Equal(t, pa.Spec.GetMtls().GetMode().String(), "STRICT")
+	assert.Equal(t, metav1.GetControllerOf(pa).UID, owner.GetUID())
+
+	// another revision in the same root namespace must not take over the mesh wide policy
+	other := newMTLSTestControlPlane("cp-v117x", "uid-v117x")
+	other.Spec.Mtls.Mode = "PERMISSIVE"
+
+	assert.NilError(t, r.reconcileMeshPeerAuthentication(context.Background(), other))
+	condition := other.GetStatus().GetConditions()[0]
+	assert.Equal(t, condition.GetType(), meshPeerAuthenticationCondition)
+	assert.Equal(t, condition.GetStatus(), string(metav1.ConditionFalse))
+	assert.Equal(t, condition.GetReason(), "Conflict")
+
+	assert.NilError(t, c.Get(context.Background(), client.ObjectKey{Name: meshPeerAuthenticationName, Namespace: "istio-system"}, pa))
+	assert.Equal(t, pa.Spec.GetMtls().GetMode().String(), "STRICT")
+
+	// the policy of the other control plane is not removed either
+	other.Spec.Mtls = nil
+	assert.NilError(t, r.reconcileMeshPeerAuthentication(context.Background(), other))
+	assert.Equal(t, len(other.GetStatus().GetConditions()), 0)
+	assert.NilError(t, c.Get(context.Background(), client.ObjectKey{Name: meshPeerAuthenticationName, Namespace: "istio-system"}, pa))
+
+	// the owner removes the policy once the mode is unset
+	owner.Spec.Mtls = nil
+	assert.NilError(t, r.reconcileMeshPeerAuthentication(context.Background(), owner))
+	assert.Equal(t, len(owner.GetStatus().GetConditions()), 0)
+	err := c.Get(context.Background(), client.ObjectKey{Name: meshPeerAuthenticationName, Namespace: "istio-system"}, pa)
+	assert.Assert(t, k8serrors.IsNotFound(err))
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
+)
+
+// resolveIstioControlPlaneSpec returns the spec of the control plane merged over the baseline configuration of the
+// referenced profile, or the spec itself if the control plane does not reference a profile
+func resolveIstioControlPlaneSpec(ctx context.Context, c client.Client, icp *servicemeshv1alpha1.IstioControlPlane) (*servicemeshv1alpha1.IstioControlPlaneSpec, error) {
+	profileName := icp.GetSpec().GetProfile()
+	if profileName == "" {
+		return icp.GetSpec(), nil
+	}
+
+	profile := &servicemeshv1alpha1.IstioControlPlaneProfile{}
+	err := c.Get(ctx, client.ObjectKey{Name: profileName}, profile)
+	if err != nil {
+		// do not block the removal of the control plane if its profile is already gone
+		if k8serrors.IsNotFound(err) && !icp.GetDeletionTimestamp().IsZero() {
+			return icp.GetResolvedSpec(), nil
+		}
+
+		return nil, errors.WrapIfWithDetails(err, "could not get Istio control plane profile", "profile", profileName)
+	}
+
+	spec, err := pkgUtil.MergeIstioControlPlaneSpecs(nil, profile.GetSpec().GetControlPlane(), icp.GetSpec())
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not merge Istio control plane spec with its profile", "profile", profileName)
+	}
+
+	return spec, nil
+}
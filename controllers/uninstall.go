@@ -0,0 +1,153 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	uninstallPollInterval = time.Second * 5
+)
+
+// Uninstaller tears down every resource managed by the operator in dependency order: the mesh gateways first,
+// then the control planes (which remove their components and finally the base CRDs) and at last it removes any
+// remaining finalizers. It is meant to be added to the manager as a runnable so the controllers are running and
+// able to clean up after the deleted resources.
+type Uninstaller struct {
+	Client  client.Client
+	Log     logger.Logger
+	Timeout time.Duration
+
+	// OnFinished is called after a successful teardown, usually to stop the manager.
+	OnFinished func()
+}
+
+func (u *Uninstaller) Start(ctx context.Context) error {
+	u.Log.Info("uninstalling managed resources")
+
+	if err := u.deleteMeshGateways(ctx); err != nil {
+		return err
+	}
+
+	if err := u.deleteControlPlanes(ctx); err != nil {
+		return err
+	}
+
+	if err := RemoveFinalizers(ctx, u.Client); err != nil {
+		return errors.WrapIf(err, "could not remove finalizers from controlled resources")
+	}
+
+	u.Log.Info("uninstall finished")
+
+	if u.OnFinished != nil {
+		u.OnFinished()
+	}
+
+	return nil
+}
+
+func (u *Uninstaller) deleteMeshGateways(ctx context.Context) error {
+	u.Log.Info("deleting mesh gateways")
+
+	list := func(ctx context.Context) ([]servicemeshv1alpha1.IstioMeshGateway, error) {
+		var imgws servicemeshv1alpha1.IstioMeshGatewayList
+		if err := u.Client.List(ctx, &imgws); err != nil {
+			return nil, errors.WrapIf(err, "could not list istio mesh gateway resources")
+		}
+
+		items := make([]servicemeshv1alpha1.IstioMeshGateway, 0, len(imgws.Items))
+		for _, imgw := range imgws.Items {
+			// gateways owned by a control plane (e.g. mesh expansion) are removed together with their owner
+			if owner := metav1.GetControllerOf(&imgw); owner != nil && owner.Kind == "IstioControlPlane" {
+				continue
+			}
+			items = append(items, imgw)
+		}
+
+		return items, nil
+	}
+
+	imgws, err := list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, imgw := range imgws {
+		imgw := imgw
+		if err := u.Client.Delete(ctx, &imgw); client.IgnoreNotFound(err) != nil {
+			return errors.WrapIfWithDetails(err, "could not delete istio mesh gateway", "name", imgw.GetName(), "namespace", imgw.GetNamespace())
+		}
+	}
+
+	return u.waitFor(ctx, "mesh gateways", func(ctx context.Context) (int, error) {
+		imgws, err := list(ctx)
+
+		return len(imgws), err
+	})
+}
+
+func (u *Uninstaller) deleteControlPlanes(ctx context.Context) error {
+	u.Log.Info("deleting control planes")
+
+	var icps servicemeshv1alpha1.IstioControlPlaneList
+	if err := u.Client.List(ctx, &icps); err != nil {
+		return errors.WrapIf(err, "could not list Istio control plane resources")
+	}
+
+	for _, icp := range icps.Items {
+		icp := icp
+		if err := u.Client.Delete(ctx, &icp); client.IgnoreNotFound(err) != nil {
+			return errors.WrapIfWithDetails(err, "could not delete Istio control plane", "name", icp.GetName(), "namespace", icp.GetNamespace())
+		}
+	}
+
+	return u.waitFor(ctx, "control planes", func(ctx context.Context) (int, error) {
+		var icps servicemeshv1alpha1.IstioControlPlaneList
+		if err := u.Client.List(ctx, &icps); err != nil {
+			return 0, errors.WrapIf(err, "could not list Istio control plane resources")
+		}
+
+		return len(icps.Items), nil
+	})
+}
+
+func (u *Uninstaller) waitFor(ctx context.Context, what string, remaining func(ctx context.Context) (int, error)) error {
+	err := wait.PollImmediateWithContext(ctx, uninstallPollInterval, u.Timeout, func(ctx context.Context) (bool, error) {
+		count, err := remaining(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if count > 0 {
+			u.Log.Info("waiting for removal", "resources", what, "remaining", count)
+		}
+
+		return count == 0, nil
+	})
+
+	return errors.WrapIff(err, "%s were not removed", what)
+}
@@ -236,6 +236,14 @@ global:
 
 {{- define "mesh" }}
 rootNamespace: {{ .Namespace }}
+{{- if .GetSpec.GetEgress.GetEnabled.GetValue }}
+outboundTrafficPolicy:
+  mode: REGISTRY_ONLY
+{{- end }}
+{{- with .GetSpec.GetDiscoverySelectors }}
+discoverySelectors:
+{{ toYaml . }}
+{{- end }}
 {{- end }}
 
 {{- $mesh := mergeOverwrite (.Properties.GetMesh.GetSpec.GetConfig | toJsonPB | fromYaml) (.GetSpec.GetMeshConfig | toJsonPB | fromYaml) (include "mesh" . | fromYaml) }}
@@ -47,3 +47,20 @@ image: "{{ .image }}"
 image: "{{ .hub }}/{{ .image }}:{{ .tag }}"
 {{- end }}
 {{- end }}
+
+{{- define "gateways" -}}
+{{- $gateways := list (dict "name" "" "exposeIstiod" .Values.exposeIstiod "exposeWebhook" .Values.exposeWebhook "exposeClusterServices" .Values.exposeClusterServices "runAsRoot" .Values.runAsRoot "metadata" .Values.metadata "deployment" .Values.deployment "service" .Values.service) -}}
+{{- range .Values.additionalGateways }}
+{{- $gateway := dict "name" .name "exposeIstiod" $.Values.exposeIstiod "exposeWebhook" $.Values.exposeWebhook "exposeClusterServices" $.Values.exposeClusterServices "runAsRoot" false "metadata" (dict "labels" (dict) "annotations" (dict)) "deployment" (dict) "service" (dict "type" "LoadBalancer") -}}
+{{- $gateways = append $gateways (mergeOverwrite $gateway .) -}}
+{{- end }}
+{{- toYaml $gateways -}}
+{{- end -}}
+
+{{- define "gateway-resource-name" -}}
+{{- if .gateway.name -}}
+{{- include "name-with-revision" (dict "name" (printf "%s-%s" .name .gateway.name) "context" .context) -}}
+{{- else -}}
+{{- include "name-with-revision" (dict "name" .name "context" .context) -}}
+{{- end -}}
+{{- end -}}
@@ -10,6 +10,19 @@
 {{ toYamlIf (dict "value" .GetDeployment "key" "deployment") }}
 {{ toYamlIf (dict "value" .GetService "key" "service") }}
 {{ end }}
+{{- if .GetSpec.GetMeshExpansion.GetAdditionalGateways }}
+additionalGateways:
+{{- range .GetSpec.GetMeshExpansion.GetAdditionalGateways }}
+- name: {{ .GetName }}
+{{ valueIf (dict "key" "exposeIstiod" "value" .GetIstiod.GetExpose) | indent 2 }}
+{{ valueIf (dict "key" "exposeWebhook" "value" .GetWebhook.GetExpose) | indent 2 }}
+{{ valueIf (dict "key" "exposeClusterServices" "value" .GetClusterServices.GetExpose) | indent 2 }}
+{{ valueIf (dict "key" "runAsRoot" "value" .GetRunAsRoot) | indent 2 }}
+{{ toYamlIf (dict "value" .GetMetadata "key" "metadata") | indent 2 }}
+{{ toYamlIf (dict "value" .GetDeployment "key" "deployment") | indent 2 }}
+{{ toYamlIf (dict "value" .GetService "key" "service") | indent 2 }}
+{{- end }}
+{{- end }}
 {{- if .GetSpec.GetMode }}
 mode: {{ .GetSpec.GetMode | toString }}
 {{- end }}
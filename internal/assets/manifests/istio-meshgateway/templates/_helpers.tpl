@@ -23,6 +23,10 @@ istio.io/rev: {{ .Values.revision }}
 {{- include "labels" (dict "context" . "labels" .Values.service.metadata.labels) }}
 {{- end }}
 
+{{- define "color.label" -}}
+gateway-color
+{{- end }}
+
 {{- define "toYamlIf" }}
 {{- if .value }}
 {{- if .key }}
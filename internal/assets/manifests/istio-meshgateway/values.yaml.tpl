@@ -75,3 +75,16 @@ externalService:
 {{ toYamlIf (dict "value" . "key" "addresses") | indent 2 }}
 {{- end }}
 {{- end }}
+
+{{- with .Properties.BlueGreen }}
+blueGreen:
+  activeColor: {{ .ActiveColor }}
+{{ toYamlIf (dict "value" .Colors "key" "colors") | indent 2 }}
+{{- end }}
+
+{{- if eq (.Properties.GetIstioControlPlane.GetSpec.GetMode | toString) "ACTIVE" }}
+{{- with .GetSpec.GetServers }}
+gateway:
+{{ toYamlIf (dict "value" . "key" "servers") | indent 2 }}
+{{- end }}
+{{- end }}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	_ "embed"
+	"io/fs"
+	"os"
+	"path"
+	"testing"
+
+	logr "github.com/go-logr/logr/testing"
+	"github.com/homeport/dyff/pkg/dyff"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/addon"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+//go:embed testdata/icp-test-cr.yaml
+var icpTestCR []byte
+
+//go:embed testdata/icp-expected-resource-dump.yaml
+var icpExpectedResourceDump []byte
+
+func TestAddonResourceDumpFromPath(t *testing.T) {
+	t.Parallel()
+
+	chart, err := addon.LoadChart(context.Background(), nil, "istio-system", &v1alpha1.AddonChartSource{
+		Path: "testdata/ratelimit",
+	})
+	assert.NilError(t, err)
+
+	compareResourceDump(t, &v1alpha1.AddonComponent{Name: "ratelimit"}, chart)
+}
+
+func TestAddonResourceDumpFromConfigMap(t *testing.T) {
+	t.Parallel()
+
+	archive, err := packageChart("testdata/ratelimit")
+	assert.NilError(t, err)
+
+	c := clientfake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ratelimit-chart",
+			Namespace: "istio-system",
+		},
+		BinaryData: map[string][]byte{
+			"chart.tgz": archive,
+		},
+	}).Build()
+
+	chart, err := addon.LoadChart(context.Background(), c, "istio-system", &v1alpha1.AddonChartSource{
+		ConfigMap: &v1alpha1.AddonChartConfigMapSource{
+			Name: "ratelimit-chart",
+		},
+	})
+	assert.NilError(t, err)
+
+	assert.Equal(t, chart.Name, "ratelimit")
+
+	compareResourceDump(t, &v1alpha1.AddonComponent{Name: "ratelimit"}, chart)
+}
+
+func compareResourceDump(t *testing.T, a *v1alpha1.AddonComponent, chart *addon.Chart) {
+	t.Helper()
+
+	var icp *v1alpha1.IstioControlPlane
+	assert.NilError(t, yaml.Unmarshal(icpTestCR, &icp))
+
+	reconciler := addon.NewChartReconciler(
+		templatereconciler.NewHelmReconciler(nil, nil, logr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
+			reconciler.NativeReconcilerSetControllerRef(),
+		}),
+		nil,
+		a,
+		chart,
+	)
+
+	dd, err := reconciler.GetManifest(icp)
+	assert.NilError(t, err)
+
+	report, err := util.CompareYAMLs(icpExpectedResourceDump, dd)
+	assert.NilError(t, err)
+
+	if len(report.Diffs) > 0 {
+		assert.NilError(t, (&dyff.HumanReport{
+			Report:       report,
+			OmitHeader:   false,
+			NoTableStyle: true,
+		}).WriteReport(os.Stdout))
+		assert.NilError(t, util.DyffReportMultilineDiffOutput(report, os.Stdout))
+	}
+	assert.Equal(t, len(report.Diffs), 0, "generated resource dump not equals with expected")
+}
+
+func packageChart(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	err := fs.WalkDir(os.DirFS(dir), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := os.ReadFile(path.Join(dir, name))
+		if err != nil {
+			return err
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name: path.Join(path.Base(dir), name),
+			Mode: 0o644,
+			Size: int64(len(data)),
+		}); err != nil {
+			return err
+		}
+
+		_, err = tw.Write(data)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"testing/fstest"
+
+	"emperror.dev/errors"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+const (
+	defaultConfigMapChartKey = "chart.tgz"
+	ociReferencePrefix       = "oci://"
+)
+
+// pulled OCI charts are cached by their reference, the tags are expected to be immutable
+var ociChartCache sync.Map
+
+// Chart is a Helm chart loaded from an external source
+type Chart struct {
+	Name string
+	FS   fs.FS
+}
+
+// LoadChart loads the chart from the given source, a ConfigMap is looked up in the given namespace
+func LoadChart(ctx context.Context, c client.Client, namespace string, source *v1alpha1.AddonChartSource) (*Chart, error) {
+	switch {
+	case source.GetConfigMap() != nil:
+		return loadChartFromConfigMap(ctx, c, namespace, source.GetConfigMap())
+	case source.GetOci() != "":
+		return loadChartFromOCI(source.GetOci())
+	case source.GetPath() != "":
+		return loadChartFromPath(source.GetPath())
+	}
+
+	return nil, errors.NewPlain("chart source is not set")
+}
+
+func loadChartFromConfigMap(ctx context.Context, c client.Client, namespace string, source *v1alpha1.AddonChartConfigMapSource) (*Chart, error) {
+	key := source.GetKey()
+	if key == "" {
+		key = defaultConfigMapChartKey
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: source.GetName(), Namespace: namespace}, cm); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get chart configmap", "name", source.GetName(), "namespace", namespace)
+	}
+
+	data, ok := cm.BinaryData[key]
+	if !ok {
+		return nil, errors.NewWithDetails("chart not found in configmap", "name", source.GetName(), "namespace", namespace, "key", key)
+	}
+
+	return loadChartArchive(data)
+}
+
+func loadChartFromOCI(ref string) (*Chart, error) {
+	if c, ok := ociChartCache.Load(ref); ok {
+		return c.(*Chart), nil
+	}
+
+	client, err := registry.NewClient()
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not create registry client")
+	}
+
+	result, err := client.Pull(strings.TrimPrefix(ref, ociReferencePrefix))
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not pull chart", "reference", ref)
+	}
+
+	c, err := loadChartArchive(result.Chart.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ociChartCache.Store(ref, c)
+
+	return c, nil
+}
+
+func loadChartFromPath(path string) (*Chart, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not find chart", "path", path)
+	}
+
+	if !info.IsDir() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not read chart", "path", path)
+		}
+
+		return loadChartArchive(data)
+	}
+
+	return newChart(os.DirFS(path))
+}
+
+func loadChartArchive(data []byte) (*Chart, error) {
+	files, err := loader.LoadArchiveFiles(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not load chart archive")
+	}
+
+	fsys := fstest.MapFS{}
+	for _, f := range files {
+		fsys[f.Name] = &fstest.MapFile{
+			Data: f.Data,
+			Mode: 0o444,
+		}
+	}
+
+	return newChart(fsys)
+}
+
+func newChart(fsys fs.FS) (*Chart, error) {
+	data, err := fs.ReadFile(fsys, chartutil.ChartfileName)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not read chart metadata")
+	}
+
+	metadata := &chart.Metadata{}
+	if err := yaml.Unmarshal(data, metadata); err != nil {
+		return nil, errors.WrapIf(err, "could not parse chart metadata")
+	}
+
+	if metadata.Name == "" {
+		return nil, errors.NewPlain("chart name is not set in the chart metadata")
+	}
+
+	return &Chart{
+		Name: metadata.Name,
+		FS:   fsys,
+	}, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"io/fs"
+	"net/http"
+	"testing/fstest"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/operator-tools/pkg/helm"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+)
+
+const (
+	valuesTemplateFileName = "values.yaml.tpl"
+)
+
+var _ components.MinimalComponent = &Component{}
+
+type Component struct {
+	addon *v1alpha1.AddonComponent
+	chart *Chart
+}
+
+func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache, addon *v1alpha1.AddonComponent, chart *Chart) components.ComponentReconciler {
+	return &components.Base{
+		HelmReconciler: helmReconciler,
+		Cache:          cache,
+		Component: &Component{
+			addon: addon,
+			chart: chart,
+		},
+	}
+}
+
+func (rec *Component) Name() string {
+	return rec.addon.GetName()
+}
+
+func (rec *Component) Dependencies() []string {
+	return rec.addon.GetDependencies()
+}
+
+func (rec *Component) Enabled(object runtime.Object) bool {
+	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
+		return controlPlane.DeletionTimestamp.IsZero() && (rec.addon.GetEnabled() == nil || rec.addon.GetEnabled().GetValue())
+	}
+
+	return true
+}
+
+func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.ReleaseData, error) {
+	icp, ok := object.(*v1alpha1.IstioControlPlane)
+	if !ok {
+		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
+	}
+
+	values, err := rec.values(icp)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	return &templatereconciler.ReleaseData{
+		Chart:       http.FS(rec.chart.FS),
+		Values:      values,
+		Namespace:   icp.Namespace,
+		ChartName:   rec.chart.Name,
+		ReleaseName: rec.addon.GetName(),
+	}, nil
+}
+
+func (rec *Component) values(icp *v1alpha1.IstioControlPlane) (helm.Strimap, error) {
+	var templateFS fs.FS
+	if rec.addon.GetValuesTemplate() != "" {
+		templateFS = fstest.MapFS{
+			valuesTemplateFileName: &fstest.MapFile{
+				Data: []byte(rec.addon.GetValuesTemplate()),
+			},
+		}
+	} else if _, err := fs.Stat(rec.chart.FS, valuesTemplateFileName); err == nil {
+		templateFS = rec.chart.FS
+	} else {
+		return helm.Strimap{}, nil
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, templateFS, valuesTemplateFileName)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "IstioControlPlane spec cannot be converted into addon values", "addon", rec.addon.GetName())
+	}
+
+	return values, nil
+}
+
+// unavailableComponent stands in for an addon whose chart could not be loaded, so the components depending
+// on it are skipped instead of failing the whole dependency graph
+type unavailableComponent struct {
+	components.ComponentReconciler
+
+	addon *v1alpha1.AddonComponent
+	err   error
+}
+
+// NewUnavailableReconciler returns a reconciler for an addon whose chart could not be loaded. It reports the
+// loading error, except when the control plane is being deleted as the resources of the addon are owned by
+// the control plane and garbage collected together with it.
+func NewUnavailableReconciler(addon *v1alpha1.AddonComponent, err error) components.ComponentReconciler {
+	return &unavailableComponent{
+		addon: addon,
+		err:   err,
+	}
+}
+
+func (rec *unavailableComponent) Name() string {
+	return rec.addon.GetName()
+}
+
+func (rec *unavailableComponent) Dependencies() []string {
+	return rec.addon.GetDependencies()
+}
+
+func (rec *unavailableComponent) Reconcile(object runtime.Object) (reconcile.Result, error) {
+	if obj, ok := object.(client.Object); ok && !obj.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{}, errors.WrapIfWithDetails(rec.err, "could not load addon chart", "addon", rec.addon.GetName())
+}
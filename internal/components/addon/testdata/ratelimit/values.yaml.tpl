@@ -0,0 +1 @@
+revision: {{ .Name }}-{{ .Namespace }}
@@ -0,0 +1,498 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+// adoptionKinds are the kinds of the resources of an existing Istio installation which are considered for adoption
+var adoptionKinds = []schema.GroupVersionKind{
+	appsv1.SchemeGroupVersion.WithKind("Deployment"),
+	corev1.SchemeGroupVersion.WithKind("Service"),
+	corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"},
+}
+
+// upstreamBaseNames are the base names of the resources of the upstream Helm charts and istioctl which are rendered
+// with the revision and the namespace of the control plane in their names, keyed by their kinds
+var upstreamBaseNames = map[string][]string{
+	"Deployment":                     {"istiod"},
+	"Service":                        {"istiod"},
+	"ConfigMap":                      {"istio", "istio-sidecar-injector"},
+	"MutatingWebhookConfiguration":   {"istio-sidecar-injector"},
+	"ValidatingWebhookConfiguration": {"istio-validator"},
+}
+
+// webhookKinds are the kinds of the resources which are deleted instead of being adopted when they are replaced by
+// differently named rendered ones, as they would keep intercepting the requests next to the rendered ones
+var webhookKinds = map[string]bool{
+	"MutatingWebhookConfiguration":   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// selectorPaths are the paths of the selectors kept by the adopted resources, keyed by their kinds
+var selectorPaths = map[string]fieldPath{
+	"Deployment":  {"spec", "selector"},
+	"DaemonSet":   {"spec", "selector"},
+	"StatefulSet": {"spec", "selector"},
+	"Service":     {"spec", "selector"},
+}
+
+// RenderedObjects returns the objects rendered by the component for the object, except the ones to be removed
+func RenderedObjects(rec ComponentReconciler, object runtime.Object) ([]client.Object, error) {
+	if rec.Skipped(object) || !rec.Enabled(object) {
+		return nil, nil
+	}
+
+	parent, ok := object.(reconciler.ResourceOwner)
+	if !ok {
+		return nil, errors.New("cannot convert object to ResourceOwner interface")
+	}
+
+	releaseData, err := rec.ReleaseData(object)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to get release data")
+	}
+
+	rbs, err := rec.GetHelmReconciler().GetResourceBuilders(parent, rec, releaseData, false)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]client.Object, 0, len(rbs))
+	for _, rb := range rbs {
+		o, state, err := rb()
+		if err != nil {
+			return nil, err
+		}
+		if o == nil || state == nil {
+			continue
+		}
+
+		if ds, ok := state.(reconciler.DesiredStateWithStaticState); ok {
+			state = ds.DesiredState()
+		}
+		if state == reconciler.StateAbsent {
+			continue
+		}
+
+		if obj, ok := o.(client.Object); ok {
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// DetectAdoptableResources lists the resources of the existing Istio installation of the given revision and matches
+// them to the rendered objects by kind, namespace and name, or by their upstream names if the control plane names them
+// differently. The resources already managed by the owner are skipped.
+func DetectAdoptableResources(ctx context.Context, c client.Client, owner client.Object, revision string, rendered []client.Object) ([]*v1alpha1.AdoptedResource, error) {
+	renderedObjects := make(map[string]client.Object, len(rendered))
+	for _, o := range rendered {
+		renderedObjects[objectID(o.GetObjectKind().GroupVersionKind().Kind, o.GetNamespace(), o.GetName())] = o
+	}
+	renamedObjects := renamedObjects(owner, revision, rendered)
+
+	resources := []*v1alpha1.AdoptedResource{}
+	for _, gvk := range adoptionKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, list, client.MatchingLabels{v1alpha1.RevisionedAutoInjectionLabel: revision}); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not list resources", "gvk", gvk.String())
+		}
+
+		for i := range list.Items {
+			current := &list.Items[i]
+			if managedBy(owner, current) {
+				continue
+			}
+
+			resource := &v1alpha1.AdoptedResource{
+				ApiVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Namespace:  current.GetNamespace(),
+				Name:       current.GetName(),
+				State:      v1alpha1.AdoptedResource_unmatched,
+				Message:    "not rendered by the control plane, left unchanged",
+			}
+			resources = append(resources, resource)
+
+			desired, ok := renderedObjects[objectID(gvk.Kind, current.GetNamespace(), current.GetName())]
+			if !ok {
+				desired, ok = renamedObjects[objectID(gvk.Kind, current.GetNamespace(), current.GetName())]
+			}
+			if !ok {
+				if istio := current.GetLabels()["istio"]; istio == "ingressgateway" || istio == "egressgateway" {
+					resource.Message = "gateway, not rendered by the control plane, gateways are taken over by IstioMeshGateway resources"
+				}
+
+				continue
+			}
+
+			fields, err := differingFields(desired, current)
+			if err != nil {
+				return nil, err
+			}
+
+			resource.State = v1alpha1.AdoptedResource_pending
+			resource.Fields = fields
+			resource.Message = ""
+			switch {
+			case desired.GetName() != current.GetName() && webhookKinds[gvk.Kind]:
+				resource.RenderedName = desired.GetName()
+				resource.Message = fmt.Sprintf("replaced by %s, the resource is deleted when it is adopted", desired.GetName())
+			case desired.GetName() != current.GetName():
+				resource.RenderedName = desired.GetName()
+				resource.Message = fmt.Sprintf("rendered as %s, the resource is only owned by the control plane when it is adopted", desired.GetName())
+			default:
+				if selector, ok := selectorPaths[gvk.Kind]; ok && pathsBelow(resource.Fields, selector.String()) {
+					resource.Message = fmt.Sprintf("the %s of the resource is kept when it is adopted", selector)
+				}
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return adoptedResourceID(resources[i]) < adoptedResourceID(resources[j])
+	})
+
+	return resources, nil
+}
+
+// AdoptResources adds the labels of the rendered objects and the owner references of the owner to the pending resources.
+// The webhook configurations replaced by differently named rendered ones are deleted once the rendered ones exist.
+func AdoptResources(ctx context.Context, c client.Client, owner client.Object, revision string, resources []*v1alpha1.AdoptedResource, rendered []client.Object) error {
+	renderedObjects := make(map[string]client.Object, len(rendered))
+	for _, o := range rendered {
+		renderedObjects[objectID(o.GetObjectKind().GroupVersionKind().Kind, o.GetNamespace(), o.GetName())] = o
+	}
+
+	for _, resource := range resources {
+		if resource.GetState() != v1alpha1.AdoptedResource_pending {
+			continue
+		}
+
+		renderedName := resource.GetRenderedName()
+		if renderedName == "" {
+			renderedName = resource.GetName()
+		}
+
+		desired, ok := renderedObjects[objectID(resource.GetKind(), resource.GetNamespace(), renderedName)]
+		if !ok {
+			continue
+		}
+
+		current := &unstructured.Unstructured{}
+		current.SetAPIVersion(resource.GetApiVersion())
+		current.SetKind(resource.GetKind())
+		if err := c.Get(ctx, client.ObjectKey{Namespace: resource.GetNamespace(), Name: resource.GetName()}, current); err != nil {
+			return errors.WrapIfWithDetails(err, "could not get resource to adopt", "kind", resource.GetKind(), "namespace", resource.GetNamespace(), "name", resource.GetName())
+		}
+
+		if renderedName != resource.GetName() && webhookKinds[resource.GetKind()] {
+			replaced, err := replaceWebhookConfiguration(ctx, c, current, desired)
+			if err != nil {
+				return err
+			}
+			if replaced {
+				resource.State = v1alpha1.AdoptedResource_adopted
+			}
+
+			continue
+		}
+
+		original := current.DeepCopy()
+
+		labels := current.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		for k, v := range desired.GetLabels() {
+			labels[k] = v
+		}
+		current.SetLabels(labels)
+
+		annotations := current.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[types.BanzaiCloudRelatedTo] = client.ObjectKeyFromObject(owner).String()
+		annotations[v1alpha1.AdoptedFromRevisionAnnotation] = revision
+		current.SetAnnotations(annotations)
+
+		// namespaced resources can only be owned by resources in the same namespace
+		if current.GetNamespace() == owner.GetNamespace() && metav1.GetControllerOf(current) == nil {
+			if err := controllerutil.SetControllerReference(owner, current, c.Scheme()); err != nil {
+				return errors.WrapIf(err, "could not set owner reference")
+			}
+		}
+
+		if err := c.Patch(ctx, current, client.MergeFrom(original)); err != nil {
+			return errors.WrapIfWithDetails(err, "could not adopt resource", "kind", resource.GetKind(), "namespace", resource.GetNamespace(), "name", resource.GetName())
+		}
+
+		resource.State = v1alpha1.AdoptedResource_adopted
+	}
+
+	return nil
+}
+
+// replaceWebhookConfiguration deletes the webhook configuration of the existing installation if its rendered replacement
+// exists already, so the requests are intercepted by one of them all the time
+func replaceWebhookConfiguration(ctx context.Context, c client.Client, current *unstructured.Unstructured, desired client.Object) (bool, error) {
+	replacement := &unstructured.Unstructured{}
+	replacement.SetGroupVersionKind(current.GroupVersionKind())
+	if err := c.Get(ctx, client.ObjectKey{Namespace: desired.GetNamespace(), Name: desired.GetName()}, replacement); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, errors.WrapIfWithDetails(err, "could not get rendered webhook configuration", "kind", current.GetKind(), "name", desired.GetName())
+	}
+
+	if err := c.Delete(ctx, current); client.IgnoreNotFound(err) != nil {
+		return false, errors.WrapIfWithDetails(err, "could not delete replaced webhook configuration", "kind", current.GetKind(), "name", current.GetName())
+	}
+
+	return true, nil
+}
+
+// MergeAdoptedResources keeps the adopted resources of the previous detections which are not detected anymore,
+// since adopted resources get the labels of the control plane when they are reconciled
+func MergeAdoptedResources(previous, detected []*v1alpha1.AdoptedResource) []*v1alpha1.AdoptedResource {
+	resources := make(map[string]*v1alpha1.AdoptedResource, len(previous)+len(detected))
+	for _, resource := range previous {
+		if resource.GetState() == v1alpha1.AdoptedResource_adopted {
+			resources[adoptedResourceID(resource)] = resource
+		}
+	}
+	for _, resource := range detected {
+		resources[adoptedResourceID(resource)] = resource
+	}
+
+	merged := make([]*v1alpha1.AdoptedResource, 0, len(resources))
+	for _, resource := range resources {
+		merged = append(merged, resource)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return adoptedResourceID(merged[i]) < adoptedResourceID(merged[j])
+	})
+
+	return merged
+}
+
+// AdoptionClient returns a client which keeps the selectors of the adopted resources when they are updated through it,
+// so the existing pods keep being selected while they are replaced
+func AdoptionClient(c client.Client) client.Client {
+	return &adoptionClient{
+		Client: c,
+	}
+}
+
+type adoptionClient struct {
+	client.Client
+}
+
+func (c *adoptionClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.keepSelector(ctx, obj); err != nil {
+		return err
+	}
+
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *adoptionClient) keepSelector(ctx context.Context, desired client.Object) error {
+	selectorPath, ok := selectorPaths[desired.GetObjectKind().GroupVersionKind().Kind]
+	if !ok {
+		return nil
+	}
+
+	current, err := newObjectFor(c.Scheme(), desired)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if _, ok := current.GetAnnotations()[v1alpha1.AdoptedFromRevisionAnnotation]; !ok {
+		return nil
+	}
+
+	var currentMap, desiredMap map[string]interface{}
+	if err := convertToMap(current, &currentMap); err != nil {
+		return err
+	}
+	if err := convertToMap(desired, &desiredMap); err != nil {
+		return err
+	}
+
+	selector, ok := selectorPath.get(currentMap)
+	if !ok {
+		return nil
+	}
+	selectorPath.set(desiredMap, selector)
+
+	// the pods of workloads must keep matching the selector
+	if matchLabels, ok := (fieldPath{"matchLabels"}).get(selector); ok {
+		if labels, ok := (fieldPath{"spec", "template", "metadata", "labels"}).get(desiredMap); ok {
+			labels, ok := labels.(map[string]interface{})
+			matchLabels, isMap := matchLabels.(map[string]interface{})
+			if ok && isMap {
+				for k, v := range matchLabels {
+					labels[k] = v
+				}
+			}
+		}
+	}
+
+	return convertFromMap(desiredMap, desired)
+}
+
+// differingFields returns the paths of the rendered fields of the desired object which differ from the current one
+func differingFields(desired, current client.Object) ([]string, error) {
+	var desiredMap, currentMap map[string]interface{}
+	if err := convertToMap(desired, &desiredMap); err != nil {
+		return nil, err
+	}
+	if err := convertToMap(current, &currentMap); err != nil {
+		return nil, err
+	}
+
+	// only the labels and annotations of the metadata are rendered
+	metadata := map[string]interface{}{}
+	if labels, ok := (fieldPath{"metadata", "labels"}).get(desiredMap); ok {
+		metadata["labels"] = labels
+	}
+	if annotations, ok := (fieldPath{"metadata", "annotations"}).get(desiredMap); ok {
+		if annotations, ok := annotations.(map[string]interface{}); ok {
+			delete(annotations, patch.LastAppliedConfig)
+			if len(annotations) > 0 {
+				metadata["annotations"] = annotations
+			}
+		}
+	}
+	desiredMap["metadata"] = metadata
+	delete(desiredMap, "status")
+
+	paths := changedPaths(desiredMap, currentMap, nil)
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		fields = append(fields, p.String())
+	}
+
+	return fields, nil
+}
+
+// pathsBelow checks whether any of the paths equals or is below the given one
+func pathsBelow(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path || strings.HasPrefix(p, path+".") || strings.HasPrefix(p, path+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renamedObjects returns the rendered objects which are named differently than the corresponding resources of the
+// upstream Helm charts and istioctl, keyed by the IDs of the upstream resources of the given revision
+func renamedObjects(owner client.Object, revision string, rendered []client.Object) map[string]client.Object {
+	ownerRevision := strings.ReplaceAll(owner.GetName(), ".", "-")
+	objects := make(map[string]client.Object)
+	for _, o := range rendered {
+		kind := o.GetObjectKind().GroupVersionKind().Kind
+		for _, base := range upstreamBaseNames[kind] {
+			// the names rendered by the cisco and the official distributions
+			names := []string{
+				fmt.Sprintf("%s-%s", base, ownerRevision),
+				fmt.Sprintf("%s-%s-%s", base, ownerRevision, owner.GetNamespace()),
+				fmt.Sprintf("%s-%s.%s", base, owner.GetName(), owner.GetNamespace()),
+				fmt.Sprintf("%s-%s.%s-%s", base, owner.GetName(), owner.GetNamespace(), owner.GetNamespace()),
+			}
+			for _, name := range names {
+				if o.GetName() != name {
+					continue
+				}
+
+				upstream := upstreamName(kind, base, revision, owner.GetNamespace())
+				if upstream != o.GetName() {
+					objects[objectID(kind, o.GetNamespace(), upstream)] = o
+				}
+			}
+		}
+	}
+
+	return objects
+}
+
+// upstreamName returns the name of the resource of the upstream Helm charts and istioctl of the given revision
+func upstreamName(kind, base, revision, namespace string) string {
+	name := base
+	if revision != "" && revision != "default" {
+		name = fmt.Sprintf("%s-%s", base, revision)
+	}
+
+	if kind == "ValidatingWebhookConfiguration" {
+		name = fmt.Sprintf("%s-%s", name, namespace)
+	}
+
+	return name
+}
+
+func managedBy(owner client.Object, obj client.Object) bool {
+	if obj.GetAnnotations()[types.BanzaiCloudRelatedTo] == client.ObjectKeyFromObject(owner).String() {
+		return true
+	}
+
+	if ref := metav1.GetControllerOf(obj); ref != nil && ref.UID == owner.GetUID() {
+		return true
+	}
+
+	return false
+}
+
+func objectID(kind, namespace, name string) string {
+	return fmt.Sprintf("%s %s/%s", kind, namespace, name)
+}
+
+func adoptedResourceID(resource *v1alpha1.AdoptedResource) string {
+	return objectID(resource.GetKind(), resource.GetNamespace(), resource.GetName())
+}
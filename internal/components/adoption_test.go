@@ -0,0 +1,309 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+func TestAdoption(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, v1alpha1.AddToScheme(scheme))
+
+	owner := &v1alpha1.IstioControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       "IstioControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "1-17",
+			Namespace: "istio-system",
+			UID:       "icp-uid",
+		},
+	}
+
+	deployment := func(name string, labels map[string]string, selector map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "istio-system",
+				Labels:    labels,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: selector},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				},
+			},
+		}
+	}
+	configMap := func(name string, labels map[string]string, annotations map[string]string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "istio-system",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Data: data,
+		}
+	}
+
+	existingLabels := map[string]string{"app": "istiod", "istio.io/rev": "1-17"}
+	renderedLabels := map[string]string{"app": "istiod", "istio.io/rev": "1-17.istio-system"}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		deployment("istiod-1-17", existingLabels, existingLabels),
+		deployment("istio-ingressgateway", map[string]string{"istio": "ingressgateway", "istio.io/rev": "1-17"}, nil),
+		configMap("istio-1-17", existingLabels, nil, map[string]string{"mesh": "{}"}),
+		configMap("managed", existingLabels, map[string]string{types.BanzaiCloudRelatedTo: "istio-system/1-17"}, nil),
+		configMap("other-revision", map[string]string{"istio.io/rev": "1-16"}, nil, nil),
+	).Build()
+
+	rendered := []client.Object{
+		deployment("istiod-1-17", renderedLabels, renderedLabels),
+		configMap("istio-1-17", renderedLabels, nil, map[string]string{"mesh": "{}"}),
+	}
+
+	ctx := context.Background()
+	resources, err := DetectAdoptableResources(ctx, c, owner, "1-17", rendered)
+	assert.NilError(t, err)
+
+	expected := map[string]v1alpha1.AdoptedResource_State{
+		"ConfigMap istio-system/istio-1-17":            v1alpha1.AdoptedResource_pending,
+		"Deployment istio-system/istio-ingressgateway": v1alpha1.AdoptedResource_unmatched,
+		"Deployment istio-system/istiod-1-17":          v1alpha1.AdoptedResource_pending,
+	}
+	assert.Equal(t, len(resources), len(expected), "resources: %v", resources)
+	states := make(map[string]v1alpha1.AdoptedResource_State, len(resources))
+	for _, resource := range resources {
+		states[adoptedResourceID(resource)] = resource.GetState()
+	}
+	assert.DeepEqual(t, states, expected)
+
+	istiod := resources[2]
+	assert.Assert(t, pathsBelow(istiod.GetFields(), "spec.selector"), "selector difference is not reported: %v", istiod)
+	assert.Assert(t, istiod.GetMessage() != "")
+	assert.DeepEqual(t, resources[0].GetFields(), []string{"metadata.labels[istio.io/rev]"})
+
+	assert.NilError(t, AdoptResources(ctx, c, owner, "1-17", resources, rendered))
+
+	current := &appsv1.Deployment{}
+	assert.NilError(t, c.Get(ctx, client.ObjectKey{Namespace: "istio-system", Name: "istiod-1-17"}, current))
+	assert.Equal(t, istiod.GetState(), v1alpha1.AdoptedResource_adopted)
+	assert.Equal(t, current.GetAnnotations()[v1alpha1.AdoptedFromRevisionAnnotation], "1-17")
+	assert.Equal(t, current.GetLabels()["istio.io/rev"], "1-17.istio-system")
+	assert.Assert(t, metav1.GetControllerOf(current) != nil, "resource is not adopted: %v", current.ObjectMeta)
+	assert.Equal(t, metav1.GetControllerOf(current).UID, owner.GetUID())
+
+	// the selector of the adopted deployment is kept when it is updated
+	desired := deployment("istiod-1-17", renderedLabels, renderedLabels)
+	desired.SetResourceVersion(current.GetResourceVersion())
+	assert.NilError(t, AdoptionClient(c).Update(ctx, desired))
+	assert.NilError(t, c.Get(ctx, client.ObjectKey{Namespace: "istio-system", Name: "istiod-1-17"}, current))
+	assert.Equal(t, current.Spec.Selector.MatchLabels["istio.io/rev"], "1-17")
+	assert.Equal(t, current.Spec.Template.GetLabels()["istio.io/rev"], "1-17")
+
+	merged := MergeAdoptedResources(resources, nil)
+	assert.Equal(t, len(merged), 2, "adopted resources are not kept: %v", merged)
+}
+
+func TestAdoptionOfRenamedResources(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, v1alpha1.AddToScheme(scheme))
+
+	object := func(kind, name string, labels map[string]string) client.Object {
+		var o client.Object
+		switch kind {
+		case "Deployment":
+			o = &appsv1.Deployment{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: kind}}
+		case "Service":
+			o = &corev1.Service{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: kind}}
+		case "ConfigMap":
+			o = &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: kind}}
+		case "MutatingWebhookConfiguration":
+			o = &admissionregistrationv1.MutatingWebhookConfiguration{TypeMeta: metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: kind}}
+		case "ValidatingWebhookConfiguration":
+			o = &admissionregistrationv1.ValidatingWebhookConfiguration{TypeMeta: metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: kind}}
+		}
+		o.SetName(name)
+		if !strings.HasSuffix(kind, "WebhookConfiguration") {
+			o.SetNamespace("istio-system")
+		}
+		o.SetLabels(labels)
+
+		return o
+	}
+
+	testCases := []struct {
+		name          string
+		owner         string
+		revision      string
+		existing      []client.Object
+		rendered      []client.Object
+		renderedNames map[string]string
+	}{
+		{
+			name:     "revisioned installation",
+			owner:    "1-17",
+			revision: "1-17",
+			existing: []client.Object{
+				object("Deployment", "istiod-1-17", map[string]string{"istio.io/rev": "1-17"}),
+				object("MutatingWebhookConfiguration", "istio-sidecar-injector-1-17", map[string]string{"istio.io/rev": "1-17"}),
+				object("ValidatingWebhookConfiguration", "istio-validator-1-17-istio-system", map[string]string{"istio.io/rev": "1-17"}),
+			},
+			rendered: []client.Object{
+				object("Deployment", "istiod-1-17", map[string]string{"istio.io/rev": "1-17.istio-system"}),
+				object("MutatingWebhookConfiguration", "istio-sidecar-injector-1-17-istio-system", map[string]string{"istio.io/rev": "1-17.istio-system"}),
+				object("ValidatingWebhookConfiguration", "istio-validator-1-17-istio-system", map[string]string{"istio.io/rev": "1-17.istio-system"}),
+			},
+			renderedNames: map[string]string{
+				"Deployment istio-system/istiod-1-17":                               "",
+				"MutatingWebhookConfiguration /istio-sidecar-injector-1-17":         "istio-sidecar-injector-1-17-istio-system",
+				"ValidatingWebhookConfiguration /istio-validator-1-17-istio-system": "",
+			},
+		},
+		{
+			name:     "default installation",
+			owner:    "cp-v117x",
+			revision: "default",
+			existing: []client.Object{
+				object("Deployment", "istiod", map[string]string{"istio.io/rev": "default"}),
+				object("Service", "istiod", map[string]string{"istio.io/rev": "default"}),
+				object("ConfigMap", "istio", map[string]string{"istio.io/rev": "default"}),
+				object("ConfigMap", "istio-sidecar-injector", map[string]string{"istio.io/rev": "default"}),
+				object("MutatingWebhookConfiguration", "istio-sidecar-injector", map[string]string{"istio.io/rev": "default"}),
+				object("ValidatingWebhookConfiguration", "istio-validator-istio-system", map[string]string{"istio.io/rev": "default"}),
+			},
+			rendered: []client.Object{
+				object("Deployment", "istiod-cp-v117x", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+				object("Service", "istiod-cp-v117x", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+				object("ConfigMap", "istio-cp-v117x", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+				object("ConfigMap", "istio-sidecar-injector-cp-v117x", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+				object("MutatingWebhookConfiguration", "istio-sidecar-injector-cp-v117x-istio-system", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+				object("ValidatingWebhookConfiguration", "istio-validator-cp-v117x-istio-system", map[string]string{"istio.io/rev": "cp-v117x.istio-system"}),
+			},
+			renderedNames: map[string]string{
+				"ConfigMap istio-system/istio":                                 "istio-cp-v117x",
+				"ConfigMap istio-system/istio-sidecar-injector":                "istio-sidecar-injector-cp-v117x",
+				"Deployment istio-system/istiod":                               "istiod-cp-v117x",
+				"MutatingWebhookConfiguration /istio-sidecar-injector":         "istio-sidecar-injector-cp-v117x-istio-system",
+				"Service istio-system/istiod":                                  "istiod-cp-v117x",
+				"ValidatingWebhookConfiguration /istio-validator-istio-system": "istio-validator-cp-v117x-istio-system",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			owner := &v1alpha1.IstioControlPlane{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: v1alpha1.GroupVersion.String(),
+					Kind:       "IstioControlPlane",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      tc.owner,
+					Namespace: "istio-system",
+					UID:       "icp-uid",
+				},
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.existing...).Build()
+
+			ctx := context.Background()
+			resources, err := DetectAdoptableResources(ctx, c, owner, tc.revision, tc.rendered)
+			assert.NilError(t, err)
+
+			renderedNames := make(map[string]string, len(resources))
+			for _, resource := range resources {
+				assert.Equal(t, resource.GetState(), v1alpha1.AdoptedResource_pending, "resource is not matched: %v", resource)
+				renderedNames[adoptedResourceID(resource)] = resource.GetRenderedName()
+			}
+			assert.DeepEqual(t, renderedNames, tc.renderedNames)
+
+			// the replaced webhook configurations are kept until the rendered ones exist
+			assert.NilError(t, AdoptResources(ctx, c, owner, tc.revision, resources, tc.rendered))
+			for _, resource := range resources {
+				webhook := resource.GetRenderedName() != "" && webhookKinds[resource.GetKind()]
+				assert.Equal(t, resource.GetState() == v1alpha1.AdoptedResource_pending, webhook, "unexpected state: %v", resource)
+			}
+
+			for _, o := range tc.rendered {
+				if !webhookKinds[o.GetObjectKind().GroupVersionKind().Kind] {
+					continue
+				}
+				// the webhook configurations named the same way are adopted in place
+				if err := c.Create(ctx, o.DeepCopyObject().(client.Object)); !k8serrors.IsAlreadyExists(err) {
+					assert.NilError(t, err)
+				}
+			}
+			assert.NilError(t, AdoptResources(ctx, c, owner, tc.revision, resources, tc.rendered))
+
+			for _, resource := range resources {
+				assert.Equal(t, resource.GetState(), v1alpha1.AdoptedResource_adopted, "resource is not adopted: %v", resource)
+
+				current := &unstructured.Unstructured{}
+				current.SetAPIVersion(resource.GetApiVersion())
+				current.SetKind(resource.GetKind())
+				err := c.Get(ctx, client.ObjectKey{Namespace: resource.GetNamespace(), Name: resource.GetName()}, current)
+				if resource.GetRenderedName() != "" && webhookKinds[resource.GetKind()] {
+					assert.Assert(t, k8serrors.IsNotFound(err), "replaced webhook configuration is not deleted: %v", resource)
+
+					continue
+				}
+				assert.NilError(t, err)
+				assert.Equal(t, current.GetAnnotations()[v1alpha1.AdoptedFromRevisionAnnotation], tc.revision)
+				assert.Equal(t, current.GetLabels()["istio.io/rev"], fmt.Sprintf("%s.istio-system", tc.owner))
+			}
+		})
+	}
+}
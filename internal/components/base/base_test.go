@@ -59,6 +59,7 @@ func TestICPBaseResourceDump(t *testing.T) {
 		templatereconciler.NewHelmReconciler(nil, nil, logr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 		logger.NewWithLogrLogger(logr.NewTestLogger(t)),
 		"1.17.8",
 	)
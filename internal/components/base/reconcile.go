@@ -54,9 +54,10 @@ type Component struct {
 	supportedIstioVersion string
 }
 
-func NewComponentReconciler(helmReconciler *templatereconciler.HelmReconciler, logger logger.Logger, supportedIstioVersion string) components.ComponentReconciler {
+func NewComponentReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache, logger logger.Logger, supportedIstioVersion string) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component: &Component{
 			logger:                logger,
 			supportedIstioVersion: supportedIstioVersion,
@@ -68,6 +69,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) Dependencies() []string {
+	return nil
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero()
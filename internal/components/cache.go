@@ -0,0 +1,399 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pkgUtil "github.com/banzaicloud/istio-operator/v2/pkg/util"
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/banzaicloud/operator-tools/pkg/helm"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+// discoveryCacheTTL determines how long the discovered server resources are reused before being fetched again
+const discoveryCacheTTL = 5 * time.Minute
+
+// Cache keeps the helm reconcilers of the components and the outcome of their last render across reconcile passes.
+// Components whose values did not change since their last successful reconciliation are only checked for drift
+// against the cached desired objects instead of being rendered and applied again.
+type Cache struct {
+	mu sync.Mutex
+
+	discovery            discovery.CachedDiscoveryInterface
+	discoveryRefreshedAt time.Time
+	helmReconcilers      map[string]*HelmReconciler
+	renders              map[renderKey]render
+}
+
+type renderKey struct {
+	owner     k8stypes.UID
+	component string
+}
+
+type render struct {
+	hash    string
+	objects []desiredObject
+}
+
+type desiredObject struct {
+	object runtime.Object
+	state  reconciler.DesiredState
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		helmReconcilers: make(map[string]*HelmReconciler),
+		renders:         make(map[renderKey]render),
+	}
+}
+
+// Discovery returns the discovery client shared by the components. The server resources are cached and invalidated
+// periodically, so newly installed APIs are picked up eventually.
+func (c *Cache) Discovery() (discovery.DiscoveryInterface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery == nil {
+		config, err := ctrl.GetConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		d, err := discovery.NewDiscoveryClientForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
+		c.discovery = memory.NewMemCacheClient(d)
+		c.discoveryRefreshedAt = time.Now()
+	} else if time.Since(c.discoveryRefreshedAt) > discoveryCacheTTL {
+		c.discovery.Invalidate()
+		c.discoveryRefreshedAt = time.Now()
+	}
+
+	return c.discovery, nil
+}
+
+// HelmReconciler returns the helm reconciler of the named component, creating it on first use
+func (c *Cache) HelmReconciler(name string, newHelmReconciler func() (*HelmReconciler, error)) (*HelmReconciler, error) {
+	c.mu.Lock()
+	if helmReconciler, ok := c.helmReconcilers[name]; ok {
+		c.mu.Unlock()
+
+		return helmReconciler, nil
+	}
+	c.mu.Unlock()
+
+	// the constructor may call back into the cache for the discovery client, so it must run unlocked
+	helmReconciler, err := newHelmReconciler()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.helmReconcilers[name]; ok {
+		return existing, nil
+	}
+	c.helmReconcilers[name] = helmReconciler
+
+	return helmReconciler, nil
+}
+
+// Hashes returns the values hashes of the components of the owner which were reconciled successfully, keyed by the
+// names of the components
+func (c *Cache) Hashes(owner k8stypes.UID) map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hashes := make(map[string]string)
+	for key, r := range c.renders {
+		if key.owner == owner {
+			hashes[key.component] = r.hash
+		}
+	}
+
+	return hashes
+}
+
+// Forget drops every render of the components of the owner
+func (c *Cache) Forget(owner k8stypes.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.renders {
+		if key.owner == owner {
+			delete(c.renders, key)
+		}
+	}
+}
+
+func (c *Cache) get(key renderKey) (render, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.renders[key]
+
+	return r, ok
+}
+
+func (c *Cache) store(key renderKey, r render) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.renders[key] = r
+}
+
+func (c *Cache) forget(key renderKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.renders, key)
+}
+
+// ValuesHash identifies the inputs of a render. The generation of the owner is part of it, as the overlays and the
+// desired state overrides of the release are functions which cannot be hashed themselves. The content of the chart is
+// part of it as well, since the charts of the addons may change without the generation of the owner being bumped.
+func ValuesHash(owner client.Object, releaseData *templatereconciler.ReleaseData) (string, error) {
+	chart, err := chartDigest(releaseData.Chart)
+	if err != nil {
+		return "", err
+	}
+
+	overrides := make([]string, 0, len(releaseData.DesiredStateOverrides))
+	for key, state := range releaseData.DesiredStateOverrides {
+		s := "Dynamic"
+		if static, ok := state.(reconciler.StaticDesiredState); ok {
+			s = string(static)
+		}
+		overrides = append(overrides, fmt.Sprintf("%s %s=%s", key.GVK, key.ObjectKey, s))
+	}
+	sort.Strings(overrides)
+
+	j, err := json.Marshal(struct {
+		Generation  int64                  `json:"generation"`
+		Chart       string                 `json:"chart"`
+		Namespace   string                 `json:"namespace"`
+		ChartName   string                 `json:"chartName"`
+		ReleaseName string                 `json:"releaseName"`
+		Values      map[string]interface{} `json:"values"`
+		Overrides   []string               `json:"overrides"`
+	}{
+		Generation:  owner.GetGeneration(),
+		Chart:       chart,
+		Namespace:   releaseData.Namespace,
+		ChartName:   releaseData.ChartName,
+		ReleaseName: releaseData.ReleaseName,
+		Values:      releaseData.Values,
+		Overrides:   overrides,
+	})
+	if err != nil {
+		return "", errors.WrapIf(err, "could not marshal release data")
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(j)), nil
+}
+
+func chartDigest(chart http.FileSystem) (string, error) {
+	if chart == nil {
+		return "", nil
+	}
+
+	files, err := helm.GetFiles(chart)
+	if err != nil {
+		return "", errors.WrapIf(err, "could not read chart files")
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	values, err := helm.GetDefaultValues(chart)
+	if err != nil && !os.IsNotExist(err) {
+		return "", errors.WrapIf(err, "could not read chart values")
+	}
+
+	h := sha256.New()
+	for _, file := range files {
+		fmt.Fprintf(h, "%s\x00%d\x00", file.Name, len(file.Data))
+		h.Write(file.Data)
+	}
+	h.Write(values)
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// inSync checks whether the objects of a cached render are still in the state they were last reconciled to. The
+// objects are compared to their last applied configuration, the same way the resource reconciler decides whether an
+// update is needed.
+func inSync(ctx context.Context, c client.Client, objects []desiredObject) (bool, error) {
+	for _, o := range objects {
+		ok, err := objectInSync(ctx, c, o)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func objectInSync(ctx context.Context, c client.Client, o desiredObject) (bool, error) {
+	current, err := newObjectFor(c.Scheme(), o.object)
+	if err != nil {
+		return false, err
+	}
+
+	m, err := meta.Accessor(o.object)
+	if err != nil {
+		return false, err
+	}
+
+	err = c.Get(ctx, client.ObjectKey{Namespace: m.GetNamespace(), Name: m.GetName()}, current)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+	found := err == nil
+	current.GetObjectKind().SetGroupVersionKind(o.object.GetObjectKind().GroupVersionKind())
+
+	state := o.state
+	if ds, ok := o.state.(reconciler.DesiredStateWithStaticState); ok {
+		state = ds.DesiredState()
+	} else if ds, ok := o.state.(reconciler.DesiredStateWithGetter); ok {
+		state = ds.GetDesiredState()
+	}
+	if _, ok := m.GetAnnotations()[types.BanzaiCloudDesiredStateCreated]; ok && state == reconciler.StatePresent {
+		state = reconciler.StateCreated
+	}
+
+	switch state {
+	case reconciler.StateAbsent:
+		return !found, nil
+	case reconciler.StateCreated:
+		return found, nil
+	}
+
+	if !found {
+		if ds, ok := o.state.(reconciler.DesiredStateShouldCreate); ok {
+			return ds.ShouldCreate(o.object.DeepCopyObject())
+		}
+
+		return false, nil
+	}
+
+	if !current.GetDeletionTimestamp().IsZero() {
+		return false, nil
+	}
+
+	original, err := patch.DefaultAnnotator.GetOriginalConfiguration(current)
+	if err != nil || original == nil {
+		return false, err
+	}
+
+	applied, err := newObjectFor(c.Scheme(), current)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(original, applied); err != nil {
+		return false, errors.WrapIf(err, "could not decode last applied configuration")
+	}
+
+	// the resource reconciler keeps the labels and annotations of the current object which are not rendered
+	merged := (&types.MetaBase{
+		Labels:      m.GetLabels(),
+		Annotations: m.GetAnnotations(),
+	}).Merge(metav1.ObjectMeta{
+		Labels:      copyStringMap(current.GetLabels()),
+		Annotations: copyStringMap(current.GetAnnotations()),
+	})
+	applied.SetLabels(merged.Labels)
+	applied.SetAnnotations(merged.Annotations)
+
+	if ds, ok := o.state.(reconciler.DesiredStateShouldUpdate); ok {
+		should, err := ds.ShouldUpdate(current.DeepCopyObject(), applied)
+		if err != nil || !should {
+			return !should, err
+		}
+	}
+
+	patchResult, err := pkgUtil.NewProtoCompatiblePatchMaker().Calculate(current, applied, patch.IgnoreStatusFields(), reconciler.IgnoreManagedFields())
+	if err != nil {
+		return false, err
+	}
+
+	return patchResult.IsEmpty(), nil
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
+
+// newObjectFor returns an empty object of the same kind, preferring the typed variant of unstructured objects
+func newObjectFor(scheme *runtime.Scheme, object runtime.Object) (client.Object, error) {
+	gvk := object.GetObjectKind().GroupVersionKind()
+
+	var o runtime.Object
+	if _, ok := object.(*unstructured.Unstructured); ok {
+		if typed, err := scheme.New(gvk); err == nil {
+			o = typed
+		} else {
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(gvk)
+			o = u
+		}
+	} else {
+		o = reflect.New(reflect.Indirect(reflect.ValueOf(object)).Type()).Interface().(runtime.Object)
+	}
+
+	if obj, ok := o.(client.Object); ok {
+		return obj, nil
+	}
+
+	return nil, errors.NewWithDetails("object is not a client object", "gvk", gvk.String())
+}
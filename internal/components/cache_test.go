@@ -0,0 +1,226 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+func TestValuesHash(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "owner",
+			Generation: 1,
+		},
+	}
+	releaseData := func(replicas int) *templatereconciler.ReleaseData {
+		return &templatereconciler.ReleaseData{
+			Namespace:   "istio-system",
+			ChartName:   "chart",
+			ReleaseName: "release",
+			Values: map[string]interface{}{
+				"replicas": replicas,
+			},
+		}
+	}
+
+	hash, err := ValuesHash(owner, releaseData(1))
+	assert.NilError(t, err)
+
+	same, err := ValuesHash(owner, releaseData(1))
+	assert.NilError(t, err)
+	assert.Equal(t, hash, same)
+
+	changedValues, err := ValuesHash(owner, releaseData(2))
+	assert.NilError(t, err)
+	assert.Assert(t, hash != changedValues, "hash did not change with the values")
+
+	owner.Generation = 2
+	changedGeneration, err := ValuesHash(owner, releaseData(1))
+	assert.NilError(t, err)
+	assert.Assert(t, hash != changedGeneration, "hash did not change with the generation of the owner")
+}
+
+func TestValuesHashChart(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "owner",
+			Generation: 1,
+		},
+	}
+	releaseData := func(template string) *templatereconciler.ReleaseData {
+		return &templatereconciler.ReleaseData{
+			Chart: http.FS(fstest.MapFS{
+				"Chart.yaml":        {Data: []byte("name: chart\nversion: 0.1.0\n")},
+				"values.yaml":       {Data: []byte("replicas: 1\n")},
+				"templates/cm.yaml": {Data: []byte(template)},
+			}),
+			Namespace:   "istio-system",
+			ChartName:   "chart",
+			ReleaseName: "release",
+		}
+	}
+
+	hash, err := ValuesHash(owner, releaseData("kind: ConfigMap\n"))
+	assert.NilError(t, err)
+
+	same, err := ValuesHash(owner, releaseData("kind: ConfigMap\n"))
+	assert.NilError(t, err)
+	assert.Equal(t, hash, same)
+
+	changedChart, err := ValuesHash(owner, releaseData("kind: Secret\n"))
+	assert.NilError(t, err)
+	assert.Assert(t, hash != changedChart, "hash did not change with the content of the chart")
+}
+
+func TestInSync(t *testing.T) {
+	t.Parallel()
+
+	desired := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "istio-system",
+				Labels: map[string]string{
+					"app": "istio",
+				},
+			},
+			Data: map[string]string{
+				"mesh": "enabled",
+			},
+		}
+	}
+
+	applied := func() client.Object {
+		o := desired()
+		assert.NilError(t, patch.DefaultAnnotator.SetLastAppliedAnnotation(o))
+
+		return o
+	}
+
+	testCases := []struct {
+		name     string
+		existing []client.Object
+		state    reconciler.DesiredState
+		inSync   bool
+	}{
+		{
+			name:     "unchanged object",
+			existing: []client.Object{applied()},
+			state:    reconciler.StatePresent,
+			inSync:   true,
+		},
+		{
+			name: "labels added by others",
+			existing: []client.Object{func() client.Object {
+				o := applied()
+				o.SetLabels(map[string]string{"app": "istio", "team": "mesh"})
+
+				return o
+			}()},
+			state:  reconciler.StatePresent,
+			inSync: true,
+		},
+		{
+			name: "changed data",
+			existing: []client.Object{func() client.Object {
+				o := applied().(*corev1.ConfigMap)
+				o.Data["mesh"] = "disabled"
+
+				return o
+			}()},
+			state:  reconciler.StatePresent,
+			inSync: false,
+		},
+		{
+			name:     "missing last applied configuration",
+			existing: []client.Object{desired()},
+			state:    reconciler.StatePresent,
+			inSync:   false,
+		},
+		{
+			name:   "deleted object",
+			state:  reconciler.StatePresent,
+			inSync: false,
+		},
+		{
+			name: "changes ignored by the desired state",
+			existing: []client.Object{func() client.Object {
+				o := applied().(*corev1.ConfigMap)
+				o.Data["mesh"] = "disabled"
+
+				return o
+			}()},
+			state: reconciler.DynamicDesiredState{
+				ShouldUpdateFunc: func(current, desired runtime.Object) (bool, error) {
+					return false, nil
+				},
+			},
+			inSync: true,
+		},
+		{
+			name:   "absent object",
+			state:  reconciler.StateAbsent,
+			inSync: true,
+		},
+		{
+			name:     "object which should be absent",
+			existing: []client.Object{applied()},
+			state:    reconciler.StateAbsent,
+			inSync:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(tc.existing...).Build()
+
+			synced, err := inSync(context.Background(), c, []desiredObject{{
+				object: desired(),
+				state:  tc.state,
+			}})
+			assert.NilError(t, err)
+			assert.Equal(t, synced, tc.inSync)
+		})
+	}
+}
@@ -43,9 +43,10 @@ var _ components.MinimalComponent = &Component{}
 
 type Component struct{}
 
-func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler) components.ComponentReconciler {
+func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component:      &Component{},
 	}
 }
@@ -54,6 +55,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) Dependencies() []string {
+	return nil
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		getCniEnabled := controlPlane.GetSpec().GetProxyInit().GetCni().GetEnabled().GetValue()
@@ -86,7 +91,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
 		ReleaseName: releaseName,
-		Layers:      overlays,
+		Modifiers:   overlays,
 	}, nil
 }
 
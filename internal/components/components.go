@@ -35,17 +35,20 @@ import (
 
 type (
 	HelmReconciler             = templatereconciler.HelmReconciler
-	NewComponentReconcilerFunc = func(helmReconciler *HelmReconciler) ComponentReconciler
+	NewComponentReconcilerFunc = func(helmReconciler *HelmReconciler, cache *Cache) ComponentReconciler
 )
 
 type MinimalComponent interface {
 	Name() string
+	// Dependencies returns the names of the components which must be reconciled before this one
+	Dependencies() []string
 	Enabled(runtime.Object) bool
 	ReleaseData(runtime.Object) (*templatereconciler.ReleaseData, error)
 }
 
 type ComponentReconciler interface {
 	templatereconciler.Component
+	Dependencies() []string
 	Reconcile(object runtime.Object) (reconcile.Result, error)
 	GetManifest(object runtime.Object) ([]byte, error)
 	GetHelmReconciler() *HelmReconciler
@@ -53,7 +56,10 @@ type ComponentReconciler interface {
 
 type Reconciler interface {
 	GetClient() client.Client
+	// GetResourceClient returns the client the resources of the components are applied with
+	GetResourceClient() client.Client
 	GetScheme() *runtime.Scheme
+	GetComponentCache() *Cache
 }
 
 type ObjectWithStatus interface {
@@ -64,9 +70,74 @@ type ObjectWithStatus interface {
 type Base struct {
 	HelmReconciler *HelmReconciler
 	Component      MinimalComponent
+	// Cache is used to skip rendering and applying the component when its values did not change
+	Cache *Cache
+
+	releaseData *templatereconciler.ReleaseData
 }
 
 func (rec *Base) Reconcile(object runtime.Object) (reconcile.Result, error) {
+	owner, ok := object.(client.Object)
+	if rec.Cache == nil || !ok {
+		return rec.reconcile(object)
+	}
+
+	key := renderKey{
+		owner:     owner.GetUID(),
+		component: rec.Name(),
+	}
+
+	if !owner.GetDeletionTimestamp().IsZero() || rec.Skipped(object) || !rec.Enabled(object) {
+		rec.Cache.forget(key)
+
+		return rec.reconcile(object)
+	}
+
+	releaseData, err := rec.Component.ReleaseData(object)
+	if err != nil {
+		return reconcile.Result{}, errors.WrapIf(err, "failed to get release data")
+	}
+
+	hash, err := ValuesHash(owner, releaseData)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if cached, ok := rec.Cache.get(key); ok && cached.hash == hash && rec.PreChecks(object) == nil {
+		synced, err := inSync(context.Background(), rec.GetHelmReconciler().GetClient(), cached.objects)
+		if err == nil && synced {
+			return reconcile.Result{}, rec.UpdateStatus(object, types.ReconcileStatusAvailable, "")
+		}
+	}
+
+	rec.releaseData = releaseData
+	defer func() {
+		rec.releaseData = nil
+	}()
+
+	result, err := rec.reconcile(object)
+	if err != nil || !result.IsZero() {
+		rec.Cache.forget(key)
+
+		return result, err
+	}
+
+	objects, err := rec.desiredObjects(owner, releaseData)
+	if err != nil {
+		rec.Cache.forget(key)
+
+		return result, nil
+	}
+
+	rec.Cache.store(key, render{
+		hash:    hash,
+		objects: objects,
+	})
+
+	return result, nil
+}
+
+func (rec *Base) reconcile(object runtime.Object) (reconcile.Result, error) {
 	result, err := rec.GetHelmReconciler().Reconcile(object, rec)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -75,7 +146,41 @@ func (rec *Base) Reconcile(object runtime.Object) (reconcile.Result, error) {
 	return *result, nil
 }
 
+func (rec *Base) desiredObjects(owner client.Object, releaseData *templatereconciler.ReleaseData) ([]desiredObject, error) {
+	parent, ok := owner.(reconciler.ResourceOwner)
+	if !ok {
+		return nil, errors.New("cannot convert object to ResourceOwner interface")
+	}
+
+	rbs, err := rec.GetHelmReconciler().GetResourceBuilders(parent, rec, releaseData, false)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]desiredObject, 0, len(rbs))
+	for _, rb := range rbs {
+		o, state, err := rb()
+		if err != nil {
+			return nil, err
+		}
+		if o == nil || state == nil {
+			continue
+		}
+
+		objects = append(objects, desiredObject{
+			object: o,
+			state:  state,
+		})
+	}
+
+	return objects, nil
+}
+
 func (rec *Base) ReleaseData(object runtime.Object) (*templatereconciler.ReleaseData, error) {
+	if rec.releaseData != nil {
+		return rec.releaseData, nil
+	}
+
 	return rec.Component.ReleaseData(object)
 }
 
@@ -83,6 +188,10 @@ func (rec *Base) Name() string {
 	return rec.Component.Name()
 }
 
+func (rec *Base) Dependencies() []string {
+	return rec.Component.Dependencies()
+}
+
 func (rec *Base) GetHelmReconciler() *HelmReconciler {
 	return rec.HelmReconciler
 }
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"sync"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ReconcileAll reconciles the components concurrently while respecting their declared dependencies. A component
+// is started only after its dependencies have been reconciled successfully, so the components depending on a failed
+// one are skipped while the unaffected ones keep going. When the object is being deleted the order is reversed and a
+// component is removed only after every component depending on it is gone. The errors of all the components are
+// aggregated and the requeue results are merged.
+func ReconcileAll(object runtime.Object, reconcilers []ComponentReconciler) (reconcile.Result, error) {
+	dependencies, err := dependencyGraph(reconcilers, isBeingDeleted(object))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	type outcome struct {
+		result reconcile.Result
+		err    error
+	}
+
+	outcomes := make([]outcome, len(reconcilers))
+	done := make([]chan struct{}, len(reconcilers))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for i, rec := range reconcilers {
+		wg.Add(1)
+		go func(i int, rec ComponentReconciler) {
+			defer wg.Done()
+			defer close(done[i])
+
+			for _, dep := range dependencies[i] {
+				<-done[dep]
+				if outcomes[dep].err != nil {
+					outcomes[i].err = errors.NewWithDetails("component skipped as its dependency failed", "component", rec.Name(), "dependency", reconcilers[dep].Name())
+
+					return
+				}
+			}
+
+			result, err := rec.Reconcile(object.DeepCopyObject())
+			outcomes[i] = outcome{
+				result: result,
+				err:    errors.WrapIfWithDetails(err, "could not reconcile component", "component", rec.Name()),
+			}
+		}(i, rec)
+	}
+	wg.Wait()
+
+	var result reconcile.Result
+	var errs []error
+	for _, o := range outcomes {
+		result = mergeResults(result, o.result)
+		if o.err != nil {
+			errs = append(errs, o.err)
+		}
+	}
+
+	return result, errors.Combine(errs...)
+}
+
+// dependencyGraph returns the indexes of the components each component has to wait for
+func dependencyGraph(reconcilers []ComponentReconciler, reverse bool) ([][]int, error) {
+	indexes := make(map[string]int, len(reconcilers))
+	for i, rec := range reconcilers {
+		if _, ok := indexes[rec.Name()]; ok {
+			return nil, errors.NewWithDetails("duplicate component", "component", rec.Name())
+		}
+		indexes[rec.Name()] = i
+	}
+
+	graph := make([][]int, len(reconcilers))
+	for i, rec := range reconcilers {
+		for _, name := range rec.Dependencies() {
+			dep, ok := indexes[name]
+			if !ok {
+				return nil, errors.NewWithDetails("unknown component dependency", "component", rec.Name(), "dependency", name)
+			}
+			if reverse {
+				graph[dep] = append(graph[dep], i)
+			} else {
+				graph[i] = append(graph[i], dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(reconcilers))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch states[i] {
+		case visiting:
+			return errors.NewWithDetails("component dependency cycle", "component", reconcilers[i].Name())
+		case visited:
+			return nil
+		}
+
+		states[i] = visiting
+		for _, dep := range graph[i] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		states[i] = visited
+
+		return nil
+	}
+	for i := range reconcilers {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return graph, nil
+}
+
+func mergeResults(a, b reconcile.Result) reconcile.Result {
+	result := reconcile.Result{
+		Requeue:      a.Requeue || b.Requeue,
+		RequeueAfter: a.RequeueAfter,
+	}
+	if b.RequeueAfter > 0 && (result.RequeueAfter == 0 || b.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = b.RequeueAfter
+	}
+
+	return result
+}
+
+func isBeingDeleted(object runtime.Object) bool {
+	if obj, ok := object.(client.Object); ok {
+		return !obj.GetDeletionTimestamp().IsZero()
+	}
+
+	return false
+}
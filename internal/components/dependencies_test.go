@@ -0,0 +1,164 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+)
+
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.order = append(r.order, name)
+}
+
+func (r *recorder) index(name string) int {
+	for i, n := range r.order {
+		if n == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+type fakeComponent struct {
+	components.ComponentReconciler
+
+	name         string
+	dependencies []string
+	result       reconcile.Result
+	err          error
+	recorder     *recorder
+}
+
+func (c *fakeComponent) Name() string {
+	return c.name
+}
+
+func (c *fakeComponent) Dependencies() []string {
+	return c.dependencies
+}
+
+func (c *fakeComponent) Reconcile(object runtime.Object) (reconcile.Result, error) {
+	c.recorder.record(c.name)
+
+	return c.result, c.err
+}
+
+func TestReconcileAllOrder(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	result, err := components.ReconcileAll(&corev1.ConfigMap{}, []components.ComponentReconciler{
+		&fakeComponent{name: "injector", dependencies: []string{"discovery"}, recorder: rec, result: reconcile.Result{RequeueAfter: time.Minute}},
+		&fakeComponent{name: "discovery", recorder: rec, result: reconcile.Result{RequeueAfter: time.Second}},
+		&fakeComponent{name: "cni", recorder: rec},
+	})
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(rec.order), 3, "reconciled components: %v", rec.order)
+	assert.Assert(t, rec.index("discovery") < rec.index("injector"), "dependency reconciled after its dependent: %v", rec.order)
+	assert.Equal(t, result.RequeueAfter, time.Second)
+}
+
+func TestReconcileAllReverseOrderOnDeletion(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	_, err := components.ReconcileAll(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		},
+	}, []components.ComponentReconciler{
+		&fakeComponent{name: "discovery", recorder: rec},
+		&fakeComponent{name: "injector", dependencies: []string{"discovery"}, recorder: rec},
+	})
+	assert.NilError(t, err)
+
+	assert.Assert(t, rec.index("injector") < rec.index("discovery"), "dependency removed before its dependent: %v", rec.order)
+}
+
+func TestReconcileAllFailure(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	_, err := components.ReconcileAll(&corev1.ConfigMap{}, []components.ComponentReconciler{
+		&fakeComponent{name: "discovery", recorder: rec, err: errors.New("discovery failed")},
+		&fakeComponent{name: "injector", dependencies: []string{"discovery"}, recorder: rec},
+		&fakeComponent{name: "cni", err: errors.New("cni failed"), recorder: rec},
+		&fakeComponent{name: "resourcesyncrule", recorder: rec},
+	})
+	assert.Assert(t, err != nil)
+
+	assert.Equal(t, len(errors.GetErrors(err)), 3, "aggregated errors: %s", err)
+	assert.Equal(t, rec.index("injector"), -1, "component with failed dependency was reconciled: %v", rec.order)
+	assert.Assert(t, rec.index("resourcesyncrule") != -1, "unaffected component was not reconciled: %v", rec.order)
+}
+
+func TestReconcileAllInvalidDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		reconcilers []components.ComponentReconciler
+		err         string
+	}{
+		{
+			name: "unknown",
+			reconcilers: []components.ComponentReconciler{
+				&fakeComponent{name: "injector", dependencies: []string{"discovery"}, recorder: &recorder{}},
+			},
+			err: "unknown component dependency",
+		},
+		{
+			name: "cycle",
+			reconcilers: []components.ComponentReconciler{
+				&fakeComponent{name: "a", dependencies: []string{"b"}, recorder: &recorder{}},
+				&fakeComponent{name: "b", dependencies: []string{"a"}, recorder: &recorder{}},
+			},
+			err: "component dependency cycle",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := components.ReconcileAll(&corev1.ConfigMap{}, tc.reconcilers)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
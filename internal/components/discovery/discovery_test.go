@@ -70,6 +70,7 @@ func TestICPDiscoveryResourceDump(t *testing.T) {
 		templatereconciler.NewHelmReconciler(nil, nil, testlogr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 		v1alpha1.IstioControlPlaneProperties{
 			Mesh: &v1alpha1.IstioMesh{
 				Spec: &v1alpha1.IstioMeshSpec{
@@ -202,6 +203,7 @@ func TestPassiveICPDiscoveryResourceDump(t *testing.T) {
 		templatereconciler.NewHelmReconciler(nil, nil, testlogr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 		v1alpha1.IstioControlPlaneProperties{
 			Mesh: &v1alpha1.IstioMesh{
 				Spec: &v1alpha1.IstioMeshSpec{
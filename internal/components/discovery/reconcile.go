@@ -39,7 +39,7 @@ import (
 )
 
 const (
-	componentName = "istio-discovery"
+	ComponentName = "istio-discovery"
 	chartName     = "istio-discovery"
 	releaseName   = "istio-operator-discovery"
 
@@ -53,9 +53,10 @@ type Component struct {
 	logger     logger.Logger
 }
 
-func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, properties v1alpha1.IstioControlPlaneProperties, logger logger.Logger) components.ComponentReconciler {
+func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache, properties v1alpha1.IstioControlPlaneProperties, logger logger.Logger) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component: &Component{
 			properties: properties,
 			logger:     logger,
@@ -64,7 +65,11 @@ func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, prope
 }
 
 func (rec *Component) Name() string {
-	return componentName
+	return ComponentName
+}
+
+func (rec *Component) Dependencies() []string {
+	return nil
 }
 
 func (rec *Component) Enabled(object runtime.Object) bool {
@@ -169,7 +174,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 				},
 			},
 		},
-		Layers: overlays,
+		Modifiers: overlays,
 	}, nil
 }
 
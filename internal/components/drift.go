@@ -0,0 +1,516 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+// DriftDetector tracks the changes made by others to the resources updated through its clients. The changes are
+// the fields of the resources which differ from their last applied configuration. They are handled according to
+// the drift policies of the owner of the resources and recorded until the reconciliation of the owner ends.
+type DriftDetector struct {
+	mu       sync.Mutex
+	sessions map[string]*driftSession
+}
+
+type driftSession struct {
+	policies []driftPolicy
+	drifts   map[string]*v1alpha1.ResourceDrift
+}
+
+type driftPolicy struct {
+	*v1alpha1.DriftPolicy
+	selector labels.Selector
+}
+
+func NewDriftDetector() *DriftDetector {
+	return &DriftDetector{
+		sessions: make(map[string]*driftSession),
+	}
+}
+
+// Begin starts tracking the drifts of the resources of the owner, handling them by the given policies
+func (d *DriftDetector) Begin(owner client.Object, policies []*v1alpha1.DriftPolicy) error {
+	session := &driftSession{
+		drifts: make(map[string]*v1alpha1.ResourceDrift),
+	}
+
+	for _, policy := range policies {
+		selector, err := labels.Parse(policy.GetLabelSelector())
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "invalid label selector in drift policy", "selector", policy.GetLabelSelector())
+		}
+
+		session.policies = append(session.policies, driftPolicy{
+			DriftPolicy: policy,
+			selector:    selector,
+		})
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sessions[client.ObjectKeyFromObject(owner).String()] = session
+
+	return nil
+}
+
+// End stops tracking the drifts of the resources of the owner and returns the ones detected since Begin
+func (d *DriftDetector) End(owner client.Object) []*v1alpha1.ResourceDrift {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := client.ObjectKeyFromObject(owner).String()
+	session, ok := d.sessions[key]
+	if !ok {
+		return nil
+	}
+	delete(d.sessions, key)
+
+	keys := make([]string, 0, len(session.drifts))
+	for k := range session.drifts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	drifts := make([]*v1alpha1.ResourceDrift, 0, len(keys))
+	for _, k := range keys {
+		drifts = append(drifts, session.drifts[k])
+	}
+
+	return drifts
+}
+
+// Client returns a client which checks the updated resources for drift before passing the updates to c
+func (d *DriftDetector) Client(c client.Client) client.Client {
+	return &driftClient{
+		Client:   c,
+		detector: d,
+	}
+}
+
+func (d *DriftDetector) session(obj client.Object) *driftSession {
+	key := obj.GetAnnotations()[types.BanzaiCloudRelatedTo]
+	if key == "" {
+		if ref := metav1.GetControllerOf(obj); ref != nil {
+			key = client.ObjectKey{Namespace: obj.GetNamespace(), Name: ref.Name}.String()
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.sessions[key]
+}
+
+func (d *DriftDetector) record(session *driftSession, drift *v1alpha1.ResourceDrift) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	session.drifts[fmt.Sprintf("%s %s %s/%s", drift.ApiVersion, drift.Kind, drift.Namespace, drift.Name)] = drift
+}
+
+func (s *driftSession) action(obj client.Object) *v1alpha1.DriftPolicy {
+	for _, policy := range s.policies {
+		if util.ObjectMatches(policy.GetGroupVersionKind(), policy.GetObjectKey(), policy.selector, obj) {
+			return policy.DriftPolicy
+		}
+	}
+
+	return nil
+}
+
+type driftClient struct {
+	client.Client
+
+	detector *DriftDetector
+}
+
+func (c *driftClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	session := c.detector.session(obj)
+	if session == nil {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+
+	skip, err := c.handleDrift(ctx, session, obj)
+	if err != nil {
+		return err
+	}
+	if skip {
+		return nil
+	}
+
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// handleDrift records the fields of the current resource which were changed since it was last applied and keeps
+// them in the desired resource when the policy says so. It returns whether the update became unnecessary.
+func (c *driftClient) handleDrift(ctx context.Context, session *driftSession, desired client.Object) (bool, error) {
+	current, err := newObjectFor(c.Scheme(), desired)
+	if err != nil {
+		return false, err
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	original, err := patch.DefaultAnnotator.GetOriginalConfiguration(current)
+	if err != nil || original == nil {
+		return false, err
+	}
+
+	var originalMap, currentMap, desiredMap map[string]interface{}
+	if err := json.Unmarshal(original, &originalMap); err != nil {
+		return false, errors.WrapIf(err, "could not decode last applied configuration")
+	}
+	if err := convertToMap(current, &currentMap); err != nil {
+		return false, err
+	}
+	if err := convertToMap(desired, &desiredMap); err != nil {
+		return false, err
+	}
+	delete(originalMap, "status")
+
+	paths := changedPaths(originalMap, currentMap, nil)
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	policy := session.action(desired)
+
+	gvk := desired.GetObjectKind().GroupVersionKind()
+	drift := &v1alpha1.ResourceDrift{
+		ApiVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  desired.GetNamespace(),
+		Name:       desired.GetName(),
+		Managers:   fieldManagers(current.GetManagedFields(), paths),
+		Action:     policy.GetAction(),
+	}
+	if drift.Action == v1alpha1.DriftPolicy_unspecified {
+		drift.Action = v1alpha1.DriftPolicy_revert
+	}
+	for _, p := range paths {
+		drift.Fields = append(drift.Fields, p.String())
+	}
+	c.detector.record(session, drift)
+
+	if drift.Action == v1alpha1.DriftPolicy_revert {
+		return false, nil
+	}
+
+	for _, p := range paths {
+		if drift.Action == v1alpha1.DriftPolicy_ignoreFields && !p.coveredBy(policy.GetFields()) {
+			continue
+		}
+
+		// intended changes of the field are still applied
+		originalValue, _ := p.get(originalMap)
+		desiredValue, _ := p.get(desiredMap)
+		if !reflect.DeepEqual(originalValue, desiredValue) {
+			continue
+		}
+
+		if currentValue, ok := p.get(currentMap); ok {
+			p.set(desiredMap, currentValue)
+		} else {
+			p.remove(desiredMap)
+		}
+	}
+
+	if !hasChanges(desiredMap, currentMap) {
+		return true, nil
+	}
+
+	return false, convertFromMap(desiredMap, desired)
+}
+
+// hasChanges checks whether applying the desired resource would change the current one
+func hasChanges(desired, current map[string]interface{}) bool {
+	desired = copyMap(desired)
+	delete(desired, "status")
+	if metadata, ok := desired["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"resourceVersion", "managedFields", "creationTimestamp"} {
+			delete(metadata, field)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, patch.LastAppliedConfig)
+		}
+	}
+
+	return len(changedPaths(desired, current, nil)) > 0
+}
+
+// convertFromMap replaces the content of obj with the given map
+func convertFromMap(m map[string]interface{}, obj client.Object) error {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		u.Object = m
+
+		return nil
+	}
+
+	j, err := json.Marshal(m)
+	if err != nil {
+		return errors.WrapIf(err, "could not encode resource")
+	}
+
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+
+	return errors.WrapIf(json.Unmarshal(j, obj), "could not decode resource")
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	j, _ := json.Marshal(m)
+	c := make(map[string]interface{})
+	_ = json.Unmarshal(j, &c)
+
+	return c
+}
+
+func convertToMap(obj interface{}, m *map[string]interface{}) error {
+	j, err := json.Marshal(obj)
+	if err != nil {
+		return errors.WrapIf(err, "could not encode resource")
+	}
+
+	return errors.WrapIf(json.Unmarshal(j, m), "could not decode resource")
+}
+
+// fieldPath is a path of a field, made of map keys (strings) and list indexes (ints)
+type fieldPath []interface{}
+
+func (p fieldPath) String() string {
+	var b strings.Builder
+	for _, segment := range p {
+		switch s := segment.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", s)
+		case string:
+			if strings.ContainsAny(s, ".[]") {
+				fmt.Fprintf(&b, "[%s]", s)
+			} else {
+				if b.Len() > 0 {
+					b.WriteByte('.')
+				}
+				b.WriteString(s)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func (p fieldPath) coveredBy(paths []string) bool {
+	s := p.String()
+	for _, path := range paths {
+		if s == path || strings.HasPrefix(s, path+".") || strings.HasPrefix(s, path+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p fieldPath) get(value interface{}) (interface{}, bool) {
+	for _, segment := range p {
+		switch s := segment.(type) {
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if value, ok = m[s]; !ok {
+				return nil, false
+			}
+		case int:
+			l, ok := value.([]interface{})
+			if !ok || s >= len(l) {
+				return nil, false
+			}
+			value = l[s]
+		}
+	}
+
+	return value, true
+}
+
+func (p fieldPath) set(root map[string]interface{}, value interface{}) {
+	if len(p) == 0 {
+		return
+	}
+
+	parent, ok := p[:len(p)-1].get(root)
+	if !ok {
+		return
+	}
+
+	switch s := p[len(p)-1].(type) {
+	case string:
+		if m, ok := parent.(map[string]interface{}); ok {
+			m[s] = value
+		}
+	case int:
+		if l, ok := parent.([]interface{}); ok && s < len(l) {
+			l[s] = value
+		}
+	}
+}
+
+func (p fieldPath) remove(root map[string]interface{}) {
+	if len(p) == 0 {
+		return
+	}
+
+	parent, ok := p[:len(p)-1].get(root)
+	if !ok {
+		return
+	}
+
+	if m, ok := parent.(map[string]interface{}); ok {
+		if s, ok := p[len(p)-1].(string); ok {
+			delete(m, s)
+		}
+	}
+}
+
+// changedPaths returns the paths of the fields set in the original value which are missing from or differ in the
+// current one. Fields which are only present in the current value, e.g. the ones defaulted by the API server, are
+// not considered changes.
+func changedPaths(original, current interface{}, path fieldPath) []fieldPath {
+	switch o := original.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return []fieldPath{path}
+		}
+
+		keys := make([]string, 0, len(o))
+		for k := range o {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var paths []fieldPath
+		for _, k := range keys {
+			p := append(append(fieldPath{}, path...), k)
+			if _, ok := c[k]; !ok {
+				paths = append(paths, p)
+
+				continue
+			}
+			paths = append(paths, changedPaths(o[k], c[k], p)...)
+		}
+
+		return paths
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || len(c) != len(o) {
+			return []fieldPath{path}
+		}
+
+		var paths []fieldPath
+		for i := range o {
+			paths = append(paths, changedPaths(o[i], c[i], append(append(fieldPath{}, path...), i))...)
+		}
+
+		return paths
+	default:
+		if !reflect.DeepEqual(original, current) {
+			return []fieldPath{path}
+		}
+
+		return nil
+	}
+}
+
+// fieldManagers returns the managers owning any of the paths according to the managed fields
+func fieldManagers(managedFields []metav1.ManagedFieldsEntry, paths []fieldPath) []string {
+	managers := map[string]bool{}
+	for _, entry := range managedFields {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+
+		for _, p := range paths {
+			if ownsPath(fields, p) {
+				managers[entry.Manager] = true
+
+				break
+			}
+		}
+	}
+
+	result := make([]string, 0, len(managers))
+	for manager := range managers {
+		result = append(result, manager)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// ownsPath checks whether the managed fields set contains the path. List items are identified by their keys or
+// values in the set, so every item of a list is considered a match for an index.
+func ownsPath(fields map[string]interface{}, p fieldPath) bool {
+	// an empty set means the field is owned as a whole
+	if len(p) == 0 || len(fields) == 0 {
+		return true
+	}
+
+	switch s := p[0].(type) {
+	case string:
+		child, ok := fields["f:"+s].(map[string]interface{})
+
+		return ok && ownsPath(child, p[1:])
+	case int:
+		for k, v := range fields {
+			if !strings.HasPrefix(k, "k:") && !strings.HasPrefix(k, "i:") && !strings.HasPrefix(k, "v:") {
+				continue
+			}
+			if child, ok := v.(map[string]interface{}); ok && ownsPath(child, p[1:]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
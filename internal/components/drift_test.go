@@ -0,0 +1,204 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+func TestDriftDetector(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "icp",
+			Namespace: "istio-system",
+		},
+	}
+
+	applied := func(data map[string]string) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "istio-system",
+				Annotations: map[string]string{
+					types.BanzaiCloudRelatedTo: "istio-system/icp",
+				},
+			},
+			Data: data,
+		}
+		assert.NilError(t, patch.DefaultAnnotator.SetLastAppliedAnnotation(cm))
+
+		return cm
+	}
+
+	edited := func() *corev1.ConfigMap {
+		cm := applied(map[string]string{"mesh": "enabled", "mode": "strict"})
+		cm.Data = map[string]string{"mesh": "disabled", "mode": "permissive"}
+		cm.ManagedFields = []metav1.ManagedFieldsEntry{
+			{
+				Manager:   "kubectl-edit",
+				Operation: metav1.ManagedFieldsOperationUpdate,
+				FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:mesh":{},"f:mode":{}}}`)},
+			},
+			{
+				Manager:   "istio-operator",
+				Operation: metav1.ManagedFieldsOperationUpdate,
+				FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{}}}`)},
+			},
+		}
+
+		return cm
+	}
+
+	testCases := []struct {
+		name     string
+		policies []*v1alpha1.DriftPolicy
+		desired  map[string]string
+		expected map[string]string
+		action   v1alpha1.DriftPolicy_Action
+	}{
+		{
+			name:     "changes are reverted by default",
+			desired:  map[string]string{"mesh": "enabled", "mode": "strict"},
+			expected: map[string]string{"mesh": "enabled", "mode": "strict"},
+			action:   v1alpha1.DriftPolicy_revert,
+		},
+		{
+			name: "changes are kept when only reported",
+			policies: []*v1alpha1.DriftPolicy{
+				{
+					GroupVersionKind: &v1alpha1.K8SResourceOverlayPatch_GroupVersionKind{Kind: "Config*"},
+					Action:           v1alpha1.DriftPolicy_report,
+				},
+			},
+			desired:  map[string]string{"mesh": "enabled", "mode": "strict"},
+			expected: map[string]string{"mesh": "disabled", "mode": "permissive"},
+			action:   v1alpha1.DriftPolicy_report,
+		},
+		{
+			name: "intended changes are applied when changes are only reported",
+			policies: []*v1alpha1.DriftPolicy{
+				{
+					Action: v1alpha1.DriftPolicy_report,
+				},
+			},
+			desired:  map[string]string{"mesh": "enabled", "mode": "mutual"},
+			expected: map[string]string{"mesh": "disabled", "mode": "mutual"},
+			action:   v1alpha1.DriftPolicy_report,
+		},
+		{
+			name: "changes of ignored fields are kept",
+			policies: []*v1alpha1.DriftPolicy{
+				{
+					ObjectKey: &v1alpha1.NamespacedName{Name: "other"},
+					Action:    v1alpha1.DriftPolicy_report,
+				},
+				{
+					ObjectKey: &v1alpha1.NamespacedName{Name: "ist*"},
+					Action:    v1alpha1.DriftPolicy_ignoreFields,
+					Fields:    []string{"data.mode"},
+				},
+			},
+			desired:  map[string]string{"mesh": "enabled", "mode": "strict"},
+			expected: map[string]string{"mesh": "enabled", "mode": "permissive"},
+			action:   v1alpha1.DriftPolicy_ignoreFields,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(edited()).Build()
+			detector := NewDriftDetector()
+			assert.NilError(t, detector.Begin(owner, tc.policies))
+
+			current := &corev1.ConfigMap{}
+			assert.NilError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "istio-system", Name: "istio"}, current))
+
+			desired := applied(tc.desired)
+			desired.ResourceVersion = current.ResourceVersion
+			assert.NilError(t, detector.Client(c).Update(context.Background(), desired))
+
+			assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(current), current))
+			assert.DeepEqual(t, current.Data, tc.expected)
+
+			drifts := detector.End(owner)
+			assert.Equal(t, len(drifts), 1, "drifts: %v", drifts)
+			assert.Equal(t, drifts[0].GetAction(), tc.action)
+			assert.DeepEqual(t, drifts[0].GetFields(), []string{"data.mesh", "data.mode"})
+			assert.DeepEqual(t, drifts[0].GetManagers(), []string{"kubectl-edit"})
+		})
+	}
+}
+
+func TestDriftDetectorWithoutDrift(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "icp",
+			Namespace: "istio-system",
+		},
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "istio",
+			Namespace: "istio-system",
+			Annotations: map[string]string{
+				types.BanzaiCloudRelatedTo: "istio-system/icp",
+			},
+		},
+		Data: map[string]string{"mesh": "enabled"},
+	}
+	assert.NilError(t, patch.DefaultAnnotator.SetLastAppliedAnnotation(cm))
+
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(cm.DeepCopy()).Build()
+	detector := NewDriftDetector()
+	assert.NilError(t, detector.Begin(owner, []*v1alpha1.DriftPolicy{{Action: v1alpha1.DriftPolicy_report}}))
+
+	current := &corev1.ConfigMap{}
+	assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cm), current))
+
+	desired := cm.DeepCopy()
+	desired.ResourceVersion = current.ResourceVersion
+	desired.Data["mesh"] = "disabled"
+	assert.NilError(t, detector.Client(c).Update(context.Background(), desired))
+
+	assert.NilError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cm), current))
+	assert.Equal(t, current.Data["mesh"], "disabled", "intended change was not applied")
+	assert.Equal(t, len(detector.End(owner)), 0)
+}
@@ -67,6 +67,7 @@ func TestIMGWResourceDump(t *testing.T) {
 		templatereconciler.NewHelmReconciler(nil, nil, testlogr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 		v1alpha1.IstioMeshGatewayProperties{
 			Revision:                "cp-v117x.istio-system",
 			EnablePrometheusMerge:   utils.BoolPointer(true),
@@ -20,8 +20,10 @@ import (
 	"net/http"
 
 	"emperror.dev/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	"github.com/banzaicloud/istio-operator/v2/internal/assets"
@@ -50,9 +52,10 @@ type Component struct {
 	logger     logger.Logger
 }
 
-func NewChartReconciler(helmReconciler *components.HelmReconciler, properties v1alpha1.IstioMeshGatewayProperties, logger logger.Logger) components.ComponentReconciler {
+func NewChartReconciler(helmReconciler *components.HelmReconciler, cache *components.Cache, properties v1alpha1.IstioMeshGatewayProperties, logger logger.Logger) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component: &Component{
 			properties: properties,
 			logger:     logger,
@@ -64,6 +67,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) Dependencies() []string {
+	return nil
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if imgw, ok := object.(*v1alpha1.IstioMeshGateway); ok {
 		return imgw.DeletionTimestamp.IsZero()
@@ -84,35 +91,50 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 			return nil, errors.WrapIf(err, "could not convert k8s resource overlays")
 		}
 
-		return &templatereconciler.ReleaseData{
-			Chart:       http.FS(assets.IstioMeshGateway),
-			Values:      values,
-			Namespace:   imgw.Namespace,
-			ChartName:   chartName,
-			ReleaseName: releaseName,
-			Layers:      overlays,
-			DesiredStateOverrides: map[reconciler.ObjectKeyWithGVK]reconciler.DesiredState{
-				{
-					GVK: policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"),
-				}: reconciler.DynamicDesiredState{
-					ShouldUpdateFunc: func(current, desired runtime.Object) (bool, error) {
-						options := []patch.CalculateOption{
-							patch.IgnoreStatusFields(),
-							reconciler.IgnoreManagedFields(),
-							patch.IgnorePDBSelector(),
-						}
-
-						patchResult, err := pkgUtil.NewProtoCompatiblePatchMaker().Calculate(current, desired, options...)
-						if err != nil {
-							rec.logger.Error(err, "could not calculate patch result")
-
-							return false, err
-						}
-
-						return !patchResult.IsEmpty(), nil
-					},
+		desiredStateOverrides := map[reconciler.ObjectKeyWithGVK]reconciler.DesiredState{
+			{
+				GVK: policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"),
+			}: reconciler.DynamicDesiredState{
+				ShouldUpdateFunc: func(current, desired runtime.Object) (bool, error) {
+					options := []patch.CalculateOption{
+						patch.IgnoreStatusFields(),
+						reconciler.IgnoreManagedFields(),
+						patch.IgnorePDBSelector(),
+					}
+
+					patchResult, err := pkgUtil.NewProtoCompatiblePatchMaker().Calculate(current, desired, options...)
+					if err != nil {
+						rec.logger.Error(err, "could not calculate patch result")
+
+						return false, err
+					}
+
+					return !patchResult.IsEmpty(), nil
 				},
 			},
+		}
+
+		// deployments of frozen colors must not be touched during a blue/green rollout
+		if rec.properties.BlueGreen != nil {
+			for _, color := range rec.properties.BlueGreen.FrozenColors {
+				desiredStateOverrides[reconciler.ObjectKeyWithGVK{
+					GVK: appsv1.SchemeGroupVersion.WithKind("Deployment"),
+					ObjectKey: client.ObjectKey{
+						Name:      v1alpha1.GetGatewayDeploymentName(imgw.GetName(), color),
+						Namespace: imgw.GetNamespace(),
+					},
+				}] = reconciler.StateCreated
+			}
+		}
+
+		return &templatereconciler.ReleaseData{
+			Chart:                 http.FS(assets.IstioMeshGateway),
+			Values:                values,
+			Namespace:             imgw.Namespace,
+			ChartName:             chartName,
+			ReleaseName:           releaseName,
+			Modifiers:             overlays,
+			DesiredStateOverrides: desiredStateOverrides,
 		}, nil
 	}
 
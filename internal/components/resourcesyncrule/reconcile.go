@@ -44,9 +44,10 @@ type Component struct {
 	resourceSyncRulesEnabled bool
 }
 
-func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, resourceSyncRulesEnabled bool) components.ComponentReconciler {
+func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache, resourceSyncRulesEnabled bool) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component: &Component{
 			resourceSyncRulesEnabled: resourceSyncRulesEnabled,
 		},
@@ -57,6 +58,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) Dependencies() []string {
+	return nil
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero() && rec.resourceSyncRulesEnabled
@@ -87,7 +92,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
 		ReleaseName: releaseName,
-		Layers:      overlays,
+		Modifiers:   overlays,
 	}, nil
 }
 
@@ -73,6 +73,7 @@ func testResourceSyncRuleResourceDump(t *testing.T, icpTestCR, rsrExpectedResour
 		templatereconciler.NewHelmReconciler(nil, nil, testlogr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 		true,
 	)
 
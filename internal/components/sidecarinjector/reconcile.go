@@ -25,6 +25,7 @@ import (
 	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	assets "github.com/banzaicloud/istio-operator/v2/internal/assets"
 	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/discovery"
 	"github.com/banzaicloud/istio-operator/v2/internal/util"
 	"github.com/banzaicloud/operator-tools/pkg/helm"
 	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
@@ -42,9 +43,10 @@ var _ components.MinimalComponent = &Component{}
 
 type Component struct{}
 
-func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler) components.ComponentReconciler {
+func NewChartReconciler(helmReconciler *templatereconciler.HelmReconciler, cache *components.Cache) components.ComponentReconciler {
 	return &components.Base{
 		HelmReconciler: helmReconciler,
+		Cache:          cache,
 		Component:      &Component{},
 	}
 }
@@ -53,6 +55,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) Dependencies() []string {
+	return []string{discovery.ComponentName}
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero() && controlPlane.GetSpec().GetMode() == v1alpha1.ModeType_PASSIVE
@@ -83,7 +89,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
 		ReleaseName: releaseName,
-		Layers:      overlays,
+		Modifiers:   overlays,
 	}, nil
 }
 
@@ -57,6 +57,7 @@ func TestICPSidecarInjectorResourceDump(t *testing.T) {
 		templatereconciler.NewHelmReconciler(nil, nil, logr.NewTestLogger(t), fake.NewSimpleClientset().Discovery(), []reconciler.NativeReconcilerOpt{
 			reconciler.NativeReconcilerSetControllerRef(),
 		}),
+		nil,
 	)
 
 	dd, err := reconciler.GetManifest(icp)
@@ -0,0 +1,257 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	requestTimeout = time.Second * 30
+)
+
+// HTTP01Challenge is an HTTP-01 challenge which has to be answered for a host of an order
+type HTTP01Challenge struct {
+	Host     string
+	Path     string
+	Response string
+}
+
+// Order is the state of a certificate order
+type Order struct {
+	// URL of the order in progress, empty once the certificate is issued
+	URL string
+	// Challenges which need to be answered for the order
+	Challenges []HTTP01Challenge
+	// PEM encoded certificate chain and private key, set once the certificate is issued
+	CertificateChain []byte
+	PrivateKey       []byte
+}
+
+// Client requests certificates from an ACME (RFC 8555) server using HTTP-01 challenges
+type Client struct {
+	client *acme.Client
+}
+
+func NewClient(directoryURL string, accountKey crypto.Signer, insecureSkipTLSVerify bool) *Client {
+	httpClient := &http.Client{
+		Timeout: requestTimeout,
+	}
+	if insecureSkipTLSVerify {
+		httpClient.Transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true, //nolint:gosec
+			},
+		}
+	}
+
+	return &Client{
+		client: &acme.Client{
+			Key:          accountKey,
+			DirectoryURL: directoryURL,
+			HTTPClient:   httpClient,
+			UserAgent:    "istio-operator",
+		},
+	}
+}
+
+// Register registers the account of the client at the ACME server if it is not registered yet
+func (c *Client) Register(ctx context.Context, email string) error {
+	account := &acme.Account{}
+	if email != "" {
+		account.Contact = []string{"mailto:" + email}
+	}
+
+	_, err := c.client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return errors.WrapIf(err, "could not register ACME account")
+	}
+
+	return nil
+}
+
+// ProcessOrder advances the order at orderURL, or creates a new order for the hosts if orderURL is empty.
+// The challenges of the order are only accepted if acceptChallenges is set, which the caller should only do
+// once the challenge responses returned by a previous call are served. The certificate is requested as soon as
+// every challenge is validated.
+func (c *Client) ProcessOrder(ctx context.Context, orderURL string, hosts []string, acceptChallenges bool) (*Order, error) {
+	var order *acme.Order
+	var err error
+
+	if orderURL != "" {
+		order, err = c.client.GetOrder(ctx, orderURL)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not get ACME order", "url", orderURL)
+		}
+		if order.URI == "" {
+			order.URI = orderURL
+		}
+	}
+
+	// the certificate of a valid order can not be used without the private key, so a new order is created
+	if order == nil || order.Status == acme.StatusValid {
+		order, err = c.client.AuthorizeOrder(ctx, acme.DomainIDs(hosts...))
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not create ACME order")
+		}
+	}
+
+	result := &Order{
+		URL: order.URI,
+	}
+
+	switch order.Status {
+	case acme.StatusPending:
+		result.Challenges, err = c.processAuthorizations(ctx, order, acceptChallenges)
+		if err != nil {
+			return nil, err
+		}
+	case acme.StatusReady:
+		result.CertificateChain, result.PrivateKey, err = c.finalize(ctx, order, hosts)
+		if err != nil {
+			return nil, err
+		}
+		result.URL = ""
+	case acme.StatusInvalid:
+		if order.Error != nil {
+			return nil, errors.WrapIfWithDetails(order.Error, "ACME order is invalid", "url", order.URI)
+		}
+
+		return nil, errors.NewWithDetails("ACME order is invalid", "url", order.URI)
+	}
+
+	return result, nil
+}
+
+func (c *Client) processAuthorizations(ctx context.Context, order *acme.Order, acceptChallenges bool) ([]HTTP01Challenge, error) {
+	challenges := make([]HTTP01Challenge, 0)
+
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := c.client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not get ACME authorization", "url", authzURL)
+		}
+
+		if authz.Status != acme.StatusPending {
+			continue
+		}
+
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == "http-01" {
+				challenge = c
+
+				break
+			}
+		}
+		if challenge == nil {
+			return nil, errors.NewWithDetails("no HTTP-01 challenge is offered by the ACME server", "host", authz.Identifier.Value)
+		}
+
+		response, err := c.client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not calculate HTTP-01 challenge response")
+		}
+
+		challenges = append(challenges, HTTP01Challenge{
+			Host:     authz.Identifier.Value,
+			Path:     c.client.HTTP01ChallengePath(challenge.Token),
+			Response: response,
+		})
+
+		if acceptChallenges && challenge.Status == acme.StatusPending {
+			if _, err := c.client.Accept(ctx, challenge); err != nil {
+				return nil, errors.WrapIfWithDetails(err, "could not accept HTTP-01 challenge", "host", authz.Identifier.Value)
+			}
+		}
+	}
+
+	return challenges, nil
+}
+
+func (c *Client) finalize(ctx context.Context, order *acme.Order, hosts []string) ([]byte, []byte, error) {
+	key, keyPEM, err := GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: hosts[0],
+		},
+		DNSNames: hosts,
+	}, key)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not create certificate signing request")
+	}
+
+	chain, _, err := c.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not finalize ACME order")
+	}
+
+	chainPEM := make([]byte, 0)
+	for _, der := range chain {
+		chainPEM = append(chainPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	return chainPEM, keyPEM, nil
+}
+
+// GenerateKey generates a new ECDSA P-256 private key and returns it along with its PEM encoded form
+func GenerateKey() (crypto.Signer, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not generate private key")
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not marshal private key")
+	}
+
+	return key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParseKey parses a PEM encoded ECDSA private key
+func ParseKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("could not decode private key")
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not parse private key")
+	}
+
+	return key, nil
+}
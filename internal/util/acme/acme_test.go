@@ -0,0 +1,285 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/util/acme"
+)
+
+// fakeACMEServer is a minimal pebble-like ACME server which considers every accepted challenge valid
+type fakeACMEServer struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu          sync.Mutex
+	accounts    int
+	hosts       []string
+	validated   map[string]bool
+	certificate []byte
+
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+}
+
+func newFakeACMEServer(t *testing.T) *fakeACMEServer {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake acme ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour * 24),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	assert.NilError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+
+	s := &fakeACMEServer{
+		t:         t,
+		validated: make(map[string]bool),
+		caKey:     caKey,
+		caCert:    caCert,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+func (s *fakeACMEServer) url(path string) string {
+	return s.server.URL + path
+}
+
+func (s *fakeACMEServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Replay-Nonce", "nonce")
+
+	var payload []byte
+	if r.Method == http.MethodPost {
+		var jws struct {
+			Payload string `json:"payload"`
+		}
+		assert.NilError(s.t, json.NewDecoder(r.Body).Decode(&jws))
+		var err error
+		payload, err = base64.RawURLEncoding.DecodeString(jws.Payload)
+		assert.NilError(s.t, err)
+	}
+
+	switch path := r.URL.Path; {
+	case path == "/directory":
+		s.write(w, http.StatusOK, map[string]string{
+			"newNonce":   s.url("/nonce"),
+			"newAccount": s.url("/account"),
+			"newOrder":   s.url("/order"),
+		})
+	case path == "/nonce":
+		w.WriteHeader(http.StatusOK)
+	case path == "/account":
+		status := http.StatusOK
+		if s.accounts == 0 {
+			status = http.StatusCreated
+		}
+		s.accounts++
+		w.Header().Set("Location", s.url("/account/1"))
+		s.write(w, status, map[string]string{"status": "valid"})
+	case path == "/order":
+		var req struct {
+			Identifiers []struct {
+				Value string `json:"value"`
+			} `json:"identifiers"`
+		}
+		assert.NilError(s.t, json.Unmarshal(payload, &req))
+		s.hosts = nil
+		for _, id := range req.Identifiers {
+			s.hosts = append(s.hosts, id.Value)
+		}
+		w.Header().Set("Location", s.url("/order/1"))
+		s.write(w, http.StatusCreated, s.order())
+	case path == "/order/1":
+		s.write(w, http.StatusOK, s.order())
+	case path == "/order/1/finalize":
+		var req struct {
+			CSR string `json:"csr"`
+		}
+		assert.NilError(s.t, json.Unmarshal(payload, &req))
+		der, err := base64.RawURLEncoding.DecodeString(req.CSR)
+		assert.NilError(s.t, err)
+		csr, err := x509.ParseCertificateRequest(der)
+		assert.NilError(s.t, err)
+		s.certificate, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			DNSNames:     csr.DNSNames,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour * 2),
+		}, s.caCert, csr.PublicKey, s.caKey)
+		assert.NilError(s.t, err)
+		w.Header().Set("Location", s.url("/order/1"))
+		s.write(w, http.StatusOK, s.order())
+	case path == "/certificate/1":
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.certificate}))
+		_, _ = w.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw}))
+	case strings.HasPrefix(path, "/authorization/"):
+		s.write(w, http.StatusOK, s.authorization(strings.TrimPrefix(path, "/authorization/")))
+	case strings.HasPrefix(path, "/challenge/"):
+		host := strings.TrimPrefix(path, "/challenge/")
+		s.validated[host] = true
+		s.write(w, http.StatusOK, s.challenge(host))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *fakeACMEServer) write(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NilError(s.t, json.NewEncoder(w).Encode(body))
+}
+
+func (s *fakeACMEServer) order() map[string]interface{} {
+	status := "ready"
+	authorizations := make([]string, 0)
+	for _, host := range s.hosts {
+		authorizations = append(authorizations, s.url("/authorization/"+host))
+		if !s.validated[host] {
+			status = "pending"
+		}
+	}
+
+	order := map[string]interface{}{
+		"status":         status,
+		"authorizations": authorizations,
+		"finalize":       s.url("/order/1/finalize"),
+	}
+	if s.certificate != nil {
+		order["status"] = "valid"
+		order["certificate"] = s.url("/certificate/1")
+	}
+
+	return order
+}
+
+func (s *fakeACMEServer) authorization(host string) map[string]interface{} {
+	status := "pending"
+	if s.validated[host] {
+		status = "valid"
+	}
+
+	return map[string]interface{}{
+		"status":     status,
+		"identifier": map[string]string{"type": "dns", "value": host},
+		"challenges": []interface{}{s.challenge(host)},
+	}
+}
+
+func (s *fakeACMEServer) challenge(host string) map[string]string {
+	status := "pending"
+	if s.validated[host] {
+		status = "valid"
+	}
+
+	return map[string]string{
+		"type":   "http-01",
+		"url":    s.url("/challenge/" + host),
+		"token":  "token-" + host,
+		"status": status,
+	}
+}
+
+func TestProcessOrder(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeACMEServer(t)
+	defer server.server.Close()
+
+	ctx := context.Background()
+	hosts := []string{"bookinfo.example.com", "www.example.com"}
+
+	accountKey, accountKeyPEM, err := acme.GenerateKey()
+	assert.NilError(t, err)
+	parsedAccountKey, err := acme.ParseKey(accountKeyPEM)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, parsedAccountKey.Public(), accountKey.Public())
+
+	client := acme.NewClient(server.url("/directory"), accountKey, false)
+	assert.NilError(t, client.Register(ctx, "admin@example.com"))
+	// registering an existing account is not an error
+	assert.NilError(t, client.Register(ctx, "admin@example.com"))
+
+	order, err := client.ProcessOrder(ctx, "", hosts, false)
+	assert.NilError(t, err)
+	assert.Equal(t, order.URL, server.url("/order/1"))
+	assert.Equal(t, len(order.Challenges), 2)
+	for i, challenge := range order.Challenges {
+		assert.Equal(t, challenge.Host, hosts[i])
+		assert.Equal(t, challenge.Path, "/.well-known/acme-challenge/token-"+hosts[i])
+		assert.Assert(t, strings.HasPrefix(challenge.Response, "token-"+hosts[i]+"."))
+	}
+	assert.Equal(t, len(server.validated), 0)
+
+	order, err = client.ProcessOrder(ctx, order.URL, hosts, true)
+	assert.NilError(t, err)
+	assert.Equal(t, order.URL, server.url("/order/1"))
+	assert.Equal(t, len(server.validated), 2)
+
+	order, err = client.ProcessOrder(ctx, order.URL, hosts, true)
+	assert.NilError(t, err)
+	assert.Equal(t, order.URL, "")
+	assert.Equal(t, len(order.Challenges), 0)
+
+	block, rest := pem.Decode(order.CertificateChain)
+	assert.Assert(t, block != nil)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, certificate.DNSNames, hosts)
+	block, _ = pem.Decode(rest)
+	assert.Assert(t, block != nil)
+	assert.DeepEqual(t, block.Bytes, server.caCert.Raw)
+
+	key, err := acme.ParseKey(order.PrivateKey)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, key.Public(), certificate.PublicKey)
+}
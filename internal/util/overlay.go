@@ -0,0 +1,247 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+
+	"emperror.dev/errors"
+	ypatch "github.com/cppforlife/go-patch/patch"
+	jsonpatch "github.com/evanphx/json-patch"
+	yamlv2 "gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/resources"
+)
+
+// ConvertK8sOverlays converts the overlay patches to object modifiers which patch the matching rendered resources
+func ConvertK8sOverlays(overlays []*v1alpha1.K8SResourceOverlayPatch) ([]resources.ObjectModifierFunc, error) {
+	modifiers := make([]resources.ObjectModifierFunc, 0, len(overlays))
+	for _, overlay := range overlays {
+		modifier, err := overlayModifier(overlay)
+		if err != nil {
+			return nil, err
+		}
+		modifiers = append(modifiers, modifier)
+	}
+
+	return modifiers, nil
+}
+
+type overlayPatchFunc func(doc []byte, object runtime.Object) ([]byte, error)
+
+func overlayModifier(overlay *v1alpha1.K8SResourceOverlayPatch) (resources.ObjectModifierFunc, error) {
+	selector, err := labels.Parse(overlay.GetLabelSelector())
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "invalid label selector", "selector", overlay.GetLabelSelector())
+	}
+
+	patches := make([]overlayPatchFunc, 0, len(overlay.GetPatches()))
+	for _, p := range overlay.GetPatches() {
+		patch, err := overlayPatch(p)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid overlay patch", "type", p.GetType().String(), "path", p.GetPath())
+		}
+		patches = append(patches, patch)
+	}
+
+	return func(o runtime.Object) (runtime.Object, error) {
+		if len(patches) == 0 || !ObjectMatches(overlay.GetGroupVersionKind(), overlay.GetObjectKey(), selector, o) {
+			return o, nil
+		}
+
+		doc, err := json.Marshal(o)
+		if err != nil {
+			return o, errors.WrapIf(err, "could not marshal runtime object")
+		}
+
+		for _, patch := range patches {
+			doc, err = patch(doc, o)
+			if err != nil {
+				return o, err
+			}
+		}
+
+		patched, ok := reflect.New(reflect.TypeOf(o).Elem()).Interface().(runtime.Object)
+		if !ok {
+			return o, errors.NewPlain("could not create runtime object for the patched resource")
+		}
+
+		if err := json.Unmarshal(doc, patched); err != nil {
+			return o, errors.WrapIf(err, "could not unmarshal patched resource")
+		}
+
+		return patched, nil
+	}, nil
+}
+
+// ObjectMatches checks whether the object matches the kind, the object key and the label selector, where the fields
+// of the kind and the object key may contain shell file name patterns and empty fields match any value
+func ObjectMatches(gvk *v1alpha1.K8SResourceOverlayPatch_GroupVersionKind, objectKey *v1alpha1.NamespacedName, selector labels.Selector, o runtime.Object) bool {
+	objectGVK := o.GetObjectKind().GroupVersionKind()
+	if !patternMatches(gvk.GetGroup(), objectGVK.Group) ||
+		!patternMatches(gvk.GetVersion(), objectGVK.Version) ||
+		!patternMatches(gvk.GetKind(), objectGVK.Kind) {
+		return false
+	}
+
+	meta, ok := o.(metav1.Object)
+	if !ok {
+		return false
+	}
+
+	return patternMatches(objectKey.GetName(), meta.GetName()) &&
+		patternMatches(objectKey.GetNamespace(), meta.GetNamespace()) &&
+		selector.Matches(labels.Set(meta.GetLabels()))
+}
+
+func patternMatches(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	ok, err := path.Match(pattern, value)
+
+	return err == nil && ok
+}
+
+func overlayPatch(p *v1alpha1.K8SResourceOverlayPatch_Patch) (overlayPatchFunc, error) {
+	switch p.GetType() {
+	case v1alpha1.K8SResourceOverlayPatch_replace, v1alpha1.K8SResourceOverlayPatch_remove:
+		return goPatch(p)
+	case v1alpha1.K8SResourceOverlayPatch_add, v1alpha1.K8SResourceOverlayPatch_move, v1alpha1.K8SResourceOverlayPatch_copy, v1alpha1.K8SResourceOverlayPatch_test:
+		return jsonPatch(p)
+	case v1alpha1.K8SResourceOverlayPatch_merge, v1alpha1.K8SResourceOverlayPatch_strategicMerge:
+		return mergePatch(p)
+	}
+
+	return nil, errors.NewPlain("unsupported patch type")
+}
+
+// goPatch keeps the go-patch based replace and remove operations, so existing paths like
+// /spec/template/spec/containers/name=discovery/image keep working
+func goPatch(p *v1alpha1.K8SResourceOverlayPatch_Patch) (overlayPatchFunc, error) {
+	op := ypatch.OpDefinition{
+		Type: p.GetType().String(),
+		Path: &p.Path,
+	}
+
+	if p.GetType() == v1alpha1.K8SResourceOverlayPatch_replace {
+		var value interface{} = p.GetValue()
+		if p.GetParseValue() {
+			if err := yamlv2.Unmarshal([]byte(p.GetValue()), &value); err != nil {
+				return nil, errors.WrapIf(err, "could not unmarshal value")
+			}
+		}
+		op.Value = &value
+	}
+
+	ops, err := ypatch.NewOpsFromDefinitions([]ypatch.OpDefinition{op})
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not init patch ops from definitions")
+	}
+
+	return func(doc []byte, _ runtime.Object) ([]byte, error) {
+		var in interface{}
+		if err := yamlv2.Unmarshal(doc, &in); err != nil {
+			return nil, errors.WrapIf(err, "could not unmarshal resource")
+		}
+
+		res, err := ops.Apply(in)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not apply patch", "path", p.GetPath())
+		}
+
+		y, err := yamlv2.Marshal(res)
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not marshal patched resource")
+		}
+
+		return yaml.YAMLToJSON(y)
+	}, nil
+}
+
+func jsonPatch(p *v1alpha1.K8SResourceOverlayPatch_Patch) (overlayPatchFunc, error) {
+	op := map[string]interface{}{
+		"op":   p.GetType().String(),
+		"path": p.GetPath(),
+	}
+
+	switch p.GetType() {
+	case v1alpha1.K8SResourceOverlayPatch_move, v1alpha1.K8SResourceOverlayPatch_copy:
+		op["from"] = p.GetFrom()
+	default:
+		var value interface{} = p.GetValue()
+		if p.GetParseValue() {
+			if err := yaml.Unmarshal([]byte(p.GetValue()), &value); err != nil {
+				return nil, errors.WrapIf(err, "could not unmarshal value")
+			}
+		}
+		op["value"] = value
+	}
+
+	ops, err := json.Marshal([]interface{}{op})
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not marshal patch")
+	}
+
+	patch, err := jsonpatch.DecodePatch(ops)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not decode patch")
+	}
+
+	return func(doc []byte, _ runtime.Object) ([]byte, error) {
+		res, err := patch.Apply(doc)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not apply patch", "op", p.GetType().String(), "path", p.GetPath())
+		}
+
+		return res, nil
+	}, nil
+}
+
+func mergePatch(p *v1alpha1.K8SResourceOverlayPatch_Patch) (overlayPatchFunc, error) {
+	patch, err := yaml.YAMLToJSON([]byte(p.GetValue()))
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not unmarshal value")
+	}
+
+	strategic := p.GetType() == v1alpha1.K8SResourceOverlayPatch_strategicMerge
+
+	return func(doc []byte, object runtime.Object) ([]byte, error) {
+		var res []byte
+		var err error
+		if _, isUnstructured := object.(*unstructured.Unstructured); strategic && !isUnstructured {
+			res, err = strategicpatch.StrategicMergePatch(doc, patch, object)
+		} else {
+			res, err = jsonpatch.MergePatch(doc, patch)
+		}
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not apply patch", "type", p.GetType().String())
+		}
+
+		return res, nil
+	}, nil
+}
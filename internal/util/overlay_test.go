@@ -0,0 +1,202 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+)
+
+func testDeployment(name string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "discovery",
+							Image: "pilot:1.17.8",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applyOverlays(t *testing.T, o runtime.Object, overlays ...*v1alpha1.K8SResourceOverlayPatch) runtime.Object {
+	t.Helper()
+
+	modifiers, err := util.ConvertK8sOverlays(overlays)
+	assert.NilError(t, err)
+
+	for _, modifier := range modifiers {
+		o, err = modifier(o)
+		assert.NilError(t, err)
+	}
+
+	return o
+}
+
+func TestK8sOverlayPatchTypes(t *testing.T) {
+	t.Parallel()
+
+	o := applyOverlays(t, testDeployment("istiod-cp-v117x", nil), &v1alpha1.K8SResourceOverlayPatch{
+		GroupVersionKind: &v1alpha1.K8SResourceOverlayPatch_GroupVersionKind{
+			Kind: "Deploy*",
+		},
+		ObjectKey: &v1alpha1.NamespacedName{
+			Name: "istiod-*",
+		},
+		Patches: []*v1alpha1.K8SResourceOverlayPatch_Patch{
+			{
+				Type:  v1alpha1.K8SResourceOverlayPatch_replace,
+				Path:  "/spec/template/spec/containers/name=discovery/image",
+				Value: "pilot:1.17.9",
+			},
+			{
+				Type:       v1alpha1.K8SResourceOverlayPatch_add,
+				Path:       "/spec/template/spec/containers/-",
+				Value:      "{name: sidecar, image: sidecar:1.0}",
+				ParseValue: true,
+			},
+			{
+				Type:  v1alpha1.K8SResourceOverlayPatch_test,
+				Path:  "/spec/template/spec/containers/1/name",
+				Value: "sidecar",
+			},
+			{
+				Type: v1alpha1.K8SResourceOverlayPatch_copy,
+				From: "/metadata/name",
+				Path: "/spec/template/spec/serviceAccountName",
+			},
+			{
+				Type:  v1alpha1.K8SResourceOverlayPatch_merge,
+				Value: "metadata: {labels: {merged: \"true\"}}",
+			},
+			{
+				Type:  v1alpha1.K8SResourceOverlayPatch_strategicMerge,
+				Value: "spec: {template: {spec: {containers: [{name: discovery, args: [discovery]}]}}}",
+			},
+		},
+	})
+
+	deployment, ok := o.(*appsv1.Deployment)
+	assert.Assert(t, ok, "unexpected type: %T", o)
+
+	containers := deployment.Spec.Template.Spec.Containers
+	assert.Equal(t, len(containers), 2)
+	assert.Equal(t, containers[0].Image, "pilot:1.17.9")
+	assert.DeepEqual(t, containers[0].Args, []string{"discovery"})
+	assert.Equal(t, containers[1].Name, "sidecar")
+	assert.Equal(t, containers[1].Image, "sidecar:1.0")
+	assert.Equal(t, deployment.Spec.Template.Spec.ServiceAccountName, "istiod-cp-v117x")
+	assert.Equal(t, deployment.Labels["merged"], "true")
+}
+
+func TestK8sOverlayMatching(t *testing.T) {
+	t.Parallel()
+
+	overlay := &v1alpha1.K8SResourceOverlayPatch{
+		GroupVersionKind: &v1alpha1.K8SResourceOverlayPatch_GroupVersionKind{
+			Group: "apps",
+			Kind:  "*",
+		},
+		LabelSelector: "app in (istiod)",
+		Patches: []*v1alpha1.K8SResourceOverlayPatch_Patch{
+			{
+				Type:  v1alpha1.K8SResourceOverlayPatch_merge,
+				Value: "metadata: {annotations: {patched: \"true\"}}",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		object  runtime.Object
+		patched bool
+	}{
+		{
+			name:    "matching labels",
+			object:  testDeployment("istiod", map[string]string{"app": "istiod"}),
+			patched: true,
+		},
+		{
+			name:   "non matching labels",
+			object: testDeployment("istio-cni", map[string]string{"app": "istio-cni"}),
+		},
+		{
+			name: "non matching group",
+			object: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata": map[string]interface{}{
+						"name":   "istiod",
+						"labels": map[string]interface{}{"app": "istiod"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := applyOverlays(t, tc.object, overlay)
+
+			meta, ok := o.(metav1.Object)
+			assert.Assert(t, ok, "unexpected type: %T", o)
+			assert.Equal(t, meta.GetAnnotations()["patched"] == "true", tc.patched)
+		})
+	}
+}
+
+func TestK8sOverlayInvalidPatch(t *testing.T) {
+	t.Parallel()
+
+	_, err := util.ConvertK8sOverlays([]*v1alpha1.K8SResourceOverlayPatch{
+		{
+			Patches: []*v1alpha1.K8SResourceOverlayPatch_Patch{
+				{
+					Path: "/metadata/name",
+				},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "unsupported patch type")
+}
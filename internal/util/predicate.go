@@ -22,6 +22,8 @@ import (
 	"strings"
 
 	"emperror.dev/errors"
+	"github.com/golang/protobuf/proto"
+	appsv1 "k8s.io/api/apps/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -190,6 +192,38 @@ func (p ICPInjectorChangePredicate) Generic(e event.GenericEvent) bool {
 	return false
 }
 
+type ICPMeshGatewayAttachmentChangePredicate struct {
+	predicate.Funcs
+}
+
+func (p ICPMeshGatewayAttachmentChangePredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (p ICPMeshGatewayAttachmentChangePredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (p ICPMeshGatewayAttachmentChangePredicate) Update(e event.UpdateEvent) bool {
+	var ok bool
+	var oldICP *servicemeshv1alpha1.IstioControlPlane
+	var newICP *servicemeshv1alpha1.IstioControlPlane
+
+	if oldICP, ok = e.ObjectOld.(*servicemeshv1alpha1.IstioControlPlane); !ok {
+		return false
+	}
+
+	if newICP, ok = e.ObjectNew.(*servicemeshv1alpha1.IstioControlPlane); !ok {
+		return false
+	}
+
+	return !proto.Equal(oldICP.GetResolvedSpec().GetMeshGatewayAttachment(), newICP.GetResolvedSpec().GetMeshGatewayAttachment())
+}
+
+func (p ICPMeshGatewayAttachmentChangePredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
+
 type IMGWAddressChangePredicate struct{}
 
 func (p IMGWAddressChangePredicate) Create(e event.CreateEvent) bool {
@@ -198,7 +232,11 @@ func (p IMGWAddressChangePredicate) Create(e event.CreateEvent) bool {
 
 func (p IMGWAddressChangePredicate) Update(e event.UpdateEvent) bool {
 	if o, ok := e.ObjectOld.(*servicemeshv1alpha1.IstioMeshGateway); ok {
-		return !reflect.DeepEqual(o.GetStatus().GatewayAddress, e.ObjectNew.(*servicemeshv1alpha1.IstioMeshGateway).GetStatus().GatewayAddress)
+		n := e.ObjectNew.(*servicemeshv1alpha1.IstioMeshGateway)
+
+		return !reflect.DeepEqual(o.GetStatus().GatewayAddress, n.GetStatus().GatewayAddress) ||
+			!reflect.DeepEqual(o.GetStatus().GatewayHostnames, n.GetStatus().GatewayHostnames) ||
+			o.GetStatus().Status != n.GetStatus().Status
 	}
 
 	return false
@@ -293,3 +331,74 @@ func (p NamespaceRevisionLabelChange) Delete(e event.DeleteEvent) bool {
 func (p NamespaceRevisionLabelChange) Generic(e event.GenericEvent) bool {
 	return false
 }
+
+type NamespaceLabelChange struct {
+	predicate.Funcs
+}
+
+func (p NamespaceLabelChange) Update(e event.UpdateEvent) bool {
+	return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+}
+
+func (p NamespaceLabelChange) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (p NamespaceLabelChange) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (p NamespaceLabelChange) Generic(e event.GenericEvent) bool {
+	return false
+}
+
+type NamespaceSidecarEgressAnnotationChange struct {
+	predicate.Funcs
+}
+
+func (p NamespaceSidecarEgressAnnotationChange) Update(e event.UpdateEvent) bool {
+	// only namespaces enabled for injection are relevant
+	if _, ok := e.ObjectNew.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; !ok {
+		return false
+	}
+
+	return e.ObjectOld.GetAnnotations()[servicemeshv1alpha1.SidecarEgressNamespacesAnnotation] != e.ObjectNew.GetAnnotations()[servicemeshv1alpha1.SidecarEgressNamespacesAnnotation]
+}
+
+func (p NamespaceSidecarEgressAnnotationChange) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (p NamespaceSidecarEgressAnnotationChange) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (p NamespaceSidecarEgressAnnotationChange) Generic(e event.GenericEvent) bool {
+	return false
+}
+
+type DeploymentReplicasChangePredicate struct{}
+
+func (p DeploymentReplicasChangePredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (p DeploymentReplicasChangePredicate) Update(e event.UpdateEvent) bool {
+	if o, ok := e.ObjectOld.(*appsv1.Deployment); ok {
+		n := e.ObjectNew.(*appsv1.Deployment)
+
+		return o.Status.Replicas != n.Status.Replicas ||
+			o.Status.ReadyReplicas != n.Status.ReadyReplicas ||
+			o.Status.UpdatedReplicas != n.Status.UpdatedReplicas
+	}
+
+	return false
+}
+
+func (p DeploymentReplicasChangePredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (p DeploymentReplicasChangePredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
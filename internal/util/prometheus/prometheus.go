@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+const (
+	namespaceDependenciesQuery = `sum by (source_workload_namespace, destination_service_namespace) (increase(istio_requests_total{reporter="source"}[%[1]s])) > 0 ` +
+		`or sum by (source_workload_namespace, destination_service_namespace) (increase(istio_tcp_connections_opened_total{reporter="source"}[%[1]s])) > 0`
+	plaintextSourcesQuery = `sum by (source_workload_namespace, destination_workload_namespace) (increase(istio_requests_total{reporter="destination",connection_security_policy!="mutual_tls"}[%[1]s])) > 0 ` +
+		`or sum by (source_workload_namespace, destination_workload_namespace) (increase(istio_tcp_connections_opened_total{reporter="destination",connection_security_policy!="mutual_tls"}[%[1]s])) > 0`
+
+	queryTimeout = time.Second * 5
+)
+
+// the queries are made during reconciliation, so a slow Prometheus server must not block the controller
+var httpClient = &http.Client{
+	Timeout: queryTimeout,
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// GetNamespaceDependencies returns the namespaces of the services called by the workloads of each namespace
+// within the given time range, based on the Istio standard metrics collected by the Prometheus server at address
+func GetNamespaceDependencies(ctx context.Context, address string, lookback time.Duration) (map[string][]string, error) {
+	samples, err := query(ctx, address, fmt.Sprintf(namespaceDependenciesQuery, formatRange(lookback)))
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies := make(map[string]map[string]struct{})
+	for _, metric := range samples {
+		source := metric["source_workload_namespace"]
+		destination := metric["destination_service_namespace"]
+		if source == "" || destination == "" || destination == "unknown" || source == destination {
+			continue
+		}
+
+		if dependencies[source] == nil {
+			dependencies[source] = make(map[string]struct{})
+		}
+		dependencies[source][destination] = struct{}{}
+	}
+
+	return sortedNamespaceSets(dependencies), nil
+}
+
+// GetPlaintextSources returns the namespaces of the workloads which sent traffic without mutual TLS to the workloads
+// of each namespace within the given time range. Workloads outside the mesh are reported as "unknown".
+func GetPlaintextSources(ctx context.Context, address string, lookback time.Duration) (map[string][]string, error) {
+	samples, err := query(ctx, address, fmt.Sprintf(plaintextSourcesQuery, formatRange(lookback)))
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]map[string]struct{})
+	for _, metric := range samples {
+		source := metric["source_workload_namespace"]
+		destination := metric["destination_workload_namespace"]
+		if destination == "" || destination == "unknown" {
+			continue
+		}
+		if source == "" {
+			source = "unknown"
+		}
+
+		if sources[destination] == nil {
+			sources[destination] = make(map[string]struct{})
+		}
+		sources[destination][source] = struct{}{}
+	}
+
+	return sortedNamespaceSets(sources), nil
+}
+
+func query(ctx context.Context, address string, promQL string) ([]map[string]string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "invalid prometheus address", "address", address)
+	}
+	u = u.JoinPath("api", "v1", "query")
+	u.RawQuery = url.Values{
+		"query": []string{promQL},
+	}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not query prometheus")
+	}
+	defer resp.Body.Close()
+
+	var response queryResponse
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not decode prometheus response")
+	}
+
+	if response.Status != "success" {
+		return nil, errors.NewWithDetails("prometheus query failed", "error", response.Error, "statusCode", resp.StatusCode)
+	}
+
+	samples := make([]map[string]string, 0, len(response.Data.Result))
+	for _, sample := range response.Data.Result {
+		samples = append(samples, sample.Metric)
+	}
+
+	return samples, nil
+}
+
+func formatRange(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
+
+func sortedNamespaceSets(sets map[string]map[string]struct{}) map[string][]string {
+	result := make(map[string][]string, len(sets))
+	for key, set := range sets {
+		names := make([]string, 0, len(set))
+		for name := range set {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		result[key] = names
+	}
+
+	return result
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/util/prometheus"
+)
+
+const queryResponse = `{
+  "status": "success",
+  "data": {
+    "resultType": "vector",
+    "result": [
+      {"metric": {"source_workload_namespace": "frontend", "destination_service_namespace": "backend"}, "value": [1, "12"]},
+      {"metric": {"source_workload_namespace": "frontend", "destination_service_namespace": "auth"}, "value": [1, "3"]},
+      {"metric": {"source_workload_namespace": "frontend", "destination_service_namespace": "frontend"}, "value": [1, "5"]},
+      {"metric": {"source_workload_namespace": "backend", "destination_service_namespace": "unknown"}, "value": [1, "1"]},
+      {"metric": {"source_workload_namespace": "backend", "destination_service_namespace": "db"}, "value": [1, "7"]}
+    ]
+  }
+}`
+
+func TestGetNamespaceDependencies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v1/query")
+		assert.Assert(t, strings.Contains(r.URL.Query().Get("query"), "[3600s]"))
+
+		_, _ = w.Write([]byte(queryResponse))
+	}))
+	defer server.Close()
+
+	dependencies, err := prometheus.GetNamespaceDependencies(context.Background(), server.URL, time.Hour)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dependencies, map[string][]string{
+		"frontend": {"auth", "backend"},
+		"backend":  {"db"},
+	})
+}
+
+func TestGetNamespaceDependenciesQueryError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status": "error", "errorType": "bad_data", "error": "parse error"}`))
+	}))
+	defer server.Close()
+
+	_, err := prometheus.GetNamespaceDependencies(context.Background(), server.URL, time.Hour)
+	assert.ErrorContains(t, err, "prometheus query failed")
+}
+
+func TestGetPlaintextSources(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Assert(t, strings.Contains(r.URL.Query().Get("query"), `connection_security_policy!="mutual_tls"`))
+
+		_, _ = w.Write([]byte(`{
+  "status": "success",
+  "data": {
+    "resultType": "vector",
+    "result": [
+      {"metric": {"source_workload_namespace": "unknown", "destination_workload_namespace": "backend"}, "value": [1, "4"]},
+      {"metric": {"source_workload_namespace": "legacy", "destination_workload_namespace": "backend"}, "value": [1, "2"]},
+      {"metric": {"destination_workload_namespace": "frontend"}, "value": [1, "1"]},
+      {"metric": {"source_workload_namespace": "legacy", "destination_workload_namespace": "unknown"}, "value": [1, "1"]}
+    ]
+  }
+}`))
+	}))
+	defer server.Close()
+
+	sources, err := prometheus.GetPlaintextSources(context.Background(), server.URL, time.Hour)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sources, map[string][]string{
+		"backend":  {"legacy", "unknown"},
+		"frontend": {"unknown"},
+	})
+}
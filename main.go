@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"os"
+	"time"
 
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
@@ -28,6 +29,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	// +kubebuilder:scaffold:imports
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
@@ -77,6 +79,14 @@ func main() {
 	flag.BoolVar(&clusterRegistryConfiguration.ResourceSyncRules.Enabled, "cluster-registry-sync-rules-enabled", false, "Enable automatically creating the necessary ResourceSyncRule resources from the cluster registry API for multi cluster setups.")
 	var webhookServerPort uint
 	flag.UintVar(&webhookServerPort, "webhook-server-port", 9443, "The port that the webhook server serves at.")
+	var gatewayHostnameResolutionInterval time.Duration
+	flag.DurationVar(&gatewayHostnameResolutionInterval, "gateway-hostname-resolution-interval", 5*time.Minute, "The interval in which the hostnames of gateways are re-resolved to IP addresses.")
+	var uninstall bool
+	flag.BoolVar(&uninstall, "uninstall", false, "Remove every managed resource in order (mesh gateways, control plane components, base CRDs), then the finalizers and exit.")
+	var uninstallTimeout time.Duration
+	flag.DurationVar(&uninstallTimeout, "uninstall-timeout", 10*time.Minute, "The time to wait for each uninstall step to finish.")
+	var serverSideApply bool
+	flag.BoolVar(&serverSideApply, "server-side-apply", false, "Apply the managed resources server-side with a dedicated field manager, reporting conflicting fields instead of overwriting them.")
 	var verboseLogging bool
 	flag.BoolVar(&verboseLogging, "verbose", false, "Enable verbose logging")
 	flag.Parse()
@@ -96,46 +106,69 @@ func main() {
 		os.Exit(1)
 	}
 
+	var resourceClient client.Client = mgr.GetClient()
+	if serverSideApply {
+		resourceClient = util.NewServerSideApplyClient(mgr.GetClient(), util.FieldManager, util.WebhookFailurePolicyField)
+	}
+
 	istioControlPlaneLogger := logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioControlPlane"))
 	if err = (&controllers.IstioControlPlaneReconciler{
 		Client: mgr.GetClient(),
 		Log:    istioControlPlaneLogger,
 		Scheme: mgr.GetScheme(),
-		ResourceReconciler: reconciler.NewReconcilerWith(mgr.GetClient(),
+		ResourceReconciler: reconciler.NewReconcilerWith(resourceClient,
 			reconciler.WithLog(istioControlPlaneLogger.GetLogrLogger()),
 			reconciler.WithRecreateImmediately(),
 			reconciler.WithEnableRecreateWorkload(),
 			reconciler.WithRecreateEnabledForAll(),
+			reconciler.WithPatchMaker(util.NewProtoCompatiblePatchMaker()),
 		),
 		ClusterRegistry:          clusterRegistryConfiguration,
 		APIServerEndpointAddress: apiServerEndpointAddress,
 		SupportedIstioVersion:    SupportedIstioVersion,
 		Version:                  Version,
 		Recorder:                 mgr.GetEventRecorderFor("IstioControlPlane"),
+		ServerSideApply:          serverSideApply,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IstioControlPlane")
 		os.Exit(1)
 	}
+	istioMeshGatewayLogger := logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioMeshGateway"))
 	if err = (&controllers.IstioMeshGatewayReconciler{
-		Client: mgr.GetClient(),
-		Log:    logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioMeshGateway")),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Log:      istioMeshGatewayLogger,
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("IstioMeshGateway"),
+		ResourceReconciler: reconciler.NewReconcilerWith(resourceClient,
+			reconciler.WithLog(istioMeshGatewayLogger.GetLogrLogger()),
+			reconciler.WithPatchMaker(util.NewProtoCompatiblePatchMaker()),
+		),
+		HostnameResolutionInterval: gatewayHostnameResolutionInterval,
+		ServerSideApply:            serverSideApply,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IstioMeshGateway")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
 
+	ctx, cancel := context.WithCancel(ctrl.SetupSignalHandler())
+	defer cancel()
+
+	if uninstall {
+		if err = mgr.Add(&controllers.Uninstaller{
+			Client:     mgr.GetClient(),
+			Log:        logger.NewWithLogrLogger(ctrl.Log.WithName("uninstall")),
+			Timeout:    uninstallTimeout,
+			OnFinished: cancel,
+		}); err != nil {
+			setupLog.Error(err, "unable to add uninstaller")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
-
-	// remove finalizers
-	setupLog.Info("removing finalizer from controlled resources")
-	err = controllers.RemoveFinalizers(context.Background(), mgr.GetClient())
-	if err != nil {
-		setupLog.Error(err, "could not remove finalizers from controlled resources")
-	}
 }
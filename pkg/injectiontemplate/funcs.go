@@ -0,0 +1,343 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package injectiontemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/golang/protobuf/jsonpb"
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kstrings "k8s.io/utils/strings"
+	"sigs.k8s.io/yaml"
+)
+
+const proxyContainerName = "istio-proxy"
+
+// FuncMap returns the functions available in the injection templates, the same as the ones of the sidecar injector of
+// Istio. The parity with the upstream functions is tested in the parity module against a pinned Istio version.
+// The only difference is that protoToJSON does not strip the default fields of the proxy config, it only shortens
+// the output.
+func FuncMap() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	istioFuncs := template.FuncMap{
+		"formatDuration":      formatDuration,
+		"isset":               isset,
+		"excludeInboundPort":  excludeInboundPort,
+		"includeInboundPorts": includeInboundPorts,
+		"kubevirtInterfaces":  kubevirtInterfaces,
+		"excludeInterfaces":   excludeInterfaces,
+		"applicationPorts":    applicationPorts,
+		"annotation":          getAnnotation,
+		"valueOrDefault":      valueOrDefault,
+		"toJSON":              toJSON,
+		"fromJSON":            fromJSON,
+		"structToJSON":        structToJSON,
+		"protoToJSON":         protoToJSON,
+		"toYaml":              toYaml,
+		"indent":              indent,
+		"directory":           directory,
+		"contains":            flippedContains,
+		"toLower":             strings.ToLower,
+		"appendMultusNetwork": appendMultusNetwork,
+		"env":                 env,
+		"omit":                omit,
+		"strdict":             strdict,
+		"toJsonMap":           toJSONMap,
+		"mergeMaps":           mergeMaps,
+	}
+	for name, f := range istioFuncs {
+		funcs[name] = f
+	}
+
+	return funcs
+}
+
+func formatDuration(in *durationpb.Duration) string {
+	return in.AsDuration().String()
+}
+
+func isset(m map[string]string, key string) bool {
+	_, ok := m[key]
+
+	return ok
+}
+
+func excludeInboundPort(port interface{}, excludedInboundPorts string) string {
+	portStr := strings.TrimSpace(fmt.Sprint(port))
+	if len(portStr) == 0 || portStr == "0" {
+		// Nothing to do.
+		return excludedInboundPorts
+	}
+
+	ports := make([]string, 0)
+	for _, port := range strings.Split(excludedInboundPorts, ",") {
+		if port == portStr {
+			// the port is already excluded
+			return excludedInboundPorts
+		}
+		if port = strings.TrimSpace(port); len(port) > 0 {
+			ports = append(ports, port)
+		}
+	}
+
+	return strings.Join(append(ports, portStr), ",")
+}
+
+func includeInboundPorts(containers []corev1.Container) string {
+	return containerPorts(containers, func(corev1.Container) bool {
+		return true
+	})
+}
+
+func applicationPorts(containers []corev1.Container) string {
+	return containerPorts(containers, func(container corev1.Container) bool {
+		return container.Name != proxyContainerName
+	})
+}
+
+func containerPorts(containers []corev1.Container, include func(corev1.Container) bool) string {
+	ports := make([]string, 0)
+	for _, container := range containers {
+		if !include(container) {
+			continue
+		}
+
+		for _, port := range container.Ports {
+			if port.Protocol == corev1.ProtocolUDP || port.Protocol == corev1.ProtocolSCTP {
+				continue
+			}
+			ports = append(ports, strconv.Itoa(int(port.ContainerPort)))
+		}
+	}
+
+	return strings.Join(ports, ",")
+}
+
+func kubevirtInterfaces(s string) string {
+	return s
+}
+
+func excludeInterfaces(s string) string {
+	return s
+}
+
+func getAnnotation(meta metav1.ObjectMeta, name string, defaultValue interface{}) string {
+	value, ok := meta.Annotations[name]
+	if !ok {
+		value = fmt.Sprint(defaultValue)
+	}
+
+	return value
+}
+
+func valueOrDefault(value interface{}, defaultValue interface{}) interface{} {
+	if value == "" || value == nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func toJSON(m map[string]string) string {
+	if m == nil {
+		return "{}"
+	}
+
+	j, err := json.Marshal(m)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(j)
+}
+
+func fromJSON(j string) interface{} {
+	var m interface{}
+	if err := json.Unmarshal([]byte(j), &m); err != nil {
+		return "{}"
+	}
+
+	return m
+}
+
+func structToJSON(v interface{}) string {
+	if v == nil {
+		return "{}"
+	}
+
+	j, err := json.Marshal(v)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(j)
+}
+
+func protoToJSON(v proto.Message) string {
+	if v == nil {
+		return "{}"
+	}
+
+	j, err := (&jsonpb.Marshaler{}).MarshalToString(protov1.MessageV1(v))
+	if err != nil {
+		return "{}"
+	}
+
+	return j
+}
+
+func toYaml(value interface{}) string {
+	y, err := yaml.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(y)
+}
+
+func indent(spaces int, source string) string {
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		if i > 0 {
+			lines[i] = strings.Repeat(" ", spaces) + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func directory(filepath string) string {
+	dir, _ := path.Split(filepath)
+
+	return dir
+}
+
+func flippedContains(needle, haystack string) bool {
+	return strings.Contains(haystack, needle)
+}
+
+func appendMultusNetwork(existingValue, istioCniNetwork string) string {
+	if existingValue == "" {
+		return istioCniNetwork
+	}
+
+	if i := strings.LastIndex(existingValue, "]"); i != -1 {
+		networks := []map[string]interface{}{}
+		if err := json.Unmarshal([]byte(existingValue), &networks); err != nil {
+			return existingValue
+		}
+
+		namespace, name := kstrings.SplitQualifiedName(istioCniNetwork)
+		for _, network := range networks {
+			networkNamespace := network["namespace"]
+			if networkNamespace == nil {
+				networkNamespace = ""
+			}
+			if network["name"] == name && networkNamespace == namespace {
+				return existingValue
+			}
+		}
+
+		if namespace == "" {
+			return existingValue[0:i] + fmt.Sprintf(`, {"name": "%s"}`, name) + existingValue[i:]
+		}
+
+		return existingValue[0:i] + fmt.Sprintf(`, {"name": "%s", "namespace": "%s"}`, name, namespace) + existingValue[i:]
+	}
+
+	for _, network := range strings.Split(existingValue, ",") {
+		if strings.TrimSpace(network) == istioCniNetwork {
+			return existingValue
+		}
+	}
+
+	return existingValue + ", " + istioCniNetwork
+}
+
+func env(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return def
+}
+
+func omit(dict map[string]string, keys ...string) map[string]string {
+	omitted := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		omitted[key] = struct{}{}
+	}
+
+	result := make(map[string]string, len(dict))
+	for key, value := range dict {
+		if _, ok := omitted[key]; !ok {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// strdict is the same as the dict function of sprig, but returns a map[string]string for labels and annotations
+func strdict(v ...string) map[string]string {
+	dict := make(map[string]string, (len(v)+1)/2)
+	for i := 0; i < len(v); i += 2 {
+		if i+1 < len(v) {
+			dict[v[i]] = v[i+1]
+		} else {
+			dict[v[i]] = ""
+		}
+	}
+
+	return dict
+}
+
+func toJSONMap(mps ...map[string]string) string {
+	j, err := json.Marshal(mergeMaps(mps...))
+	if err != nil {
+		return ""
+	}
+
+	return string(j)
+}
+
+// mergeMaps merges the maps, the latter ones take precedence
+func mergeMaps(mps ...map[string]string) map[string]string {
+	if len(mps) == 0 {
+		return nil
+	}
+
+	data := make(map[string]string, len(mps[0]))
+	for _, m := range mps {
+		for key, value := range m {
+			data[key] = value
+		}
+	}
+
+	return data
+}
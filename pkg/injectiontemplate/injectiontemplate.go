@@ -0,0 +1,227 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package injectiontemplate
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"emperror.dev/errors"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var errorLinePatterns = []*regexp.Regexp{
+	// errors of the template engine, e.g. template: sidecar:3:12: executing "sidecar" at <.Values.x>: ...
+	regexp.MustCompile(`template: [^:]+:(\d+)`),
+	// errors of the YAML parser, the line of the rendered template
+	regexp.MustCompile(`line (\d+)`),
+}
+
+// Error describes an invalid injection template
+type Error struct {
+	// Name of the template
+	Template string
+	// Line of the error, 0 if unknown
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("injection template %s is invalid at line %d: %s", e.Template, e.Line, e.Message)
+	}
+
+	return fmt.Sprintf("injection template %s is invalid: %s", e.Template, e.Message)
+}
+
+func newError(name string, err error) *Error {
+	e := &Error{
+		Template: name,
+		Message:  err.Error(),
+	}
+
+	for _, pattern := range errorLinePatterns {
+		if m := pattern.FindStringSubmatch(e.Message); m != nil {
+			e.Line, _ = strconv.Atoi(m[1])
+
+			break
+		}
+	}
+
+	return e
+}
+
+// Data is the data the injection templates are rendered with by the sidecar injector of Istio
+type Data struct {
+	TypeMeta             metav1.TypeMeta
+	DeploymentMeta       metav1.ObjectMeta
+	ObjectMeta           metav1.ObjectMeta
+	Spec                 corev1.PodSpec
+	ProxyConfig          *meshv1alpha1.ProxyConfig
+	MeshConfig           *meshv1alpha1.MeshConfig
+	Values               map[string]interface{}
+	Revision             string
+	EstimatedConcurrency int
+	ProxyImage           string
+}
+
+// SampleData returns the data of a sample pod to render the injection templates with
+func SampleData(values map[string]interface{}, meshConfig *meshv1alpha1.MeshConfig) Data {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if meshConfig == nil {
+		meshConfig = &meshv1alpha1.MeshConfig{}
+	}
+	proxyConfig := meshConfig.GetDefaultConfig()
+	if proxyConfig == nil {
+		proxyConfig = &meshv1alpha1.ProxyConfig{}
+	}
+
+	revision, _ := values["revision"].(string)
+
+	return Data{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		DeploymentMeta: metav1.ObjectMeta{
+			Name:      "sample",
+			Namespace: "default",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-7d4c9b8f6d-x2x5z",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":     "sample",
+				"version": "v1",
+			},
+			Annotations: map[string]string{},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "sample",
+					Image: "busybox",
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          "http",
+							ContainerPort: 8080,
+							Protocol:      corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+		},
+		ProxyConfig:          proxyConfig,
+		MeshConfig:           meshConfig,
+		Values:               values,
+		Revision:             revision,
+		EstimatedConcurrency: 2,
+		ProxyImage:           proxyImage(values),
+	}
+}
+
+func proxyImage(values map[string]interface{}) string {
+	global, _ := values["global"].(map[string]interface{})
+	proxy, _ := global["proxy"].(map[string]interface{})
+
+	hub, _ := global["hub"].(string)
+	tag := fmt.Sprint(global["tag"])
+	image, _ := proxy["image"].(string)
+	if image == "" {
+		image = "proxyv2"
+	}
+	if hub == "" {
+		return image
+	}
+
+	return fmt.Sprintf("%s/%s:%s", hub, image, tag)
+}
+
+// Validate renders the injection template with the data and checks that the result is a valid pod
+func Validate(name string, tmpl string, data Data) error {
+	t, err := template.New(name).Funcs(FuncMap()).Parse(tmpl)
+	if err != nil {
+		return newError(name, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, &data); err != nil {
+		return newError(name, err)
+	}
+
+	// unknown fields are ignored by the injector as well
+	pod := &corev1.Pod{}
+	if err := yaml.Unmarshal(rendered.Bytes(), pod); err != nil {
+		return newError(name, errors.WrapIf(err, "rendered template is not a valid pod"))
+	}
+
+	return nil
+}
+
+// ValidateInjectorConfig validates the named templates of the sidecar injector config with the values of the injector,
+// the data of the istio-sidecar-injector configmap
+func ValidateInjectorConfig(config string, values string, meshConfig *meshv1alpha1.MeshConfig, names []string) ([]*Error, error) {
+	injectorConfig := struct {
+		Templates map[string]string `json:"templates"`
+	}{}
+	if err := yaml.Unmarshal([]byte(config), &injectorConfig); err != nil {
+		return nil, errors.WrapIf(err, "could not parse sidecar injector config")
+	}
+
+	injectorValues := map[string]interface{}{}
+	if values != "" {
+		if err := yaml.Unmarshal([]byte(values), &injectorValues); err != nil {
+			return nil, errors.WrapIf(err, "could not parse sidecar injector values")
+		}
+	}
+
+	data := SampleData(injectorValues, meshConfig)
+
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+
+	invalid := []*Error{}
+	for _, name := range sorted {
+		tmpl, ok := injectorConfig.Templates[name]
+		if !ok {
+			invalid = append(invalid, &Error{
+				Template: name,
+				Message:  "template is missing from the sidecar injector config",
+			})
+
+			continue
+		}
+
+		if err := Validate(name, tmpl, data); err != nil {
+			var e *Error
+			if errors.As(err, &e) {
+				invalid = append(invalid, e)
+			}
+		}
+	}
+
+	return invalid, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package injectiontemplate_test
+
+import (
+	"os"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/golang/protobuf/jsonpb"
+	"gotest.tools/v3/assert"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/injectiontemplate"
+)
+
+func readConfigMap(t *testing.T, fileName string) *corev1.ConfigMap {
+	t.Helper()
+
+	y, err := os.ReadFile(fileName)
+	assert.NilError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	assert.NilError(t, yaml.Unmarshal(y, cm))
+
+	return cm
+}
+
+func TestValidateInjectorConfig(t *testing.T) {
+	t.Parallel()
+
+	injector := readConfigMap(t, "testdata/sidecar-injector-configmap.yaml")
+
+	mcJSON, err := yaml.YAMLToJSON([]byte(readConfigMap(t, "testdata/mesh-configmap.yaml").Data["mesh"]))
+	assert.NilError(t, err)
+	meshConfig := &meshv1alpha1.MeshConfig{}
+	assert.NilError(t, jsonpb.UnmarshalString(string(mcJSON), meshConfig))
+
+	// the default templates of Istio are rendered with the same functions
+	invalid, err := injectiontemplate.ValidateInjectorConfig(injector.Data["config"], injector.Data["values"], meshConfig, []string{
+		"sidecar", "gateway", "grpc-simple", "grpc-agent", "sidecarOverrides", "gatewayOverrides",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(invalid), 0, "%v", invalid)
+
+	invalid, err = injectiontemplate.ValidateInjectorConfig(injector.Data["config"], injector.Data["values"], meshConfig, []string{"missing"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(invalid), 1)
+	assert.Equal(t, invalid[0].Template, "missing")
+
+	_, err = injectiontemplate.ValidateInjectorConfig("templates: [", "", nil, nil)
+	assert.ErrorContains(t, err, "could not parse sidecar injector config")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	data := injectiontemplate.SampleData(map[string]interface{}{
+		"global": map[string]interface{}{
+			"hub": "gcr.io/istio-release",
+			"tag": "1.17.8",
+		},
+	}, nil)
+
+	err := injectiontemplate.Validate("valid", `spec:
+  containers:
+  - name: istio-proxy
+    image: {{ .ProxyImage }}
+    env:
+    - name: APP_PORTS
+      value: {{ includeInboundPorts .Spec.Containers | quote }}
+    - name: PROXY_CONFIG
+      value: {{ protoToJSON .ProxyConfig | quote }}
+    - name: LABELS
+      value: {{ toJSON .ObjectMeta.Labels | quote }}
+`, data)
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		name     string
+		template string
+		line     int
+		message  string
+	}{
+		{
+			name: "parse",
+			template: `spec:
+  containers:
+  - name: istio-proxy
+    image: {{ .ProxyImage }
+`,
+			line:    4,
+			message: "unexpected \"}\" in operand",
+		},
+		{
+			name: "execute",
+			template: `spec:
+  containers:
+  - name: istio-proxy
+    args:
+    - --drainDuration
+    - {{ formatDuration "45s" }}
+`,
+			line:    6,
+			message: "can't handle \"45s\"",
+		},
+		{
+			name: "function",
+			template: `spec:
+  containers:
+  - name: istio-proxy
+    image: {{ proxyImage . }}
+`,
+			line:    4,
+			message: "function \"proxyImage\" not defined",
+		},
+		{
+			name: "yaml",
+			template: `spec:
+  containers:
+  - name: istio-proxy
+     image: busybox
+`,
+			line:    4,
+			message: "rendered template is not a valid pod",
+		},
+		{
+			name: "type",
+			template: `spec:
+  containers:
+  - name: istio-proxy
+    ports: {{ .ProxyImage }}
+`,
+			message: "cannot unmarshal string into Go struct field",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := injectiontemplate.Validate(tc.name, tc.template, data)
+
+		var e *injectiontemplate.Error
+		assert.Assert(t, errors.As(err, &e), tc.name)
+		assert.Equal(t, e.Template, tc.name)
+		assert.Equal(t, e.Line, tc.line, tc.name)
+		assert.ErrorContains(t, err, tc.message, tc.name)
+	}
+}
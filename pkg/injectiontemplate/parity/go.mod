@@ -0,0 +1,23 @@
+module github.com/banzaicloud/istio-operator/v2/pkg/injectiontemplate/parity
+
+go 1.21
+
+// Istio release tags lack the v prefix of module versions, so the tag of the supported Istio release is resolved to its
+// pseudo-version and the dependencies are completed by go mod tidy, which the test-injection-template-parity make
+// target runs before the tests
+require istio.io/istio 1.17.8
+
+require (
+	github.com/Masterminds/sprig/v3 v3.2.2
+	github.com/banzaicloud/istio-operator/v2 v2.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.28.1
+	gotest.tools/v3 v3.0.3
+	istio.io/api v0.0.0-20231011001129-b6bd6cd1b885
+	k8s.io/api v0.26.1
+	k8s.io/apimachinery v0.26.1
+)
+
+replace (
+	github.com/banzaicloud/istio-operator/api/v2 => ../../../api
+	github.com/banzaicloud/istio-operator/v2 => ../../..
+)
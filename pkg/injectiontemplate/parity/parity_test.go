@@ -0,0 +1,151 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parity_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gotest.tools/v3/assert"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pkg/kube/inject"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/injectiontemplate"
+)
+
+type templateData struct {
+	ObjectMeta  metav1.ObjectMeta
+	Containers  []corev1.Container
+	Duration    *durationpb.Duration
+	ProxyConfig *meshv1alpha1.ProxyConfig
+}
+
+func TestFuncMapSignatures(t *testing.T) {
+	t.Parallel()
+
+	funcs := injectiontemplate.FuncMap()
+	for name, upstream := range inject.InjectionFuncmap {
+		f, ok := funcs[name]
+		assert.Assert(t, ok, "function %s is missing", name)
+		assert.Equal(t, reflect.TypeOf(f), reflect.TypeOf(upstream), "signature of function %s differs", name)
+	}
+}
+
+func TestFuncMapOutput(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"app":     "reviews",
+				"version": "v1",
+			},
+			Annotations: map[string]string{
+				"k8s.v1.cni.cncf.io/networks":      `[{"name": "macvlan"}]`,
+				"prometheus.io/port":               "9090",
+				"traffic.sidecar.istio.io/exclude": "8080",
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name: "app",
+				Ports: []corev1.ContainerPort{
+					{ContainerPort: 9080},
+					{ContainerPort: 5353, Protocol: corev1.ProtocolUDP},
+					{ContainerPort: 3868, Protocol: corev1.ProtocolSCTP},
+				},
+			},
+			{
+				Name: "istio-proxy",
+				Ports: []corev1.ContainerPort{
+					{ContainerPort: 15090, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+		Duration: durationpb.New(90_000_000_000),
+		ProxyConfig: &meshv1alpha1.ProxyConfig{
+			DiscoveryAddress: "istiod-cp-v117x.istio-system.svc:15012",
+			ProxyMetadata: map[string]string{
+				"ISTIO_META_DNS_CAPTURE": "true",
+			},
+		},
+	}
+
+	templates := []string{
+		`{{ formatDuration .Duration }}`,
+		`{{ isset .ObjectMeta.Annotations "prometheus.io/port" }} {{ isset .ObjectMeta.Annotations "missing" }}`,
+		`{{ excludeInboundPort 15020 "" }}`,
+		`{{ excludeInboundPort "15020" "8080, 9090" }}`,
+		`{{ excludeInboundPort 15020 "8080,15020" }}`,
+		`{{ excludeInboundPort 0 "8080" }}`,
+		`{{ includeInboundPorts .Containers }}`,
+		`{{ applicationPorts .Containers }}`,
+		`{{ kubevirtInterfaces "net1" }} {{ excludeInterfaces "net2" }}`,
+		`{{ annotation .ObjectMeta "prometheus.io/port" 15020 }} {{ annotation .ObjectMeta "missing" 15020 }}`,
+		`{{ valueOrDefault "" "default" }} {{ valueOrDefault "value" "default" }} {{ valueOrDefault nil 1 }}`,
+		`{{ toJSON .ObjectMeta.Labels }} {{ toJSON nil }}`,
+		`{{ fromJSON "{\"a\": [1, 2]}" }} {{ fromJSON "invalid" }}`,
+		`{{ structToJSON .Containers }} {{ structToJSON nil }}`,
+		`{{ protoToJSON .ProxyConfig }}`,
+		`{{ toYaml .ObjectMeta.Labels }}`,
+		`{{ indent 4 "a\nb\n  c" }}`,
+		`{{ directory "/etc/istio/proxy/envoy.json" }}`,
+		`{{ contains "istio" "istio-proxy" }} {{ contains "envoy" "istio-proxy" }}`,
+		`{{ toLower "ISTIO" }}`,
+		`{{ appendMultusNetwork "" "istio-cni" }}`,
+		`{{ appendMultusNetwork "macvlan, istio-cni" "istio-cni" }}`,
+		`{{ appendMultusNetwork "macvlan" "istio-cni" }}`,
+		`{{ appendMultusNetwork (index .ObjectMeta.Annotations "k8s.v1.cni.cncf.io/networks") "istio-cni" }}`,
+		`{{ appendMultusNetwork (index .ObjectMeta.Annotations "k8s.v1.cni.cncf.io/networks") "kube-system/istio-cni" }}`,
+		`{{ appendMultusNetwork "[{\"name\": \"istio-cni\", \"namespace\": \"kube-system\"}]" "kube-system/istio-cni" }}`,
+		`{{ appendMultusNetwork "[invalid" "istio-cni" }}`,
+		`{{ env "INJECTION_TEMPLATE_PARITY_UNSET" "default" }}`,
+		`{{ toJSON (omit .ObjectMeta.Labels "version" "missing") }}`,
+		`{{ toJSON (strdict "a" "1" "b") }}`,
+		`{{ toJsonMap .ObjectMeta.Labels (strdict "app" "ratings") }}`,
+		`{{ toJSON (mergeMaps .ObjectMeta.Labels .ObjectMeta.Annotations) }} {{ mergeMaps }}`,
+	}
+
+	for _, tmpl := range templates {
+		tmpl := tmpl
+		t.Run(tmpl, func(t *testing.T) {
+			t.Parallel()
+
+			// the sidecar injector of Istio adds its functions to the ones of sprig the same way
+			upstream := render(t, template.New("upstream").Funcs(sprig.TxtFuncMap()).Funcs(inject.InjectionFuncmap), tmpl, data)
+			assert.Equal(t, render(t, template.New("operator").Funcs(injectiontemplate.FuncMap()), tmpl, data), upstream)
+		})
+	}
+}
+
+func render(t *testing.T, tmpl *template.Template, text string, data templateData) string {
+	t.Helper()
+
+	tmpl, err := tmpl.Parse(text)
+	assert.NilError(t, err)
+
+	var buf bytes.Buffer
+	assert.NilError(t, tmpl.Execute(&buf, data))
+
+	return buf.String()
+}
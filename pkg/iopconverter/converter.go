@@ -0,0 +1,389 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iopconverter converts upstream IstioOperator (install.istio.io/v1alpha1) manifests into
+// IstioControlPlane and IstioMeshGateway resources.
+package iopconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/golang/protobuf/jsonpb"
+	"istio.io/api/mesh/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+const (
+	IstioOperatorKind       = "IstioOperator"
+	IstioOperatorAPIVersion = "install.istio.io/v1alpha1"
+
+	defaultNamespace = "istio-system"
+	defaultName      = "istio"
+)
+
+var versionTagRegex = regexp.MustCompile(`^1\.[0-9]+(\.[0-9]+)?(-.+)?$`)
+
+// Options of the conversion
+type Options struct {
+	// Namespace of the resources when the IstioOperator does not define one
+	Namespace string
+	// Version of the control plane when it cannot be derived from the tag of the images
+	Version string
+}
+
+// Result holds the resources converted from an IstioOperator
+type Result struct {
+	IstioControlPlane *servicemeshv1alpha1.IstioControlPlane
+	IstioMeshGateways []*servicemeshv1alpha1.IstioMeshGateway
+	// Paths of the IstioOperator fields which have no equivalent in the converted resources
+	UnsupportedFields []string
+}
+
+// Convert converts a single IstioOperator manifest
+func Convert(manifest []byte, opts Options) (*Result, error) {
+	iop := map[string]interface{}{}
+	if err := yaml.Unmarshal(manifest, &iop); err != nil {
+		return nil, errors.WrapIf(err, "could not parse IstioOperator manifest")
+	}
+
+	if kind, _ := iop["kind"].(string); kind != IstioOperatorKind {
+		return nil, errors.NewWithDetails("manifest is not an IstioOperator", "kind", kind)
+	}
+
+	if apiVersion, _ := iop["apiVersion"].(string); apiVersion != IstioOperatorAPIVersion {
+		return nil, errors.NewWithDetails("unsupported IstioOperator API version", "apiVersion", apiVersion)
+	}
+
+	c := &converter{
+		iop:  iop,
+		opts: opts,
+	}
+
+	return c.convert()
+}
+
+type converter struct {
+	iop  map[string]interface{}
+	opts Options
+
+	converted   []string
+	unsupported []string
+}
+
+func (c *converter) convert() (*Result, error) {
+	c.mark("apiVersion", "kind", "metadata.name", "metadata.namespace")
+
+	icp, err := c.convertControlPlane()
+	if err != nil {
+		return nil, err
+	}
+
+	gateways, err := c.convertGateways(icp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		IstioControlPlane: icp,
+		IstioMeshGateways: gateways,
+		UnsupportedFields: c.unsupportedFields(),
+	}, nil
+}
+
+// get returns the value at the given path of the IstioOperator and marks it as converted
+func (c *converter) get(path string) (interface{}, bool) {
+	v, ok := lookup(c.iop, path)
+	if ok {
+		c.converted = append(c.converted, path)
+	}
+
+	return v, ok
+}
+
+func (c *converter) getString(path string) string {
+	v, ok := c.get(path)
+	if !ok || v == nil {
+		return ""
+	}
+
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	return fmt.Sprint(v)
+}
+
+// mark marks the given paths as converted without using their values
+func (c *converter) mark(paths ...string) {
+	c.converted = append(c.converted, paths...)
+}
+
+// reject reports the value at the given path as unsupported
+func (c *converter) reject(path string) {
+	if _, ok := lookup(c.iop, path); ok {
+		c.unsupported = append(c.unsupported, path)
+		c.converted = append(c.converted, path)
+	}
+}
+
+// copy sets the value at the given path of the IstioOperator to the given path of the target,
+// transformed by the optional conversion functions; a conversion function returns false for values which
+// cannot be converted and nil for values which need not be set
+func (c *converter) copy(target map[string]interface{}, to string, from string, convert ...func(interface{}) (interface{}, bool)) {
+	v, ok := lookup(c.iop, from)
+	if !ok || v == nil {
+		return
+	}
+
+	for _, fn := range convert {
+		if v, ok = fn(v); !ok {
+			c.reject(from)
+
+			return
+		}
+	}
+
+	c.converted = append(c.converted, from)
+	if v != nil {
+		set(target, to, v)
+	}
+}
+
+// copyKeys copies the given keys of the object at the given path of the IstioOperator to the object at the given path of the target
+func (c *converter) copyKeys(target map[string]interface{}, to string, from string, keys ...string) {
+	for _, key := range keys {
+		c.copy(target, to+"."+key, from+"."+key)
+	}
+}
+
+// unsupportedFields returns the rejected fields and every field of the IstioOperator which was not converted
+func (c *converter) unsupportedFields() []string {
+	fields := map[string]struct{}{}
+	for _, path := range c.unsupported {
+		fields[path] = struct{}{}
+	}
+
+	for _, path := range leaves(c.iop, "") {
+		if !covered(path, c.converted) {
+			fields[path] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(fields))
+	for path := range fields {
+		result = append(result, path)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func (c *converter) version() (string, error) {
+	for _, path := range []string{"spec.tag", "spec.values.global.tag"} {
+		if tag := c.getString(path); versionTagRegex.MatchString(tag) {
+			return tag, nil
+		}
+	}
+
+	if c.opts.Version != "" {
+		return c.opts.Version, nil
+	}
+
+	return "", errors.New("the Istio version could not be derived from the tag of the images, it must be specified explicitly")
+}
+
+// validMeshConfig splits the given mesh config to the fields which are known by the mesh config of the control plane
+// and to the unknown ones
+func validMeshConfig(meshConfig map[string]interface{}) (map[string]interface{}, []string) {
+	valid := map[string]interface{}{}
+	invalid := []string{}
+
+	for key, value := range meshConfig {
+		j, err := json.Marshal(map[string]interface{}{key: value})
+		if err == nil {
+			err = (&jsonpb.Unmarshaler{}).Unmarshal(strings.NewReader(string(j)), &v1alpha1.MeshConfig{})
+		}
+		if err != nil {
+			invalid = append(invalid, key)
+
+			continue
+		}
+
+		valid[key] = value
+	}
+
+	return valid, invalid
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// decode converts the given generic object to the given typed one
+func decode(from map[string]interface{}, to interface{}) error {
+	j, err := json.Marshal(from)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(json.Unmarshal(j, to))
+}
+
+func lookup(obj interface{}, path string) (interface{}, bool) {
+	current := obj
+	for _, segment := range splitPath(path) {
+		switch s := segment.(type) {
+		case string:
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = m[s]; !ok {
+				return nil, false
+			}
+		case int:
+			l, ok := current.([]interface{})
+			if !ok || s >= len(l) {
+				return nil, false
+			}
+			current = l[s]
+		}
+	}
+
+	return current, true
+}
+
+func set(obj map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	current := obj
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+		current = next
+	}
+
+	current[keys[len(keys)-1]] = value
+}
+
+// splitPath splits paths like a.b[1].c to map keys and list indices
+func splitPath(path string) []interface{} {
+	segments := []interface{}{}
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var indices []interface{}
+		for strings.HasSuffix(key, "]") {
+			i := strings.LastIndex(key, "[")
+			if i < 0 {
+				break
+			}
+			index, err := strconv.Atoi(key[i+1 : len(key)-1])
+			if err != nil {
+				break
+			}
+			indices = append([]interface{}{index}, indices...)
+			key = key[:i]
+		}
+		if key != "" {
+			segments = append(segments, key)
+		}
+		segments = append(segments, indices...)
+	}
+
+	return segments
+}
+
+// leaves returns the paths of the non-empty scalar values of the object
+func leaves(obj interface{}, prefix string) []string {
+	paths := []string{}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range o {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			paths = append(paths, leaves(value, path)...)
+		}
+	case []interface{}:
+		for i, value := range o {
+			paths = append(paths, leaves(value, fmt.Sprintf("%s[%d]", prefix, i))...)
+		}
+	case nil:
+	default:
+		paths = append(paths, prefix)
+	}
+
+	return paths
+}
+
+func covered(path string, converted []string) bool {
+	for _, c := range converted {
+		if path == c || strings.HasPrefix(path, c+".") || strings.HasPrefix(path, c+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// YAML returns the converted resources as a multi-document YAML
+func (r *Result) YAML() ([]byte, error) {
+	objects := []interface{}{r.IstioControlPlane}
+	for _, gateway := range r.IstioMeshGateways {
+		objects = append(objects, gateway)
+	}
+
+	docs := make([]string, 0, len(objects))
+	for _, object := range objects {
+		j, err := json.Marshal(object)
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not marshal resource")
+		}
+
+		m := map[string]interface{}{}
+		if err := json.Unmarshal(j, &m); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// drop the zero creation timestamp and the empty status of the new resources
+		if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		delete(m, "status")
+
+		y, err := yaml.Marshal(m)
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not marshal resource")
+		}
+		docs = append(docs, string(y))
+	}
+
+	return []byte(strings.Join(docs, "---\n")), nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iopconverter_test
+
+import (
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"istio.io/api/mesh/v1alpha1"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/iopconverter"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := os.ReadFile("testdata/istiooperator.yaml")
+	assert.NilError(t, err)
+
+	result, err := iopconverter.Convert(manifest, iopconverter.Options{})
+	assert.NilError(t, err)
+
+	icp := result.IstioControlPlane
+	assert.Equal(t, icp.GetName(), "cp-v117x")
+	assert.Equal(t, icp.GetNamespace(), "istio-system")
+
+	spec := icp.GetSpec()
+	assert.Equal(t, spec.GetVersion(), "1.17.8")
+	assert.Equal(t, spec.GetMode(), servicemeshv1alpha1.ModeType_ACTIVE)
+	assert.Equal(t, spec.GetMeshID(), "mesh1")
+	assert.Equal(t, spec.GetNetworkName(), "network1")
+	assert.Equal(t, spec.GetClusterID(), "cluster1")
+	assert.Equal(t, spec.GetJwtPolicy(), servicemeshv1alpha1.JWTPolicyType_THIRD_PARTY_JWT)
+	assert.Equal(t, spec.GetIstiod().GetCertProvider(), servicemeshv1alpha1.PilotCertProviderType_ISTIOD)
+	assert.Equal(t, spec.GetContainerImageConfiguration().GetHub(), "docker.io/istio")
+	assert.Equal(t, spec.GetContainerImageConfiguration().GetImagePullSecrets()[0].Name, "secret1")
+	assert.Equal(t, spec.GetProxy().GetLogLevel(), servicemeshv1alpha1.ProxyLogLevel_WARNING)
+	assert.Equal(t, spec.GetProxy().GetImage(), "")
+	assert.Equal(t, spec.GetMeshConfig().GetAccessLogFile(), "/dev/stdout")
+	assert.Equal(t, spec.GetMeshConfig().GetDefaultConfig().GetHoldApplicationUntilProxyStarts().GetValue(), true)
+	assert.Equal(t, spec.GetTelemetryV2().GetEnabled().GetValue(), false)
+	assert.Equal(t, spec.GetProxyInit().GetCni().GetEnabled().GetValue(), true)
+	assert.DeepEqual(t, spec.GetProxyInit().GetCni().GetExcludeNamespaces(), []string{"kube-system"})
+	assert.Equal(t, spec.GetSidecarInjector().GetTemplates().GetCustomTemplates()[0].GetName(), "custom")
+
+	deployment := spec.GetIstiod().GetDeployment()
+	assert.Equal(t, deployment.GetReplicas().GetMin().GetValue(), int32(2))
+	assert.Equal(t, deployment.GetReplicas().GetMax().GetValue(), int32(5))
+	assert.Equal(t, deployment.GetResources().GetRequests()["cpu"].String(), "500m")
+	assert.Equal(t, len(deployment.GetEnv()), 2)
+	assert.Equal(t, deployment.GetEnv()[0].Name, "PILOT_X")
+	assert.Equal(t, deployment.GetEnv()[0].Value, "true")
+
+	assert.Equal(t, len(result.IstioMeshGateways), 2)
+
+	ingress := result.IstioMeshGateways[0]
+	assert.Equal(t, ingress.GetName(), "istio-ingressgateway")
+	assert.Equal(t, ingress.GetNamespace(), "istio-system")
+	assert.Equal(t, ingress.GetSpec().GetType(), servicemeshv1alpha1.GatewayType_ingress)
+	assert.Equal(t, ingress.GetSpec().GetIstioControlPlane().GetName(), "cp-v117x")
+	assert.Equal(t, ingress.GetSpec().GetService().GetType(), "NodePort")
+	assert.Equal(t, ingress.GetSpec().GetService().GetMetadata().GetAnnotations()["a"], "b")
+	assert.Equal(t, len(ingress.GetSpec().GetService().GetPorts()), 1)
+	assert.Equal(t, ingress.GetSpec().GetService().GetPorts()[0].GetNodePort(), int32(31380))
+	assert.Equal(t, ingress.GetSpec().GetDeployment().GetPodMetadata().GetLabels()["app"], "istio-ingressgateway")
+
+	egress := result.IstioMeshGateways[1]
+	assert.Equal(t, egress.GetName(), "istio-egressgateway")
+	assert.Equal(t, egress.GetNamespace(), "egress")
+	assert.Equal(t, egress.GetSpec().GetType(), servicemeshv1alpha1.GatewayType_egress)
+	assert.Equal(t, egress.GetSpec().GetService().GetType(), "ClusterIP")
+	assert.Equal(t, len(egress.GetSpec().GetService().GetPorts()), 2)
+
+	assert.DeepEqual(t, result.UnsupportedFields, []string{
+		"spec.components.pilot.k8s.overlays[0].kind",
+		"spec.components.pilot.k8s.overlays[0].name",
+		"spec.meshConfig.notAField",
+		"spec.values.global.defaultPodDisruptionBudget.enabled",
+	})
+
+	_, err = result.YAML()
+	assert.NilError(t, err)
+}
+
+func TestConvertDefaults(t *testing.T) {
+	t.Parallel()
+
+	manifest := []byte(`
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  tag: latest
+  components:
+    pilot:
+      enabled: false
+  meshConfig:
+    outboundTrafficPolicy:
+      mode: REGISTRY_ONLY
+`)
+
+	_, err := iopconverter.Convert(manifest, iopconverter.Options{})
+	assert.ErrorContains(t, err, "version")
+
+	result, err := iopconverter.Convert(manifest, iopconverter.Options{Namespace: "mesh", Version: "1.17"})
+	assert.NilError(t, err)
+
+	icp := result.IstioControlPlane
+	assert.Equal(t, icp.GetName(), "istio")
+	assert.Equal(t, icp.GetNamespace(), "mesh")
+	assert.Equal(t, icp.GetSpec().GetVersion(), "1.17")
+	assert.Equal(t, icp.GetSpec().GetMode(), servicemeshv1alpha1.ModeType_PASSIVE)
+	assert.Equal(t, icp.GetSpec().GetMeshConfig().GetOutboundTrafficPolicy().GetMode(), v1alpha1.MeshConfig_OutboundTrafficPolicy_REGISTRY_ONLY)
+
+	assert.Equal(t, len(result.IstioMeshGateways), 1)
+	assert.Equal(t, result.IstioMeshGateways[0].GetName(), "istio-ingressgateway")
+	assert.Equal(t, result.IstioMeshGateways[0].GetNamespace(), "mesh")
+	assert.Equal(t, len(result.IstioMeshGateways[0].GetSpec().GetService().GetPorts()), 3)
+	assert.Equal(t, len(result.UnsupportedFields), 0)
+}
+
+func TestConvertInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := iopconverter.Convert([]byte("apiVersion: v1\nkind: ConfigMap\n"), iopconverter.Options{})
+	assert.ErrorContains(t, err, "not an IstioOperator")
+
+	_, err = iopconverter.Convert([]byte("apiVersion: install.istio.io/v1beta1\nkind: IstioOperator\n"), iopconverter.Options{})
+	assert.ErrorContains(t, err, "API version")
+}
@@ -0,0 +1,493 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iopconverter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+var (
+	jwtPolicies = map[string]string{
+		"third-party-jwt": servicemeshv1alpha1.JWTPolicyType_THIRD_PARTY_JWT.String(),
+		"first-party-jwt": servicemeshv1alpha1.JWTPolicyType_FIRST_PARTY_JWT.String(),
+	}
+	pilotCertProviders = map[string]string{
+		"istiod":     servicemeshv1alpha1.PilotCertProviderType_ISTIOD.String(),
+		"kubernetes": servicemeshv1alpha1.PilotCertProviderType_KUBERNETES.String(),
+	}
+	proxyLogLevels = map[string]string{
+		"trace":    servicemeshv1alpha1.ProxyLogLevel_TRACE.String(),
+		"debug":    servicemeshv1alpha1.ProxyLogLevel_DEBUG.String(),
+		"info":     servicemeshv1alpha1.ProxyLogLevel_INFO.String(),
+		"warning":  servicemeshv1alpha1.ProxyLogLevel_WARNING.String(),
+		"error":    servicemeshv1alpha1.ProxyLogLevel_ERROR.String(),
+		"critical": servicemeshv1alpha1.ProxyLogLevel_CRITICAL.String(),
+		"off":      servicemeshv1alpha1.ProxyLogLevel_OFF.String(),
+	}
+
+	// profiles whose gateways and addons are either converted or not installed at all
+	supportedProfiles = map[string]bool{
+		"":        true,
+		"default": true,
+		"minimal": true,
+	}
+)
+
+func (c *converter) convertControlPlane() (*servicemeshv1alpha1.IstioControlPlane, error) {
+	version, err := c.version()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]interface{}{
+		"version": version,
+		"mode":    servicemeshv1alpha1.ModeType_ACTIVE.String(),
+	}
+
+	if enabled, ok := c.get("spec.components.pilot.enabled"); ok && enabled == false {
+		spec["mode"] = servicemeshv1alpha1.ModeType_PASSIVE.String()
+	}
+
+	if profile, _ := lookup(c.iop, "spec.profile"); !supportedProfiles[fmt.Sprint(profile)] {
+		c.reject("spec.profile")
+	} else {
+		c.mark("spec.profile")
+	}
+
+	c.copy(spec, "containerImageConfiguration.hub", "spec.values.global.hub")
+	c.copy(spec, "containerImageConfiguration.tag", "spec.values.global.tag", str)
+	c.copy(spec, "containerImageConfiguration.hub", "spec.hub")
+	c.copy(spec, "containerImageConfiguration.tag", "spec.tag", str)
+	c.copy(spec, "containerImageConfiguration.imagePullPolicy", "spec.values.global.imagePullPolicy")
+	c.copy(spec, "containerImageConfiguration.imagePullSecrets", "spec.values.global.imagePullSecrets", localObjectReferences)
+
+	c.convertMeshConfig(spec)
+	c.convertGlobalValues(spec)
+	c.convertPilot(spec)
+	c.convertCNI(spec)
+
+	c.copy(spec, "telemetryV2.enabled", "spec.values.telemetry.v2.enabled")
+
+	if templates, ok := c.get("spec.values.sidecarInjectorWebhook.templates"); ok {
+		if customTemplates := namedList(templates, "template"); len(customTemplates) > 0 {
+			set(spec, "sidecarInjector.templates.customTemplates", customTemplates)
+		}
+	}
+
+	// components which are installed together with the control plane
+	c.mark("spec.components.base.enabled")
+	if enabled, _ := lookup(c.iop, "spec.components.istiodRemote.enabled"); enabled == false {
+		c.mark("spec.components.istiodRemote.enabled")
+	}
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{
+		ObjectMeta: objectMeta(c.controlPlaneName(), c.namespace()),
+		Spec:       &servicemeshv1alpha1.IstioControlPlaneSpec{},
+	}
+	icp.APIVersion = servicemeshv1alpha1.GroupVersion.String()
+	icp.Kind = "IstioControlPlane"
+
+	if err := decode(spec, icp.Spec); err != nil {
+		return nil, errors.WrapIf(err, "could not convert IstioOperator to IstioControlPlane")
+	}
+
+	return icp, nil
+}
+
+func (c *converter) defaultProfile() bool {
+	profile, _ := lookup(c.iop, "spec.profile")
+
+	return profile == nil || profile == "default"
+}
+
+func (c *converter) controlPlaneName() string {
+	for _, path := range []string{"spec.revision", "metadata.name"} {
+		if name := c.getString(path); name != "" {
+			return name
+		}
+	}
+
+	return defaultName
+}
+
+func (c *converter) namespace() string {
+	for _, path := range []string{"spec.namespace", "spec.values.global.istioNamespace", "metadata.namespace"} {
+		if namespace := c.getString(path); namespace != "" {
+			return namespace
+		}
+	}
+
+	if c.opts.Namespace != "" {
+		return c.opts.Namespace
+	}
+
+	return defaultNamespace
+}
+
+func (c *converter) convertMeshConfig(spec map[string]interface{}) {
+	meshConfig := map[string]interface{}{}
+
+	// the meshConfig field of the spec takes precedence over the legacy values.meshConfig
+	for _, from := range []string{"spec.values.meshConfig", "spec.meshConfig"} {
+		v, _ := lookup(c.iop, from)
+		config, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		valid, invalid := validMeshConfig(config)
+		for _, key := range invalid {
+			c.reject(from + "." + key)
+		}
+		for key, value := range valid {
+			c.mark(from + "." + key)
+			meshConfig[key] = value
+		}
+	}
+
+	if len(meshConfig) > 0 {
+		spec["meshConfig"] = meshConfig
+	}
+}
+
+func (c *converter) convertGlobalValues(spec map[string]interface{}) {
+	const global = "spec.values.global"
+
+	c.copy(spec, "meshID", global+".meshID")
+	c.copy(spec, "networkName", global+".network")
+	c.copy(spec, "clusterID", global+".multiCluster.clusterName")
+	c.copy(spec, "logging.level", global+".logging.level")
+	c.copy(spec, "jwtPolicy", global+".jwtPolicy", enum(jwtPolicies))
+	c.copy(spec, "istiod.certProvider", global+".pilotCertProvider", enum(pilotCertProviders))
+	c.copy(spec, "caAddress", global+".caAddress")
+	c.copy(spec, "mountMtlsCerts", global+".mountMtlsCerts")
+	c.copy(spec, "watchOneNamespace", global+".oneNamespace")
+	c.copy(spec, "sds.tokenAudience", global+".sds.token.aud")
+	c.copy(spec, "istiod.enableAnalysis", global+".istiod.enableAnalysis")
+	c.copy(spec, "istiod.deployment.priorityClassName", global+".priorityClassName")
+
+	c.copyKeys(spec, "proxy", global+".proxy",
+		"privileged",
+		"enableCoreDump",
+		"componentLogLevel",
+		"clusterDomain",
+		"holdApplicationUntilProxyStarts",
+		"lifecycle",
+		"resources",
+		"includeIPRanges",
+		"excludeIPRanges",
+		"excludeInboundPorts",
+		"excludeOutboundPorts",
+		"tracer",
+	)
+	c.copy(spec, "proxy.image", global+".proxy.image", image("proxyv2"))
+	c.copy(spec, "proxy.logLevel", global+".proxy.logLevel", enum(proxyLogLevels))
+
+	c.copy(spec, "proxyInit.image", global+".proxy_init.image", image("proxyv2"))
+	c.copy(spec, "proxyInit.resources", global+".proxy_init.resources")
+}
+
+func (c *converter) convertPilot(spec map[string]interface{}) {
+	const pilot = "spec.values.pilot"
+
+	c.copy(spec, "istiod.enableProtocolSniffingOutbound", pilot+".enableProtocolSniffingForOutbound")
+	c.copy(spec, "istiod.enableProtocolSniffingInbound", pilot+".enableProtocolSniffingForInbound")
+
+	c.copy(spec, "istiod.deployment.image", pilot+".image", image("pilot"))
+	c.copy(spec, "istiod.deployment.replicas.count", pilot+".replicaCount")
+	c.copy(spec, "istiod.deployment.replicas.min", pilot+".autoscaleMin")
+	c.copy(spec, "istiod.deployment.replicas.max", pilot+".autoscaleMax")
+	c.copy(spec, "istiod.deployment.replicas.targetCPUUtilizationPercentage", pilot+".cpu.targetAverageUtilization")
+	c.copy(spec, "istiod.deployment.resources", pilot+".resources")
+	c.copy(spec, "istiod.deployment.nodeSelector", pilot+".nodeSelector")
+	c.copy(spec, "istiod.deployment.tolerations", pilot+".tolerations")
+	c.copy(spec, "istiod.deployment.podMetadata.annotations", pilot+".podAnnotations")
+	c.copy(spec, "istiod.deployment.podMetadata.labels", pilot+".podLabels")
+
+	if env, ok := c.get(pilot + ".env"); ok {
+		if vars := namedList(env, "value"); len(vars) > 0 {
+			set(spec, "istiod.deployment.env", vars)
+		}
+	}
+
+	c.convertKubernetesResources(spec, "istiod.deployment", "spec.components.pilot.k8s")
+}
+
+func (c *converter) convertCNI(spec map[string]interface{}) {
+	const cni = "spec.values.cni"
+
+	c.copy(spec, "proxyInit.cni.enabled", "spec.components.cni.enabled")
+	c.copy(spec, "proxyInit.cni.chained", cni+".chained")
+	c.copy(spec, "proxyInit.cni.binDir", cni+".cniBinDir")
+	c.copy(spec, "proxyInit.cni.confDir", cni+".cniConfDir")
+	c.copy(spec, "proxyInit.cni.confFileName", cni+".cniConfFileName")
+	c.copy(spec, "proxyInit.cni.excludeNamespaces", cni+".excludeNamespaces")
+	c.copy(spec, "proxyInit.cni.includeNamespaces", cni+".includeNamespaces")
+	c.copy(spec, "proxyInit.cni.logLevel", cni+".logLevel")
+	c.copy(spec, "proxyInit.cni.pspClusterRoleName", cni+".psp_cluster_role")
+	c.copyKeys(spec, "proxyInit.cni.repair", cni+".repair",
+		"enabled",
+		"labelPods",
+		"deletePods",
+		"initContainerName",
+		"brokenPodLabelKey",
+		"brokenPodLabelValue",
+	)
+	c.copyKeys(spec, "proxyInit.cni.resourceQuotas", cni+".resourceQuotas", "enabled", "pods")
+	c.copy(spec, "proxyInit.cni.daemonset.image", cni+".image", image("install-cni"))
+
+	c.convertKubernetesResources(spec, "proxyInit.cni.daemonset", "spec.components.cni.k8s")
+}
+
+// convertKubernetesResources converts a KubernetesResourcesSpec of an IstioOperator component to a BaseKubernetesResourceConfig
+func (c *converter) convertKubernetesResources(target map[string]interface{}, to string, from string) {
+	c.copyKeys(target, to, from,
+		"affinity",
+		"imagePullPolicy",
+		"nodeSelector",
+		"priorityClassName",
+		"tolerations",
+		"resources",
+		"readinessProbe",
+	)
+	c.copy(target, to+".replicas.count", from+".replicaCount")
+	c.copy(target, to+".replicas.min", from+".hpaSpec.minReplicas")
+	c.copy(target, to+".replicas.max", from+".hpaSpec.maxReplicas")
+	c.copy(target, to+".podMetadata.annotations", from+".podAnnotations")
+	c.copy(target, to+".podSecurityContext", from+".securityContext")
+	c.copyKeys(target, to+".podDisruptionBudget", from+".podDisruptionBudget", "minAvailable", "maxUnavailable")
+	c.copyKeys(target, to+".deploymentStrategy", from+".strategy", "type", "rollingUpdate.maxSurge", "rollingUpdate.maxUnavailable")
+
+	if env, ok := c.get(from + ".env"); ok {
+		vars, _ := env.([]interface{})
+		existing, _ := lookup(target, to+".env")
+		if existing, ok := existing.([]interface{}); ok {
+			vars = append(existing, vars...)
+		}
+		set(target, to+".env", vars)
+	}
+}
+
+func (c *converter) convertGateways(icp *servicemeshv1alpha1.IstioControlPlane) ([]*servicemeshv1alpha1.IstioMeshGateway, error) {
+	gateways := []*servicemeshv1alpha1.IstioMeshGateway{}
+
+	for _, gatewayType := range []servicemeshv1alpha1.GatewayType{servicemeshv1alpha1.GatewayType_ingress, servicemeshv1alpha1.GatewayType_egress} {
+		path := fmt.Sprintf("spec.components.%sGateways", gatewayType.String())
+		v, _ := lookup(c.iop, path)
+		components, _ := v.([]interface{})
+
+		// the default profile installs an ingress gateway unless the IstioOperator lists them explicitly
+		if gatewayType == servicemeshv1alpha1.GatewayType_ingress && len(components) == 0 && c.defaultProfile() {
+			gateway, err := c.convertGateway(icp, gatewayType, path+"[0]")
+			if err != nil {
+				return nil, err
+			}
+
+			gateways = append(gateways, gateway)
+		}
+
+		for i := range components {
+			from := fmt.Sprintf("%s[%d]", path, i)
+			if enabled, _ := lookup(c.iop, from+".enabled"); enabled != true {
+				// disabled gateways are not installed, their settings are irrelevant
+				c.mark(from)
+
+				continue
+			}
+
+			gateway, err := c.convertGateway(icp, gatewayType, from)
+			if err != nil {
+				return nil, err
+			}
+
+			gateways = append(gateways, gateway)
+		}
+	}
+
+	return gateways, nil
+}
+
+func (c *converter) convertGateway(icp *servicemeshv1alpha1.IstioControlPlane, gatewayType servicemeshv1alpha1.GatewayType, from string) (*servicemeshv1alpha1.IstioMeshGateway, error) {
+	c.mark(from + ".enabled")
+
+	name := c.getString(from + ".name")
+	if name == "" {
+		name = fmt.Sprintf("istio-%sgateway", gatewayType.String())
+	}
+
+	namespace := c.getString(from + ".namespace")
+	if namespace == "" {
+		namespace = icp.GetNamespace()
+	}
+
+	spec := map[string]interface{}{
+		"type": gatewayType.String(),
+		"istioControlPlane": map[string]interface{}{
+			"name":      icp.GetName(),
+			"namespace": icp.GetNamespace(),
+		},
+	}
+
+	c.copy(spec, "deployment.podMetadata.labels", from+".label")
+	c.convertKubernetesResources(spec, "deployment", from+".k8s")
+
+	c.copyKeys(spec, "service", from+".k8s.service",
+		"type",
+		"clusterIP",
+		"externalIPs",
+		"sessionAffinity",
+		"loadBalancerIP",
+		"loadBalancerSourceRanges",
+		"externalName",
+		"externalTrafficPolicy",
+		"healthCheckNodePort",
+	)
+	c.copy(spec, "service.metadata.annotations", from+".k8s.serviceAnnotations")
+
+	ports := []interface{}{}
+	v, _ := lookup(c.iop, from+".k8s.service.ports")
+	items, _ := v.([]interface{})
+	for i := range items {
+		port := map[string]interface{}{}
+		for _, key := range []string{"name", "protocol", "port", "targetPort", "nodePort"} {
+			c.copy(port, key, fmt.Sprintf("%s.k8s.service.ports[%d].%s", from, i, key))
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 0 {
+		ports = defaultGatewayPorts(gatewayType)
+	}
+	set(spec, "service.ports", ports)
+
+	if _, ok := lookup(spec, "service.type"); !ok {
+		serviceType := "LoadBalancer"
+		if gatewayType == servicemeshv1alpha1.GatewayType_egress {
+			serviceType = "ClusterIP"
+		}
+		set(spec, "service.type", serviceType)
+	}
+
+	gateway := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: objectMeta(name, namespace),
+		Spec:       &servicemeshv1alpha1.IstioMeshGatewaySpec{},
+	}
+	gateway.APIVersion = servicemeshv1alpha1.GroupVersion.String()
+	gateway.Kind = "IstioMeshGateway"
+
+	if err := decode(spec, gateway.Spec); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not convert IstioOperator gateway to IstioMeshGateway", "name", name)
+	}
+
+	return gateway, nil
+}
+
+// defaultGatewayPorts returns the service ports of the upstream gateways
+func defaultGatewayPorts(gatewayType servicemeshv1alpha1.GatewayType) []interface{} {
+	ports := []interface{}{
+		servicePort("http2", 80, 8080),
+		servicePort("https", 443, 8443),
+	}
+
+	if gatewayType == servicemeshv1alpha1.GatewayType_ingress {
+		ports = append([]interface{}{servicePort("status-port", 15021, 15021)}, ports...)
+	}
+
+	return ports
+}
+
+func servicePort(name string, port, targetPort int) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       name,
+		"protocol":   "TCP",
+		"port":       port,
+		"targetPort": targetPort,
+	}
+}
+
+// enum returns a conversion function which maps the IstioOperator value to an enum value
+func enum(values map[string]string) func(interface{}) (interface{}, bool) {
+	return func(v interface{}) (interface{}, bool) {
+		value, ok := values[strings.ToLower(fmt.Sprint(v))]
+
+		return value, ok
+	}
+}
+
+// image returns a conversion function which accepts full image references and the default image name
+// which is resolved from the hub and tag of the control plane
+func image(defaultName string) func(interface{}) (interface{}, bool) {
+	return func(v interface{}) (interface{}, bool) {
+		image := fmt.Sprint(v)
+		switch {
+		case strings.Contains(image, "/"):
+			return image, true
+		case image == defaultName:
+			return nil, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// str converts scalar values to string, eg. image tags which are parsed as numbers
+func str(v interface{}) (interface{}, bool) {
+	return fmt.Sprint(v), true
+}
+
+func localObjectReferences(v interface{}) (interface{}, bool) {
+	names, ok := v.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	references := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		references = append(references, map[string]interface{}{"name": name})
+	}
+
+	return references, true
+}
+
+// namedList converts a map to a list of name and value pairs ordered by name
+func namedList(v interface{}, valueKey string) []interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		list = append(list, map[string]interface{}{
+			"name":   name,
+			valueKey: fmt.Sprint(m[name]),
+		})
+	}
+
+	return list
+}
@@ -35,12 +35,13 @@ func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, i
 		return istiodEndpointAddresses, errors.WithStackIf(err)
 	}
 
+	seen := make(map[string]struct{})
 	for _, picp := range picpList.Items {
-		if picp.GetStatus().IstioControlPlaneName != icpName || picp.Spec.GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		if picp.GetStatus().IstioControlPlaneName != icpName || picp.GetResolvedSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
 			continue
 		}
 
-		if picp.Spec.GetNetworkName() == icpNetworkName {
+		if picp.GetNetworkName() == icpNetworkName {
 			for _, address := range picp.GetStatus().IstiodAddresses {
 				istiodEndpointAddresses = append(istiodEndpointAddresses,
 					corev1.EndpointAddress{
@@ -48,11 +49,18 @@ func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, i
 					})
 			}
 		} else {
-			for _, address := range picp.GetStatus().GatewayAddress {
-				istiodEndpointAddresses = append(istiodEndpointAddresses,
-					corev1.EndpointAddress{
-						IP: address,
-					})
+			// istiod on other networks is only reachable through the gateways exposing it
+			for _, gw := range picp.GetStatus().IstiodGateways() {
+				for _, address := range gw.GetGatewayAddress() {
+					if _, ok := seen[address]; ok {
+						continue
+					}
+					seen[address] = struct{}{}
+					istiodEndpointAddresses = append(istiodEndpointAddresses,
+						corev1.EndpointAddress{
+							IP: address,
+						})
+				}
 			}
 		}
 	}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil_test
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+)
+
+func TestGetIstiodEndpointAddresses(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, servicemeshv1alpha1.AddToScheme(scheme))
+
+	peer := func(name, network string, status *servicemeshv1alpha1.IstioControlPlaneStatus) *servicemeshv1alpha1.PeerIstioControlPlane {
+		status.IstioControlPlaneName = "cp-v117x"
+
+		return &servicemeshv1alpha1.PeerIstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "istio-system",
+			},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				Mode:        servicemeshv1alpha1.ModeType_ACTIVE,
+				NetworkName: network,
+			},
+			Status: status,
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		peer("same-network", "network1", &servicemeshv1alpha1.IstioControlPlaneStatus{
+			IstiodAddresses: []string{"10.0.0.10"},
+			GatewayAddress:  []string{"10.0.0.1"},
+		}),
+		peer("other-network", "network2", &servicemeshv1alpha1.IstioControlPlaneStatus{
+			IstiodAddresses: []string{"10.1.0.10"},
+			GatewayAddress:  []string{"10.1.0.1", "10.1.0.2", "10.1.0.3"},
+			MeshExpansionGateways: []*servicemeshv1alpha1.MeshExpansionGatewayStatus{
+				{
+					Name:           "istio-meshexpansion-cp-v117x",
+					Status:         servicemeshv1alpha1.ConfigState_Available,
+					GatewayAddress: []string{"10.1.0.1"},
+					ExposeIstiod:   true,
+				},
+				{
+					Name:                  "istio-meshexpansion-services-cp-v117x",
+					Status:                servicemeshv1alpha1.ConfigState_Available,
+					GatewayAddress:        []string{"10.1.0.2"},
+					ExposeClusterServices: true,
+				},
+				{
+					Name:           "istio-meshexpansion-zone-cp-v117x",
+					Status:         servicemeshv1alpha1.ConfigState_ReconcileFailed,
+					GatewayAddress: []string{"10.1.0.3"},
+					ExposeIstiod:   true,
+				},
+			},
+		}),
+		peer("legacy-network", "network3", &servicemeshv1alpha1.IstioControlPlaneStatus{
+			GatewayAddress: []string{"10.2.0.1"},
+		}),
+	).Build()
+
+	addresses, err := k8sutil.GetIstiodEndpointAddresses(context.Background(), c, "cp-v117x", "network1", "istio-system")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, addresses, []corev1.EndpointAddress{
+		{IP: "10.2.0.1"},
+		{IP: "10.1.0.1"},
+		{IP: "10.0.0.10"},
+	})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetRegionOfNodes returns the most common region of the nodes based on the well-known topology label
+func GetRegionOfNodes(ctx context.Context, kubeClient client.Client) (string, error) {
+	nodes := &corev1.NodeList{}
+	err := kubeClient.List(ctx, nodes)
+	if err != nil {
+		return "", errors.WrapIf(err, "could not list nodes")
+	}
+
+	counts := make(map[string]int)
+	for _, node := range nodes.Items {
+		if region := node.GetLabels()[corev1.LabelTopologyRegion]; region != "" {
+			counts[region]++
+		}
+	}
+
+	regions := make([]string, 0, len(counts))
+	for region := range counts {
+		regions = append(regions, region)
+	}
+
+	if len(regions) == 0 {
+		return "", nil
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		if counts[regions[i]] == counts[regions[j]] {
+			return regions[i] < regions[j]
+		}
+
+		return counts[regions[i]] > counts[regions[j]]
+	})
+
+	return regions[0], nil
+}
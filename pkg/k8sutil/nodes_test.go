@@ -0,0 +1,97 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+)
+
+func TestGetRegionOfNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := func(regions ...string) []client.Object {
+		objects := make([]client.Object, 0, len(regions))
+		for i, region := range regions {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("node-%d", i),
+				},
+			}
+			if region != "" {
+				node.Labels = map[string]string{
+					corev1.LabelTopologyRegion: region,
+				}
+			}
+			objects = append(objects, node)
+		}
+
+		return objects
+	}
+
+	testCases := []struct {
+		name   string
+		nodes  []client.Object
+		region string
+	}{
+		{
+			name: "no nodes",
+		},
+		{
+			name:  "no region labels",
+			nodes: nodes("", ""),
+		},
+		{
+			name:   "single region",
+			nodes:  nodes("us-east-1", "us-east-1", ""),
+			region: "us-east-1",
+		},
+		{
+			name:   "most common region",
+			nodes:  nodes("eu-west-1", "us-east-1", "us-east-1"),
+			region: "us-east-1",
+		},
+		{
+			name:   "ties are broken by name",
+			nodes:  nodes("us-east-1", "eu-west-1"),
+			region: "eu-west-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(tc.nodes...).Build()
+
+			region, err := k8sutil.GetRegionOfNodes(context.Background(), c)
+			assert.NilError(t, err)
+			assert.Equal(t, region, tc.region)
+		})
+	}
+}
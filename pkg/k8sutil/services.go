@@ -40,53 +40,58 @@ func (e IngressSetupPendingError) Error() string {
 }
 
 func GetServiceEndpointIPs(service corev1.Service) ([]string, bool, error) {
+	ips, hostnames, err := GetServiceEndpointAddresses(service)
+
+	return ips, len(hostnames) > 0, err
+}
+
+// GetServiceEndpointAddresses returns the IP addresses and the hostnames of the service endpoint.
+// Hostnames are resolved, so the returned IP addresses contain the current addresses of the hostnames as well.
+func GetServiceEndpointAddresses(service corev1.Service) ([]string, []string, error) {
 	ips := make([]string, 0)
+	hostnames := make([]string, 0)
 
 	// check whether the load balancer was assigned
 	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) < 1 {
-		return nil, false, IngressSetupPendingError{}
+		return nil, nil, IngressSetupPendingError{}
 	}
 
 	// ip address is overridden by annotation
 	if overriddenIPAddress, ok := service.GetAnnotations()[serviceIPAddressOverrideAnnotation]; ok && overriddenIPAddress != "" {
-		return []string{overriddenIPAddress}, false, nil
+		return []string{overriddenIPAddress}, hostnames, nil
 	}
 
 	// hostname is overridden by annotation
 	if overriddenHostname, ok := service.GetAnnotations()[serviceHostnameOverrideAnnotation]; ok && overriddenHostname != "" {
-		hostIPs, err := getIPsForHostname(overriddenHostname)
-		if err != nil {
-			return nil, true, err
-		}
-		ips = append(ips, hostIPs...)
-
-		return ips, true, nil
-	}
-
-	switch service.Spec.Type {
-	case corev1.ServiceTypeClusterIP:
-		if service.Spec.ClusterIP != corev1.ClusterIPNone {
-			ips = []string{
-				service.Spec.ClusterIP,
-			}
-		}
-	case corev1.ServiceTypeLoadBalancer:
-		if service.Status.LoadBalancer.Ingress[0].IP != "" {
-			ips = []string{
-				service.Status.LoadBalancer.Ingress[0].IP,
+		hostnames = append(hostnames, overriddenHostname)
+	} else {
+		switch service.Spec.Type {
+		case corev1.ServiceTypeClusterIP:
+			if service.Spec.ClusterIP != corev1.ClusterIPNone {
+				ips = []string{
+					service.Spec.ClusterIP,
+				}
 			}
-		} else if service.Status.LoadBalancer.Ingress[0].Hostname != "" {
-			hostIPs, err := getIPsForHostname(service.Status.LoadBalancer.Ingress[0].Hostname)
-			if err != nil {
-				return nil, true, err
+		case corev1.ServiceTypeLoadBalancer:
+			if service.Status.LoadBalancer.Ingress[0].IP != "" {
+				ips = []string{
+					service.Status.LoadBalancer.Ingress[0].IP,
+				}
+			} else if service.Status.LoadBalancer.Ingress[0].Hostname != "" {
+				hostnames = append(hostnames, service.Status.LoadBalancer.Ingress[0].Hostname)
 			}
-			ips = append(ips, hostIPs...)
+		}
+	}
 
-			return ips, true, nil
+	for _, hostname := range hostnames {
+		hostIPs, err := getIPsForHostname(hostname)
+		if err != nil {
+			return nil, hostnames, err
 		}
+		ips = append(ips, hostIPs...)
 	}
 
-	return ips, false, nil
+	return ips, hostnames, nil
 }
 
 func getIPsForHostname(hostname string) ([]string, error) {
@@ -0,0 +1,262 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// FieldManager is the name the operator applies the managed resources with in server-side apply mode
+const FieldManager = "istio-operator"
+
+// CoOwnedField is a field of the managed resources which is only set by the operator on creation, since another
+// controller takes it over afterwards
+type CoOwnedField struct {
+	GroupKind schema.GroupKind
+	// Path of the field, the field is selected in every item of the lists on the path
+	Path []string
+}
+
+// WebhookFailurePolicyField is the failure policy of the validating webhooks, which is created as Ignore and
+// switched to Fail by istiod once its webhook endpoint is ready
+var WebhookFailurePolicyField = CoOwnedField{
+	GroupKind: admissionregistrationv1.SchemeGroupVersion.WithKind("ValidatingWebhookConfiguration").GroupKind(),
+	Path:      []string{"webhooks", "failurePolicy"},
+}
+
+// ServerSideApplyClient turns the creates and updates of the wrapped client into server-side applies, so the fields
+// set by the operator are tracked separately from the ones set by other controllers. Conflicting fields are not
+// forced, the apply fails instead with an error listing the conflicts.
+// The co-owned fields are left out of the applies, on creation they are handed over to a separate field manager
+// so the other controllers can take them over without conflicts.
+type ServerSideApplyClient struct {
+	client.Client
+
+	fieldManager  string
+	coOwnedFields []CoOwnedField
+}
+
+func NewServerSideApplyClient(c client.Client, fieldManager string, coOwnedFields ...CoOwnedField) *ServerSideApplyClient {
+	return &ServerSideApplyClient{
+		Client:        c,
+		fieldManager:  fieldManager,
+		coOwnedFields: coOwnedFields,
+	}
+}
+
+// InitialFieldManager returns the name of the field manager which owns the co-owned fields after the creation of the resources
+func (c *ServerSideApplyClient) InitialFieldManager() string {
+	return c.fieldManager + "-initial"
+}
+
+func (c *ServerSideApplyClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	createOpts := &client.CreateOptions{}
+	createOpts.ApplyOptions(opts)
+
+	return c.apply(ctx, obj, createOpts.DryRun, true)
+}
+
+func (c *ServerSideApplyClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	updateOpts := &client.UpdateOptions{}
+	updateOpts.ApplyOptions(opts)
+
+	return c.apply(ctx, obj, updateOpts.DryRun, false)
+}
+
+func (c *ServerSideApplyClient) apply(ctx context.Context, obj client.Object, dryRun []string, create bool) error {
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+		if err != nil {
+			return errors.WrapIf(err, "could not determine the kind of the applied object")
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	// apply configurations must not contain these, the server tracks them on its own
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	var paths [][]string
+	for _, field := range c.coOwnedFields {
+		if field.GroupKind == obj.GetObjectKind().GroupVersionKind().GroupKind() {
+			paths = append(paths, field.Path)
+		}
+	}
+
+	if len(paths) == 0 {
+		return c.patch(ctx, obj, c.fieldManager, dryRun)
+	}
+
+	payload, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.WrapIf(err, "could not convert the applied object")
+	}
+
+	if create && len(dryRun) == 0 {
+		// the resource is created with the co-owned fields, then their ownership is moved to the initial field manager
+		if err := c.patch(ctx, &unstructured.Unstructured{Object: runtime.DeepCopyJSON(payload)}, c.fieldManager, nil); err != nil {
+			return err
+		}
+
+		coOwned := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		for _, path := range paths {
+			mergeFields(coOwned.Object, extractField(payload, path))
+		}
+		coOwned.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		coOwned.SetName(obj.GetName())
+		coOwned.SetNamespace(obj.GetNamespace())
+
+		if err := c.patch(ctx, coOwned, c.InitialFieldManager(), nil); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range paths {
+		removeField(payload, path)
+	}
+
+	applied := &unstructured.Unstructured{Object: payload}
+	if err := c.patch(ctx, applied, c.fieldManager, dryRun); err != nil {
+		return err
+	}
+
+	return errors.WrapIf(runtime.DefaultUnstructuredConverter.FromUnstructured(applied.Object, obj), "could not convert the applied object")
+}
+
+func (c *ServerSideApplyClient) patch(ctx context.Context, obj client.Object, fieldManager string, dryRun []string) error {
+	opts := []client.PatchOption{client.FieldOwner(fieldManager)}
+	if len(dryRun) > 0 {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	err := c.Patch(ctx, obj, client.Apply, opts...)
+	if apierrors.IsConflict(err) {
+		return errors.WrapIfWithDetails(err, "server-side apply conflict, the fields are managed by other controllers",
+			"kind", obj.GetObjectKind().GroupVersionKind().Kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+	}
+
+	return err
+}
+
+// removeField removes the field at the path from the object
+func removeField(obj map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(obj, path[0])
+
+		return
+	}
+
+	switch value := obj[path[0]].(type) {
+	case map[string]interface{}:
+		removeField(value, path[1:])
+	case []interface{}:
+		for _, item := range value {
+			if item, ok := item.(map[string]interface{}); ok {
+				removeField(item, path[1:])
+			}
+		}
+	}
+}
+
+// extractField returns the part of the object which holds the field at the path, the items of the lists keep
+// their names so the server can merge them with the existing ones
+func extractField(obj map[string]interface{}, path []string) map[string]interface{} {
+	value, ok := obj[path[0]]
+	if !ok {
+		return nil
+	}
+
+	if len(path) == 1 {
+		return map[string]interface{}{path[0]: runtime.DeepCopyJSONValue(value)}
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if field := extractField(value, path[1:]); field != nil {
+			return map[string]interface{}{path[0]: field}
+		}
+	case []interface{}:
+		items := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			if item, ok := item.(map[string]interface{}); ok {
+				if field := extractField(item, path[1:]); field != nil {
+					field["name"] = item["name"]
+					items = append(items, field)
+				}
+			}
+		}
+		if len(items) > 0 {
+			return map[string]interface{}{path[0]: items}
+		}
+	}
+
+	return nil
+}
+
+// mergeFields merges the extracted fields into the destination, list items are matched by their names
+func mergeFields(dst, src map[string]interface{}) {
+	for key, value := range src {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				mergeFields(existing, value)
+
+				continue
+			}
+		case []interface{}:
+			if existing, ok := dst[key].([]interface{}); ok {
+				dst[key] = mergeListItems(existing, value)
+
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+func mergeListItems(dst, src []interface{}) []interface{} {
+	for _, item := range src {
+		item, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		merged := false
+		for _, existing := range dst {
+			if existing, ok := existing.(map[string]interface{}); ok && existing["name"] == item["name"] {
+				mergeFields(existing, item)
+				merged = true
+
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, item)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"emperror.dev/errors"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// SpecHistoryEntry is a known-good IstioControlPlane spec kept in the spec history
+type SpecHistoryEntry struct {
+	Hash string `json:"hash"`
+	// Time the spec passed the health gates, in RFC 3339 format
+	Time string                                     `json:"time"`
+	Spec *servicemeshv1alpha1.IstioControlPlaneSpec `json:"spec"`
+}
+
+// SpecHash returns a short hash identifying the IstioControlPlane spec
+func SpecHash(spec *servicemeshv1alpha1.IstioControlPlaneSpec) (string, error) {
+	j, err := json.Marshal(spec)
+	if err != nil {
+		return "", errors.WrapIf(err, "could not marshal spec")
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(j))[:16], nil
+}
+
+// DecodeSpecHistory decodes the spec history stored as JSON, newest entry first
+func DecodeSpecHistory(data string) ([]SpecHistoryEntry, error) {
+	history := []SpecHistoryEntry{}
+	if data == "" {
+		return history, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &history); err != nil {
+		return nil, errors.WrapIf(err, "could not decode spec history")
+	}
+
+	return history, nil
+}
+
+// EncodeSpecHistory encodes the spec history as JSON
+func EncodeSpecHistory(history []SpecHistoryEntry) (string, error) {
+	j, err := json.Marshal(history)
+	if err != nil {
+		return "", errors.WrapIf(err, "could not encode spec history")
+	}
+
+	return string(j), nil
+}
+
+// AddSpecHistoryEntry puts the entry to the front of the history, removing its earlier occurrence
+// and the entries over the limit
+func AddSpecHistoryEntry(history []SpecHistoryEntry, entry SpecHistoryEntry, limit int) []SpecHistoryEntry {
+	result := []SpecHistoryEntry{entry}
+	for _, e := range history {
+		if e.Hash != entry.Hash {
+			result = append(result, e)
+		}
+	}
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result
+}
+
+// FindSpecHistoryEntry returns the entry with the given hash, or the newest entry with a different hash than the
+// current one when the hash is empty
+func FindSpecHistoryEntry(history []SpecHistoryEntry, hash string, currentHash string) (SpecHistoryEntry, bool) {
+	for _, e := range history {
+		if hash != "" && e.Hash == hash {
+			return e, true
+		}
+		if hash == "" && e.Hash != currentHash {
+			return e, true
+		}
+	}
+
+	return SpecHistoryEntry{}, false
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/util"
+)
+
+func TestSpecHistory(t *testing.T) {
+	t.Parallel()
+
+	entries := []util.SpecHistoryEntry{}
+	for _, version := range []string{"1.16.0", "1.17.0", "1.17.1"} {
+		spec := &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Version: version,
+		}
+		hash, err := util.SpecHash(spec)
+		assert.NilError(t, err)
+		assert.Equal(t, len(hash), 16)
+
+		entries = append(entries, util.SpecHistoryEntry{
+			Hash: hash,
+			Spec: spec,
+		})
+	}
+
+	history := []util.SpecHistoryEntry{}
+	for _, entry := range entries {
+		history = util.AddSpecHistoryEntry(history, entry, 2)
+	}
+	assert.Equal(t, len(history), 2)
+	assert.Equal(t, history[0].Hash, entries[2].Hash)
+	assert.Equal(t, history[1].Hash, entries[1].Hash)
+
+	// an entry already in the history is moved to the front
+	history = util.AddSpecHistoryEntry(history, entries[1], 2)
+	assert.Equal(t, len(history), 2)
+	assert.Equal(t, history[0].Hash, entries[1].Hash)
+	assert.Equal(t, history[1].Hash, entries[2].Hash)
+
+	data, err := util.EncodeSpecHistory(history)
+	assert.NilError(t, err)
+	decoded, err := util.DecodeSpecHistory(data)
+	assert.NilError(t, err)
+	assert.Equal(t, len(decoded), 2)
+	assert.Equal(t, decoded[0].Spec.GetVersion(), "1.17.0")
+
+	entry, found := util.FindSpecHistoryEntry(decoded, entries[2].Hash, "")
+	assert.Assert(t, found)
+	assert.Equal(t, entry.Spec.GetVersion(), "1.17.1")
+
+	// the last known-good spec is the newest one which differs from the current spec
+	entry, found = util.FindSpecHistoryEntry(decoded, "", entries[1].Hash)
+	assert.Assert(t, found)
+	assert.Equal(t, entry.Hash, entries[2].Hash)
+
+	_, found = util.FindSpecHistoryEntry(decoded, entries[0].Hash, "")
+	assert.Assert(t, !found)
+
+	empty, err := util.DecodeSpecHistory("")
+	assert.NilError(t, err)
+	assert.Equal(t, len(empty), 0)
+
+	_, err = util.DecodeSpecHistory("{")
+	assert.ErrorContains(t, err, "could not decode spec history")
+}
@@ -27,6 +27,8 @@ import (
 	"istio.io/api/mesh/v1alpha1"
 	k8s_zap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/yaml"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 )
 
 func CreateLogger(debug bool, development bool) logr.Logger {
@@ -130,6 +132,46 @@ func MergeYAMLs(mergoOptions []MergoOption, yamls ...string) ([]byte, error) {
 	return yaml.Marshal(l)
 }
 
+// MergeIstioControlPlaneSpecs merges the given specs in order, the fields set in the latter ones override the former ones
+func MergeIstioControlPlaneSpecs(mergoOptions []MergoOption, specs ...*servicemeshv1alpha1.IstioControlPlaneSpec) (*servicemeshv1alpha1.IstioControlPlaneSpec, error) {
+	dstSpec := &servicemeshv1alpha1.IstioControlPlaneSpec{}
+
+	yamls := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+
+		y, err := json.Marshal(spec)
+		if err != nil {
+			return dstSpec, err
+		}
+
+		yamls = append(yamls, string(y))
+	}
+
+	if len(yamls) == 0 {
+		return dstSpec, nil
+	}
+
+	y, err := MergeYAMLs(mergoOptions, yamls...)
+	if err != nil {
+		return dstSpec, err
+	}
+
+	jsonBytes, err := yaml.YAMLToJSON(y)
+	if err != nil {
+		return dstSpec, err
+	}
+
+	err = json.Unmarshal(jsonBytes, dstSpec)
+	if err != nil {
+		return dstSpec, err
+	}
+
+	return dstSpec, nil
+}
+
 func MergeStringMaps(l map[string]string, l2 map[string]string) map[string]string {
 	merged := make(map[string]string)
 	for lKey, lValue := range l {
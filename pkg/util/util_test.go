@@ -19,10 +19,14 @@ package util_test
 import (
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/proto"
 	"gotest.tools/v3/assert"
 	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
 	"istio.io/client-go/pkg/apis/networking/v1alpha3"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	"github.com/banzaicloud/istio-operator/v2/pkg/util"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
 )
@@ -60,3 +64,66 @@ func TestProtoCompatiblePatchMaker(t *testing.T) {
 
 	assert.Equal(t, string(r.Patch), `{"spec":{"configPatches":[{"applyTo":"HTTP_FILTER"}]}}`)
 }
+
+func TestMergeIstioControlPlaneSpecs(t *testing.T) {
+	t.Parallel()
+
+	profile := &servicemeshv1alpha1.IstioControlPlaneSpec{
+		Version: "1.17.8",
+		Mode:    servicemeshv1alpha1.ModeType_ACTIVE,
+		Proxy: &servicemeshv1alpha1.ProxyConfiguration{
+			Image: "proxyv2:1.17.8",
+			Resources: &servicemeshv1alpha1.ResourceRequirements{
+				Requests: map[string]*servicemeshv1alpha1.Quantity{
+					"cpu":    {Quantity: resource.MustParse("100m")},
+					"memory": {Quantity: resource.MustParse("128Mi")},
+				},
+			},
+		},
+		MeshExpansion: &servicemeshv1alpha1.MeshExpansionConfiguration{
+			Enabled: &wrappers.BoolValue{Value: true},
+		},
+		WatchOneNamespace: &wrappers.BoolValue{Value: true},
+		K8SResourceOverlays: []*servicemeshv1alpha1.K8SResourceOverlayPatch{
+			{ObjectKey: &servicemeshv1alpha1.NamespacedName{Name: "a"}},
+			{ObjectKey: &servicemeshv1alpha1.NamespacedName{Name: "b"}},
+		},
+	}
+
+	spec := &servicemeshv1alpha1.IstioControlPlaneSpec{
+		Profile: "production",
+		MeshID:  "mesh1",
+		Proxy: &servicemeshv1alpha1.ProxyConfiguration{
+			Resources: &servicemeshv1alpha1.ResourceRequirements{
+				Requests: map[string]*servicemeshv1alpha1.Quantity{
+					"cpu": {Quantity: resource.MustParse("500m")},
+				},
+			},
+		},
+		MeshExpansion: &servicemeshv1alpha1.MeshExpansionConfiguration{
+			Enabled: &wrappers.BoolValue{Value: false},
+		},
+		K8SResourceOverlays: []*servicemeshv1alpha1.K8SResourceOverlayPatch{
+			{ObjectKey: &servicemeshv1alpha1.NamespacedName{Name: "c"}},
+		},
+	}
+
+	resolved, err := util.MergeIstioControlPlaneSpecs(nil, profile, nil, spec)
+	assert.NilError(t, err)
+
+	assert.Equal(t, resolved.GetVersion(), "1.17.8")
+	assert.Equal(t, resolved.GetMode(), servicemeshv1alpha1.ModeType_ACTIVE)
+	assert.Equal(t, resolved.GetProfile(), "production")
+	assert.Equal(t, resolved.GetMeshID(), "mesh1")
+	assert.Equal(t, resolved.GetProxy().GetImage(), "proxyv2:1.17.8")
+	assert.Equal(t, resolved.GetProxy().GetResources().GetRequests()["cpu"].String(), "500m")
+	assert.Equal(t, resolved.GetProxy().GetResources().GetRequests()["memory"].String(), "128Mi")
+	assert.Equal(t, resolved.GetMeshExpansion().GetEnabled().GetValue(), false)
+	assert.Equal(t, resolved.GetWatchOneNamespace().GetValue(), true)
+	assert.Equal(t, len(resolved.GetK8SResourceOverlays()), 1)
+	assert.Equal(t, resolved.GetK8SResourceOverlays()[0].GetObjectKey().GetName(), "c")
+
+	empty, err := util.MergeIstioControlPlaneSpecs(nil)
+	assert.NilError(t, err)
+	assert.Assert(t, proto.Equal(empty, &servicemeshv1alpha1.IstioControlPlaneSpec{}))
+}